@@ -0,0 +1,91 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+	_ "time/tzdata"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	authv1 "github.com/qkitzero/auth-service/gen/go/auth/v1"
+	appauth "github.com/qkitzero/event-service/internal/application/auth"
+	appcalendar "github.com/qkitzero/event-service/internal/application/calendar"
+	appevent "github.com/qkitzero/event-service/internal/application/event"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	apiauth "github.com/qkitzero/event-service/internal/infrastructure/api/auth"
+	apiuser "github.com/qkitzero/event-service/internal/infrastructure/api/user"
+	infracalendar "github.com/qkitzero/event-service/internal/infrastructure/calendar"
+	"github.com/qkitzero/event-service/internal/infrastructure/db"
+	infraevent "github.com/qkitzero/event-service/internal/infrastructure/event"
+	"github.com/qkitzero/event-service/internal/interface/caldav"
+	"github.com/qkitzero/event-service/util"
+	userv1 "github.com/qkitzero/user-service/gen/go/user/v1"
+)
+
+func main() {
+	db, err := db.Init(
+		util.GetEnv("DB_HOST", ""),
+		util.GetEnv("DB_USER", ""),
+		util.GetEnv("DB_PASSWORD", ""),
+		util.GetEnv("DB_NAME", ""),
+		util.GetEnv("DB_PORT", ""),
+		util.GetEnv("DB_SSL_MODE", ""),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	maxEventDuration, err := time.ParseDuration(util.GetEnv("MAX_EVENT_DURATION", event.DefaultMaxDuration.String()))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	trashRetention, err := time.ParseDuration(util.GetEnv("TRASH_RETENTION", "720h"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	authTarget := util.GetEnv("AUTH_SERVICE_HOST", "") + ":" + util.GetEnv("AUTH_SERVICE_PORT", "")
+	userTarget := util.GetEnv("USER_SERVICE_HOST", "") + ":" + util.GetEnv("USER_SERVICE_PORT", "")
+
+	var opts grpc.DialOption
+	switch util.GetEnv("ENV", "development") {
+	case "production":
+		opts = grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, ""))
+	default:
+		opts = grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+
+	authConn, err := grpc.NewClient(authTarget, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer authConn.Close()
+
+	userConn, err := grpc.NewClient(userTarget, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer userConn.Close()
+
+	authServiceClient := authv1.NewAuthServiceClient(authConn)
+	userServiceClient := userv1.NewUserServiceClient(userConn)
+	eventRepository := infraevent.NewEventRepository(db)
+	calendarRepository := infracalendar.NewCalendarRepository(db)
+	shareRepository := infracalendar.NewShareRepository(db)
+
+	authService := apiauth.NewAuthService(authServiceClient)
+	userService := apiuser.NewUserService(userServiceClient)
+	authenticator := appauth.NewAuthenticator(authService, userService)
+	policy := appcalendar.NewPolicy(calendarRepository, shareRepository)
+	eventUsecase := appevent.NewEventUsecase(eventRepository, calendarRepository, policy, maxEventDuration, trashRetention)
+
+	handler := caldav.NewHandler(eventUsecase, authenticator)
+
+	if err := http.ListenAndServe(":"+util.GetEnv("PORT", ""), handler); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,8 +1,13 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	"net/http"
+	"strconv"
+	"time"
+	_ "time/tzdata"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -12,13 +17,30 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	authv1 "github.com/qkitzero/auth-service/gen/go/auth/v1"
+	calendarv1 "github.com/qkitzero/event-service/gen/go/calendar/v1"
 	eventv1 "github.com/qkitzero/event-service/gen/go/event/v1"
+	feedv1 "github.com/qkitzero/event-service/gen/go/feed/v1"
+	webhookv1 "github.com/qkitzero/event-service/gen/go/webhook/v1"
+	appauth "github.com/qkitzero/event-service/internal/application/auth"
+	appcalendar "github.com/qkitzero/event-service/internal/application/calendar"
 	appevent "github.com/qkitzero/event-service/internal/application/event"
+	appfeed "github.com/qkitzero/event-service/internal/application/feed"
+	appwebhook "github.com/qkitzero/event-service/internal/application/webhook"
+	"github.com/qkitzero/event-service/internal/domain/event"
 	apiauth "github.com/qkitzero/event-service/internal/infrastructure/api/auth"
 	apiuser "github.com/qkitzero/event-service/internal/infrastructure/api/user"
+	infracalendar "github.com/qkitzero/event-service/internal/infrastructure/calendar"
 	"github.com/qkitzero/event-service/internal/infrastructure/db"
 	infraevent "github.com/qkitzero/event-service/internal/infrastructure/event"
+	infrafeed "github.com/qkitzero/event-service/internal/infrastructure/feed"
+	"github.com/qkitzero/event-service/internal/infrastructure/notifier"
+	"github.com/qkitzero/event-service/internal/infrastructure/publisher"
+	infrawebhook "github.com/qkitzero/event-service/internal/infrastructure/webhook"
+	grpccalendar "github.com/qkitzero/event-service/internal/interface/grpc/calendar"
 	grpcevent "github.com/qkitzero/event-service/internal/interface/grpc/event"
+	grpcfeed "github.com/qkitzero/event-service/internal/interface/grpc/feed"
+	"github.com/qkitzero/event-service/internal/interface/grpc/interceptor"
+	grpcwebhook "github.com/qkitzero/event-service/internal/interface/grpc/webhook"
 	"github.com/qkitzero/event-service/util"
 	userv1 "github.com/qkitzero/user-service/gen/go/user/v1"
 )
@@ -41,6 +63,117 @@ func main() {
 		log.Fatal(err)
 	}
 
+	maxEventDuration, err := time.ParseDuration(util.GetEnv("MAX_EVENT_DURATION", event.DefaultMaxDuration.String()))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	trashRetention, err := time.ParseDuration(util.GetEnv("TRASH_RETENTION", "720h"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	trashPurgeInterval, err := time.ParseDuration(util.GetEnv("TRASH_PURGE_INTERVAL", "1h"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	changeRetention, err := time.ParseDuration(util.GetEnv("CHANGE_RETENTION", "720h"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	reminderPollInterval, err := time.ParseDuration(util.GetEnv("REMINDER_POLL_INTERVAL", "1m"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	reminderBatchSize, err := strconv.Atoi(util.GetEnv("REMINDER_BATCH_SIZE", "100"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if reminderBatchSize <= 0 {
+		log.Fatalf("invalid REMINDER_BATCH_SIZE: %d", reminderBatchSize)
+	}
+
+	var reminderNotifier appevent.Notifier
+	switch util.GetEnv("REMINDER_NOTIFIER", "log") {
+	case "log":
+		reminderNotifier = notifier.NewLogNotifier()
+	case "webhook":
+		webhookURL := util.GetEnv("REMINDER_WEBHOOK_URL", "")
+		if webhookURL == "" {
+			log.Fatal("REMINDER_WEBHOOK_URL is required for the webhook notifier")
+		}
+		reminderNotifier = notifier.NewWebhookNotifier(webhookURL, &http.Client{Timeout: 10 * time.Second})
+	default:
+		log.Fatalf("unknown REMINDER_NOTIFIER: %s", util.GetEnv("REMINDER_NOTIFIER", ""))
+	}
+
+	outboxPollInterval, err := time.ParseDuration(util.GetEnv("OUTBOX_POLL_INTERVAL", "5s"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	outboxBatchSize, err := strconv.Atoi(util.GetEnv("OUTBOX_BATCH_SIZE", "100"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if outboxBatchSize <= 0 {
+		log.Fatalf("invalid OUTBOX_BATCH_SIZE: %d", outboxBatchSize)
+	}
+
+	// Changes are always relayed to webhooks, and also to OUTBOX_PUBLISHER
+	// when one is configured. Webhooks go first since they drop the changes
+	// that are relayed again after a later publisher fails.
+	var outboxPublishers appevent.Publishers
+	switch util.GetEnv("OUTBOX_PUBLISHER", "") {
+	case "":
+	case "file":
+		outboxFile := util.GetEnv("OUTBOX_FILE", "")
+		if outboxFile == "" {
+			log.Fatal("OUTBOX_FILE is required for the file publisher")
+		}
+		outboxPublishers = append(outboxPublishers, publisher.NewFilePublisher(outboxFile))
+	default:
+		log.Fatalf("unknown OUTBOX_PUBLISHER: %s", util.GetEnv("OUTBOX_PUBLISHER", ""))
+	}
+
+	webhookPollInterval, err := time.ParseDuration(util.GetEnv("WEBHOOK_POLL_INTERVAL", "10s"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	webhookBatchSize, err := strconv.Atoi(util.GetEnv("WEBHOOK_BATCH_SIZE", "50"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if webhookBatchSize <= 0 {
+		log.Fatalf("invalid WEBHOOK_BATCH_SIZE: %d", webhookBatchSize)
+	}
+
+	webhookTimeout, err := time.ParseDuration(util.GetEnv("WEBHOOK_TIMEOUT", "10s"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	watchPollInterval, err := time.ParseDuration(util.GetEnv("WATCH_POLL_INTERVAL", "1s"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	watchBatchSize, err := strconv.Atoi(util.GetEnv("WATCH_BATCH_SIZE", "100"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if watchBatchSize <= 0 {
+		log.Fatalf("invalid WATCH_BATCH_SIZE: %d", watchBatchSize)
+	}
+
+	// Webhooks may only call plain http and private addresses in
+	// development.
+	development := util.GetEnv("ENV", "development") == "development"
+
 	authTarget := util.GetEnv("AUTH_SERVICE_HOST", "") + ":" + util.GetEnv("AUTH_SERVICE_PORT", "")
 	userTarget := util.GetEnv("USER_SERVICE_HOST", "") + ":" + util.GetEnv("USER_SERVICE_PORT", "")
 
@@ -64,25 +197,68 @@ func main() {
 	}
 	defer userConn.Close()
 
-	server := grpc.NewServer()
-
 	authServiceClient := authv1.NewAuthServiceClient(authConn)
 	userServiceClient := userv1.NewUserServiceClient(userConn)
 	eventRepository := infraevent.NewEventRepository(db)
+	calendarRepository := infracalendar.NewCalendarRepository(db)
+	shareRepository := infracalendar.NewShareRepository(db)
+	feedRepository := infrafeed.NewFeedRepository(db)
+	webhookRepository := infrawebhook.NewWebhookRepository(db)
+	deliveryRepository := infrawebhook.NewDeliveryRepository(db)
 
-	_ = apiauth.NewAuthService(authServiceClient)
+	authService := apiauth.NewAuthService(authServiceClient)
 	userService := apiuser.NewUserService(userServiceClient)
-	eventUsecase := appevent.NewEventUsecase(userService, eventRepository)
+	authenticator := appauth.NewAuthenticator(authService, userService)
+	policy := appcalendar.NewPolicy(calendarRepository, shareRepository)
+	calendarUsecase := appcalendar.NewCalendarUsecase(calendarRepository, shareRepository, policy)
+	// A sync needs the trashed events and the changes made since its token,
+	// so tokens expire with whichever is purged first.
+	eventUsecase := appevent.NewEventUsecase(eventRepository, calendarRepository, policy, maxEventDuration, min(trashRetention, changeRetention))
+	trashPurger := appevent.NewTrashPurger(eventRepository, trashRetention, changeRetention, trashPurgeInterval)
+	reminderScheduler := appevent.NewReminderScheduler(eventRepository, reminderNotifier, reminderPollInterval, reminderBatchSize)
+	feedUsecase := appfeed.NewFeedUsecase(feedRepository, eventRepository)
+	webhookUsecase := appwebhook.NewWebhookUsecase(webhookRepository, deliveryRepository, development)
+	webhookDispatcher := appwebhook.NewDispatcher(eventRepository, webhookRepository, deliveryRepository)
+	outboxRelay := appevent.NewOutboxRelay(eventRepository, append(appevent.Publishers{webhookDispatcher}, outboxPublishers...), outboxPollInterval, outboxBatchSize)
+	changeFeed := appevent.NewChangeFeed(eventRepository, watchPollInterval, watchBatchSize)
+	eventWatcher := appevent.NewEventWatcher(eventRepository, policy, changeFeed, changeRetention)
+	deliveryWorker := appwebhook.NewDeliveryWorker(webhookRepository, deliveryRepository, infrawebhook.NewHTTPSender(infrawebhook.NewHTTPClient(webhookTimeout, development)), webhookPollInterval, webhookBatchSize)
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.ErrorUnaryServerInterceptor(),
+			interceptor.AuthUnaryServerInterceptor(authenticator),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.ErrorStreamServerInterceptor(),
+			interceptor.AuthStreamServerInterceptor(authenticator),
+		),
+	)
 
 	healthServer := health.NewServer()
-	eventHandler := grpcevent.NewEventHandler(eventUsecase)
+	calendarHandler := grpccalendar.NewCalendarHandler(calendarUsecase)
+	eventHandler := grpcevent.NewEventHandler(eventUsecase, eventWatcher)
+	feedHandler := grpcfeed.NewFeedHandler(feedUsecase, util.GetEnv("FEED_BASE_URL", ""))
+	webhookHandler := grpcwebhook.NewWebhookHandler(webhookUsecase)
 
 	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	calendarv1.RegisterCalendarServiceServer(server, calendarHandler)
 	eventv1.RegisterEventServiceServer(server, eventHandler)
+	feedv1.RegisterFeedServiceServer(server, feedHandler)
+	webhookv1.RegisterWebhookServiceServer(server, webhookHandler)
 
 	healthServer.SetServingStatus("event", grpc_health_v1.HealthCheckResponse_SERVING)
 
-	if util.GetEnv("ENV", "development") == "development" {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go trashPurger.Run(ctx)
+	go reminderScheduler.Run(ctx)
+	go outboxRelay.Run(ctx)
+	go deliveryWorker.Run(ctx)
+	go changeFeed.Run(ctx)
+
+	if development {
 		reflection.Register(server)
 	}
 
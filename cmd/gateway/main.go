@@ -4,14 +4,21 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
 
+	calendarv1 "github.com/qkitzero/event-service/gen/go/calendar/v1"
 	eventv1 "github.com/qkitzero/event-service/gen/go/event/v1"
+	feedv1 "github.com/qkitzero/event-service/gen/go/feed/v1"
+	webhookv1 "github.com/qkitzero/event-service/gen/go/webhook/v1"
+	grpcfeed "github.com/qkitzero/event-service/internal/interface/grpc/feed"
 	"github.com/qkitzero/event-service/util"
 )
 
@@ -40,13 +47,61 @@ func main() {
 
 	mux := runtime.NewServeMux(
 		runtime.WithHealthzEndpoint(healthClient),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithForwardResponseOption(forwardHTTPCode),
 	)
 
+	if err := calendarv1.RegisterCalendarServiceHandlerFromEndpoint(ctx, mux, endpoint, []grpc.DialOption{opts}); err != nil {
+		log.Fatal(err)
+	}
+
 	if err := eventv1.RegisterEventServiceHandlerFromEndpoint(ctx, mux, endpoint, []grpc.DialOption{opts}); err != nil {
 		log.Fatal(err)
 	}
 
+	if err := feedv1.RegisterFeedServiceHandlerFromEndpoint(ctx, mux, endpoint, []grpc.DialOption{opts}); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := webhookv1.RegisterWebhookServiceHandlerFromEndpoint(ctx, mux, endpoint, []grpc.DialOption{opts}); err != nil {
+		log.Fatal(err)
+	}
+
 	if err := http.ListenAndServe(":"+util.GetEnv("PORT", ""), mux); err != nil {
 		log.Fatal(err)
 	}
 }
+
+// outgoingHeaderMatcher passes HTTP caching headers set by the server through
+// unchanged, so that feed clients can make conditional requests.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case "etag", "last-modified", "cache-control":
+		return key, true
+	case grpcfeed.HTTPCodeHeader:
+		return "", false
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// forwardHTTPCode applies the HTTP status requested by the server, such as
+// 304 Not Modified for unchanged feeds.
+func forwardHTTPCode(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	values := md.HeaderMD.Get(grpcfeed.HTTPCodeHeader)
+	if len(values) == 0 {
+		return nil
+	}
+
+	code, err := strconv.Atoi(values[0])
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(code)
+
+	return nil
+}
@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,11 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventService_CreateEvent_FullMethodName = "/event.v1.EventService/CreateEvent"
-	EventService_UpdateEvent_FullMethodName = "/event.v1.EventService/UpdateEvent"
-	EventService_GetEvent_FullMethodName    = "/event.v1.EventService/GetEvent"
-	EventService_ListEvents_FullMethodName  = "/event.v1.EventService/ListEvents"
-	EventService_DeleteEvent_FullMethodName = "/event.v1.EventService/DeleteEvent"
+	EventService_CreateEvent_FullMethodName         = "/event.v1.EventService/CreateEvent"
+	EventService_UpdateEvent_FullMethodName         = "/event.v1.EventService/UpdateEvent"
+	EventService_GetEvent_FullMethodName            = "/event.v1.EventService/GetEvent"
+	EventService_ListEvents_FullMethodName          = "/event.v1.EventService/ListEvents"
+	EventService_DeleteEvent_FullMethodName         = "/event.v1.EventService/DeleteEvent"
+	EventService_ListDeletedEvents_FullMethodName   = "/event.v1.EventService/ListDeletedEvents"
+	EventService_RestoreEvent_FullMethodName        = "/event.v1.EventService/RestoreEvent"
+	EventService_RespondToEvent_FullMethodName      = "/event.v1.EventService/RespondToEvent"
+	EventService_ExportEvents_FullMethodName        = "/event.v1.EventService/ExportEvents"
+	EventService_ImportEvents_FullMethodName        = "/event.v1.EventService/ImportEvents"
+	EventService_QueryFreeBusy_FullMethodName       = "/event.v1.EventService/QueryFreeBusy"
+	EventService_CheckConflicts_FullMethodName      = "/event.v1.EventService/CheckConflicts"
+	EventService_SuggestMeetingTimes_FullMethodName = "/event.v1.EventService/SuggestMeetingTimes"
+	EventService_SyncEvents_FullMethodName          = "/event.v1.EventService/SyncEvents"
+	EventService_WatchEvents_FullMethodName         = "/event.v1.EventService/WatchEvents"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -31,10 +42,47 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EventServiceClient interface {
 	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error)
+	// Without an update_mask only the fields set in event change, so an empty
+	// list of attendees keeps the current ones. With a mask, or "*" for every
+	// field, the listed fields are replaced with what was sent, so a PUT with
+	// "*" and no attendees removes them.
 	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateEventResponse, error)
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*DeleteEventResponse, error)
+	// Lists the deleted events in the calendars the caller can write to, that
+	// is the events RestoreEvent lets them restore.
+	ListDeletedEvents(ctx context.Context, in *ListDeletedEventsRequest, opts ...grpc.CallOption) (*ListDeletedEventsResponse, error)
+	// Restores a deleted event. Fails with FAILED_PRECONDITION when another
+	// event of the organizer has since taken its iCalendar UID.
+	RestoreEvent(ctx context.Context, in *RestoreEventRequest, opts ...grpc.CallOption) (*RestoreEventResponse, error)
+	// Records the caller's response to an event they are invited to.
+	RespondToEvent(ctx context.Context, in *RespondToEventRequest, opts ...grpc.CallOption) (*RespondToEventResponse, error)
+	// Exports the caller's events as an RFC 5545 iCalendar (text/calendar) file.
+	ExportEvents(ctx context.Context, in *ExportEventsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Imports the VEVENTs of an RFC 5545 iCalendar file. Events whose UID was
+	// imported before are updated instead of duplicated.
+	ImportEvents(ctx context.Context, in *ImportEventsRequest, opts ...grpc.CallOption) (*ImportEventsResponse, error)
+	// Returns when users are busy, without revealing anything else about their
+	// events. Only calendars the caller holds free/busy access or above to are
+	// considered.
+	QueryFreeBusy(ctx context.Context, in *QueryFreeBusyRequest, opts ...grpc.CallOption) (*QueryFreeBusyResponse, error)
+	// Returns the caller's events and invitations that overlap a time window.
+	CheckConflicts(ctx context.Context, in *CheckConflictsRequest, opts ...grpc.CallOption) (*CheckConflictsResponse, error)
+	// Suggests slots where the caller and every participant are free. Requires
+	// the same access as QueryFreeBusy for each participant.
+	SuggestMeetingTimes(ctx context.Context, in *SuggestMeetingTimesRequest, opts ...grpc.CallOption) (*SuggestMeetingTimesResponse, error)
+	// Returns the events that changed since sync_token, for clients that keep
+	// an offline copy. Fails with FAILED_PRECONDITION when the token is too
+	// old or the calendars the caller can read have changed since, after
+	// which the client has to sync again without one.
+	SyncEvents(ctx context.Context, in *SyncEventsRequest, opts ...grpc.CallOption) (*SyncEventsResponse, error)
+	// Streams changes to the events the caller can see as they happen. Pass
+	// the last resume_token received to continue after a disconnect without
+	// missing changes. Fails with FAILED_PRECONDITION when the token is older
+	// than the change retention, after which the client has to sync again and
+	// watch without one.
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error)
 }
 
 type eventServiceClient struct {
@@ -95,15 +143,161 @@ func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventReq
 	return out, nil
 }
 
+func (c *eventServiceClient) ListDeletedEvents(ctx context.Context, in *ListDeletedEventsRequest, opts ...grpc.CallOption) (*ListDeletedEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_ListDeletedEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RestoreEvent(ctx context.Context, in *RestoreEventRequest, opts ...grpc.CallOption) (*RestoreEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreEventResponse)
+	err := c.cc.Invoke(ctx, EventService_RestoreEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RespondToEvent(ctx context.Context, in *RespondToEventRequest, opts ...grpc.CallOption) (*RespondToEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondToEventResponse)
+	err := c.cc.Invoke(ctx, EventService_RespondToEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ExportEvents(ctx context.Context, in *ExportEventsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, EventService_ExportEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ImportEvents(ctx context.Context, in *ImportEventsRequest, opts ...grpc.CallOption) (*ImportEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_ImportEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) QueryFreeBusy(ctx context.Context, in *QueryFreeBusyRequest, opts ...grpc.CallOption) (*QueryFreeBusyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFreeBusyResponse)
+	err := c.cc.Invoke(ctx, EventService_QueryFreeBusy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CheckConflicts(ctx context.Context, in *CheckConflictsRequest, opts ...grpc.CallOption) (*CheckConflictsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckConflictsResponse)
+	err := c.cc.Invoke(ctx, EventService_CheckConflicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SuggestMeetingTimes(ctx context.Context, in *SuggestMeetingTimesRequest, opts ...grpc.CallOption) (*SuggestMeetingTimesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestMeetingTimesResponse)
+	err := c.cc.Invoke(ctx, EventService_SuggestMeetingTimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SyncEvents(ctx context.Context, in *SyncEventsRequest, opts ...grpc.CallOption) (*SyncEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_SyncEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[0], EventService_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, WatchEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventsClient = grpc.ServerStreamingClient[WatchEventsResponse]
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
 type EventServiceServer interface {
 	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
+	// Without an update_mask only the fields set in event change, so an empty
+	// list of attendees keeps the current ones. With a mask, or "*" for every
+	// field, the listed fields are replaced with what was sent, so a PUT with
+	// "*" and no attendees removes them.
 	UpdateEvent(context.Context, *UpdateEventRequest) (*UpdateEventResponse, error)
 	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error)
+	// Lists the deleted events in the calendars the caller can write to, that
+	// is the events RestoreEvent lets them restore.
+	ListDeletedEvents(context.Context, *ListDeletedEventsRequest) (*ListDeletedEventsResponse, error)
+	// Restores a deleted event. Fails with FAILED_PRECONDITION when another
+	// event of the organizer has since taken its iCalendar UID.
+	RestoreEvent(context.Context, *RestoreEventRequest) (*RestoreEventResponse, error)
+	// Records the caller's response to an event they are invited to.
+	RespondToEvent(context.Context, *RespondToEventRequest) (*RespondToEventResponse, error)
+	// Exports the caller's events as an RFC 5545 iCalendar (text/calendar) file.
+	ExportEvents(context.Context, *ExportEventsRequest) (*httpbody.HttpBody, error)
+	// Imports the VEVENTs of an RFC 5545 iCalendar file. Events whose UID was
+	// imported before are updated instead of duplicated.
+	ImportEvents(context.Context, *ImportEventsRequest) (*ImportEventsResponse, error)
+	// Returns when users are busy, without revealing anything else about their
+	// events. Only calendars the caller holds free/busy access or above to are
+	// considered.
+	QueryFreeBusy(context.Context, *QueryFreeBusyRequest) (*QueryFreeBusyResponse, error)
+	// Returns the caller's events and invitations that overlap a time window.
+	CheckConflicts(context.Context, *CheckConflictsRequest) (*CheckConflictsResponse, error)
+	// Suggests slots where the caller and every participant are free. Requires
+	// the same access as QueryFreeBusy for each participant.
+	SuggestMeetingTimes(context.Context, *SuggestMeetingTimesRequest) (*SuggestMeetingTimesResponse, error)
+	// Returns the events that changed since sync_token, for clients that keep
+	// an offline copy. Fails with FAILED_PRECONDITION when the token is too
+	// old or the calendars the caller can read have changed since, after
+	// which the client has to sync again without one.
+	SyncEvents(context.Context, *SyncEventsRequest) (*SyncEventsResponse, error)
+	// Streams changes to the events the caller can see as they happen. Pass
+	// the last resume_token received to continue after a disconnect without
+	// missing changes. Fails with FAILED_PRECONDITION when the token is older
+	// than the change retention, after which the client has to sync again and
+	// watch without one.
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -129,6 +323,36 @@ func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRe
 func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
 }
+func (UnimplementedEventServiceServer) ListDeletedEvents(context.Context, *ListDeletedEventsRequest) (*ListDeletedEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedEvents not implemented")
+}
+func (UnimplementedEventServiceServer) RestoreEvent(context.Context, *RestoreEventRequest) (*RestoreEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEvent not implemented")
+}
+func (UnimplementedEventServiceServer) RespondToEvent(context.Context, *RespondToEventRequest) (*RespondToEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToEvent not implemented")
+}
+func (UnimplementedEventServiceServer) ExportEvents(context.Context, *ExportEventsRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEvents not implemented")
+}
+func (UnimplementedEventServiceServer) ImportEvents(context.Context, *ImportEventsRequest) (*ImportEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEvents not implemented")
+}
+func (UnimplementedEventServiceServer) QueryFreeBusy(context.Context, *QueryFreeBusyRequest) (*QueryFreeBusyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFreeBusy not implemented")
+}
+func (UnimplementedEventServiceServer) CheckConflicts(context.Context, *CheckConflictsRequest) (*CheckConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConflicts not implemented")
+}
+func (UnimplementedEventServiceServer) SuggestMeetingTimes(context.Context, *SuggestMeetingTimesRequest) (*SuggestMeetingTimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestMeetingTimes not implemented")
+}
+func (UnimplementedEventServiceServer) SyncEvents(context.Context, *SyncEventsRequest) (*SyncEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncEvents not implemented")
+}
+func (UnimplementedEventServiceServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,6 +464,179 @@ func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_ListDeletedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListDeletedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListDeletedEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListDeletedEvents(ctx, req.(*ListDeletedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RestoreEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RestoreEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RestoreEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RestoreEvent(ctx, req.(*RestoreEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RespondToEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RespondToEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RespondToEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RespondToEvent(ctx, req.(*RespondToEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ExportEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ExportEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ExportEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ExportEvents(ctx, req.(*ExportEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ImportEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ImportEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ImportEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ImportEvents(ctx, req.(*ImportEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_QueryFreeBusy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFreeBusyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).QueryFreeBusy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_QueryFreeBusy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).QueryFreeBusy(ctx, req.(*QueryFreeBusyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CheckConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CheckConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CheckConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CheckConflicts(ctx, req.(*CheckConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SuggestMeetingTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestMeetingTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SuggestMeetingTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SuggestMeetingTimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SuggestMeetingTimes(ctx, req.(*SuggestMeetingTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SyncEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SyncEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SyncEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SyncEvents(ctx, req.(*SyncEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, WatchEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventsServer = grpc.ServerStreamingServer[WatchEventsResponse]
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,7 +664,49 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEvent",
 			Handler:    _EventService_DeleteEvent_Handler,
 		},
+		{
+			MethodName: "ListDeletedEvents",
+			Handler:    _EventService_ListDeletedEvents_Handler,
+		},
+		{
+			MethodName: "RestoreEvent",
+			Handler:    _EventService_RestoreEvent_Handler,
+		},
+		{
+			MethodName: "RespondToEvent",
+			Handler:    _EventService_RespondToEvent_Handler,
+		},
+		{
+			MethodName: "ExportEvents",
+			Handler:    _EventService_ExportEvents_Handler,
+		},
+		{
+			MethodName: "ImportEvents",
+			Handler:    _EventService_ImportEvents_Handler,
+		},
+		{
+			MethodName: "QueryFreeBusy",
+			Handler:    _EventService_QueryFreeBusy_Handler,
+		},
+		{
+			MethodName: "CheckConflicts",
+			Handler:    _EventService_CheckConflicts_Handler,
+		},
+		{
+			MethodName: "SuggestMeetingTimes",
+			Handler:    _EventService_SuggestMeetingTimes_Handler,
+		},
+		{
+			MethodName: "SyncEvents",
+			Handler:    _EventService_SyncEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _EventService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "event/v1/event.proto",
 }
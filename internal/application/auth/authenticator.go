@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/application/user"
+)
+
+const (
+	userIDCacheTTL  = 10 * time.Minute
+	userIDCacheSize = 10000
+)
+
+type Authenticator interface {
+	Authenticate(ctx context.Context) (Principal, error)
+}
+
+type cachedUserID struct {
+	subject   string
+	userID    string
+	expiresAt time.Time
+}
+
+type authenticator struct {
+	authService AuthService
+	userService user.UserService
+
+	// userIDs caches up to userIDCacheSize user IDs by subject. recent
+	// orders them from the most to the least recently used, which is the
+	// first to be evicted when the cache is full.
+	mu      sync.Mutex
+	userIDs map[string]*list.Element
+	recent  *list.List
+}
+
+func NewAuthenticator(authService AuthService, userService user.UserService) Authenticator {
+	return &authenticator{
+		authService: authService,
+		userService: userService,
+		userIDs:     make(map[string]*list.Element),
+		recent:      list.New(),
+	}
+}
+
+// Authenticate verifies the caller's token and resolves the user service ID
+// for its subject. The subject to user ID mapping does not change, so it is
+// cached to avoid calling the user service on every request.
+func (a *authenticator) Authenticate(ctx context.Context) (Principal, error) {
+	subject, err := a.authService.VerifyToken(ctx)
+	if err != nil {
+		return Principal{}, err
+	}
+	if subject == "" {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	if userID, ok := a.lookup(subject); ok {
+		return Principal{UserID: userID, Subject: subject}, nil
+	}
+
+	userID, err := a.userService.GetUser(ctx)
+	if err != nil {
+		return Principal{}, err
+	}
+	if userID == "" {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	a.store(subject, userID)
+
+	return Principal{UserID: userID, Subject: subject}, nil
+}
+
+func (a *authenticator) lookup(subject string) (string, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	elem, ok := a.userIDs[subject]
+	if !ok {
+		return "", false
+	}
+
+	cached := elem.Value.(cachedUserID)
+	if time.Now().After(cached.expiresAt) {
+		a.recent.Remove(elem)
+		delete(a.userIDs, subject)
+		return "", false
+	}
+
+	a.recent.MoveToFront(elem)
+	return cached.userID, true
+}
+
+func (a *authenticator) store(subject, userID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cached := cachedUserID{subject: subject, userID: userID, expiresAt: time.Now().Add(userIDCacheTTL)}
+	if elem, ok := a.userIDs[subject]; ok {
+		elem.Value = cached
+		a.recent.MoveToFront(elem)
+		return
+	}
+
+	if a.recent.Len() >= userIDCacheSize {
+		oldest := a.recent.Back()
+		a.recent.Remove(oldest)
+		delete(a.userIDs, oldest.Value.(cachedUserID).subject)
+	}
+	a.userIDs[subject] = a.recent.PushFront(cached)
+}
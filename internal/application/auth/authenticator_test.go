@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	mocksauth "github.com/qkitzero/event-service/mocks/application/auth"
+	mocksuser "github.com/qkitzero/event-service/mocks/application/user"
+)
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		success        bool
+		ctx            context.Context
+		subject        string
+		verifyTokenErr error
+		userID         string
+		getUserErr     error
+	}{
+		{"success authenticate", true, context.Background(), "google-oauth2|000000000000000000000", nil, "6d322c66-bf4d-427a-970c-874f3745f653", nil},
+		{"failure verify token error", false, context.Background(), "", errors.New("verify token error"), "", nil},
+		{"failure empty subject", false, context.Background(), "", nil, "", nil},
+		{"failure get user error", false, context.Background(), "google-oauth2|000000000000000000000", nil, "", errors.New("get user error")},
+		{"failure empty user id", false, context.Background(), "google-oauth2|000000000000000000000", nil, "", nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuthService := mocksauth.NewMockAuthService(ctrl)
+			mockAuthService.EXPECT().VerifyToken(tt.ctx).Return(tt.subject, tt.verifyTokenErr).AnyTimes()
+			mockUserService := mocksuser.NewMockUserService(ctrl)
+			mockUserService.EXPECT().GetUser(tt.ctx).Return(tt.userID, tt.getUserErr).AnyTimes()
+
+			authenticator := NewAuthenticator(mockAuthService, mockUserService)
+
+			principal, err := authenticator.Authenticate(tt.ctx)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && principal.UserID != tt.userID {
+				t.Errorf("UserID = %v, want %v", principal.UserID, tt.userID)
+			}
+			if tt.success && principal.Subject != tt.subject {
+				t.Errorf("Subject = %v, want %v", principal.Subject, tt.subject)
+			}
+		})
+	}
+}
+
+func TestAuthenticateCachesUserID(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockAuthService := mocksauth.NewMockAuthService(ctrl)
+	mockAuthService.EXPECT().VerifyToken(ctx).Return("google-oauth2|000000000000000000000", nil).Times(2)
+	mockUserService := mocksuser.NewMockUserService(ctrl)
+	mockUserService.EXPECT().GetUser(ctx).Return("6d322c66-bf4d-427a-970c-874f3745f653", nil).Times(1)
+
+	authenticator := NewAuthenticator(mockAuthService, mockUserService)
+
+	for i := 0; i < 2; i++ {
+		principal, err := authenticator.Authenticate(ctx)
+		if err != nil {
+			t.Errorf("expected no error, but got %v", err)
+		}
+		if principal.UserID != "6d322c66-bf4d-427a-970c-874f3745f653" {
+			t.Errorf("UserID = %v, want %v", principal.UserID, "6d322c66-bf4d-427a-970c-874f3745f653")
+		}
+	}
+}
+
+func TestUserIDCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+	a := NewAuthenticator(nil, nil).(*authenticator)
+
+	for i := 0; i < userIDCacheSize; i++ {
+		a.store("subject-"+strconv.Itoa(i), "user-"+strconv.Itoa(i))
+	}
+	if _, ok := a.lookup("subject-0"); !ok {
+		t.Fatalf("lookup(subject-0) = false, want true")
+	}
+	a.store("subject-new", "user-new")
+
+	if len(a.userIDs) != userIDCacheSize {
+		t.Errorf("len(userIDs) = %d, want %d", len(a.userIDs), userIDCacheSize)
+	}
+	if _, ok := a.lookup("subject-0"); !ok {
+		t.Errorf("lookup(subject-0) = false, want the recently used entry kept")
+	}
+	if _, ok := a.lookup("subject-1"); ok {
+		t.Errorf("lookup(subject-1) = true, want the least recently used entry evicted")
+	}
+	if userID, ok := a.lookup("subject-new"); !ok || userID != "user-new" {
+		t.Errorf("lookup(subject-new) = %q, %v, want %q, true", userID, ok, "user-new")
+	}
+}
+
+func TestUserIDCacheExpires(t *testing.T) {
+	t.Parallel()
+	a := NewAuthenticator(nil, nil).(*authenticator)
+
+	a.store("subject", "user")
+	elem := a.userIDs["subject"]
+	cached := elem.Value.(cachedUserID)
+	cached.expiresAt = time.Now().Add(-time.Second)
+	elem.Value = cached
+
+	if _, ok := a.lookup("subject"); ok {
+		t.Errorf("lookup(subject) = true, want the expired entry dropped")
+	}
+	if len(a.userIDs) != 0 || a.recent.Len() != 0 {
+		t.Errorf("cache holds %d entries, want 0", len(a.userIDs))
+	}
+}
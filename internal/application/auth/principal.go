@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Principal is the authenticated caller of a request. UserID is the user
+// service's ID for the caller and Subject is the token subject returned by
+// the auth service.
+type Principal struct {
+	UserID  string
+	Subject string
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func FromContext(ctx context.Context) (Principal, error) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	if !ok || principal.UserID == "" {
+		return Principal{}, ErrUnauthenticated
+	}
+	return principal, nil
+}
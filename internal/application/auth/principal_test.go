@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		ctx     context.Context
+	}{
+		{"success from context", true, NewContext(context.Background(), Principal{UserID: "6d322c66-bf4d-427a-970c-874f3745f653", Subject: "google-oauth2|000000000000000000000"})},
+		{"failure missing principal", false, context.Background()},
+		{"failure empty user id", false, NewContext(context.Background(), Principal{})},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := FromContext(tt.ctx)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
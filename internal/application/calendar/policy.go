@@ -0,0 +1,116 @@
+package calendar
+
+import (
+	"errors"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+)
+
+// Policy decides what a user may do with a calendar and the events in it.
+// The owner of a calendar holds calendar.AccessLevelOwner; other users hold
+// the level of their share, if any.
+type Policy interface {
+	// Authorize returns the calendar when the user holds at least the
+	// required access level on it, and calendar.ErrPermissionDenied otherwise.
+	Authorize(userID domainuser.UserID, calendarID calendar.CalendarID, required calendar.AccessLevel) (calendar.Calendar, error)
+	// AccessibleCalendarIDs returns the IDs of the user's own calendars and of
+	// the calendars shared with them at the required level or above.
+	AccessibleCalendarIDs(userID domainuser.UserID, required calendar.AccessLevel) ([]calendar.CalendarID, error)
+	// VisibleCalendarIDs returns the IDs of the calendars owned by ownerID on
+	// which viewerID holds at least the required access level.
+	VisibleCalendarIDs(viewerID, ownerID domainuser.UserID, required calendar.AccessLevel) ([]calendar.CalendarID, error)
+}
+
+type policy struct {
+	calendarRepo calendar.CalendarRepository
+	shareRepo    calendar.ShareRepository
+}
+
+func NewPolicy(calendarRepo calendar.CalendarRepository, shareRepo calendar.ShareRepository) Policy {
+	return &policy{
+		calendarRepo: calendarRepo,
+		shareRepo:    shareRepo,
+	}
+}
+
+func (p *policy) Authorize(userID domainuser.UserID, calendarID calendar.CalendarID, required calendar.AccessLevel) (calendar.Calendar, error) {
+	foundCalendar, err := p.calendarRepo.FindByID(calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	if foundCalendar.UserID() == userID {
+		return foundCalendar, nil
+	}
+
+	foundShare, err := p.shareRepo.FindByCalendarIDAndUserID(calendarID, userID)
+	if errors.Is(err, calendar.ErrShareNotFound) {
+		return nil, calendar.ErrPermissionDenied
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !foundShare.AccessLevel().Allows(required) {
+		return nil, calendar.ErrPermissionDenied
+	}
+
+	return foundCalendar, nil
+}
+
+func (p *policy) AccessibleCalendarIDs(userID domainuser.UserID, required calendar.AccessLevel) ([]calendar.CalendarID, error) {
+	calendars, err := p.calendarRepo.FindAllByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := p.shareRepo.FindAllByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]calendar.CalendarID, 0, len(calendars)+len(shares))
+	for _, c := range calendars {
+		ids = append(ids, c.ID())
+	}
+	for _, s := range shares {
+		if s.AccessLevel().Allows(required) {
+			ids = append(ids, s.CalendarID())
+		}
+	}
+
+	return ids, nil
+}
+
+func (p *policy) VisibleCalendarIDs(viewerID, ownerID domainuser.UserID, required calendar.AccessLevel) ([]calendar.CalendarID, error) {
+	calendars, err := p.calendarRepo.FindAllByUserID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]calendar.CalendarID, 0, len(calendars))
+	if viewerID == ownerID {
+		for _, c := range calendars {
+			ids = append(ids, c.ID())
+		}
+		return ids, nil
+	}
+
+	shares, err := p.shareRepo.FindAllByUserID(viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := make(map[calendar.CalendarID]calendar.AccessLevel, len(shares))
+	for _, s := range shares {
+		levels[s.CalendarID()] = s.AccessLevel()
+	}
+	for _, c := range calendars {
+		if level, ok := levels[c.ID()]; ok && level.Allows(required) {
+			ids = append(ids, c.ID())
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,194 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	mocks "github.com/qkitzero/event-service/mocks/domain/calendar"
+)
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+	ownerID := domainuser.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}
+	otherID := domainuser.UserID{UUID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+	tests := []struct {
+		name        string
+		success     bool
+		userID      domainuser.UserID
+		required    calendar.AccessLevel
+		shareLevel  calendar.AccessLevel
+		findByIDErr error
+		findByErr   error
+		expectedErr error
+	}{
+		{"success owner", true, ownerID, calendar.AccessLevelOwner, "", nil, nil, nil},
+		{"success share allows required level", true, otherID, calendar.AccessLevelRead, calendar.AccessLevelWrite, nil, nil, nil},
+		{"success share equals required level", true, otherID, calendar.AccessLevelManage, calendar.AccessLevelManage, nil, nil, nil},
+		{"failure share below required level", false, otherID, calendar.AccessLevelRead, calendar.AccessLevelFreeBusy, nil, nil, calendar.ErrPermissionDenied},
+		{"failure share cannot act as owner", false, otherID, calendar.AccessLevelOwner, calendar.AccessLevelManage, nil, nil, calendar.ErrPermissionDenied},
+		{"failure no share", false, otherID, calendar.AccessLevelFreeBusy, "", nil, calendar.ErrShareNotFound, calendar.ErrPermissionDenied},
+		{"failure calendar not found", false, ownerID, calendar.AccessLevelRead, "", calendar.ErrCalendarNotFound, nil, calendar.ErrCalendarNotFound},
+		{"failure find share error", false, otherID, calendar.AccessLevelRead, "", nil, errors.New("find share error"), nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			calendarID := calendar.NewCalendarID()
+			foundCalendar := calendar.NewCalendar(calendarID, ownerID, calendar.Name("Work"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), false, time.Now(), time.Now())
+			foundShare := calendar.NewShare(calendarID, otherID, tt.shareLevel, time.Now(), time.Now())
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockCalendarRepository.EXPECT().FindByID(calendarID).Return(foundCalendar, tt.findByIDErr).AnyTimes()
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockShareRepository.EXPECT().FindByCalendarIDAndUserID(calendarID, tt.userID).Return(foundShare, tt.findByErr).AnyTimes()
+
+			policy := NewPolicy(mockCalendarRepository, mockShareRepository)
+
+			_, err := policy.Authorize(tt.userID, calendarID, tt.required)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, but got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestAccessibleCalendarIDs(t *testing.T) {
+	t.Parallel()
+	userID := domainuser.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}
+	ownID := calendar.NewCalendarID()
+	readID := calendar.NewCalendarID()
+	freeBusyID := calendar.NewCalendarID()
+	tests := []struct {
+		name               string
+		success            bool
+		required           calendar.AccessLevel
+		findAllByUserIDErr error
+		findAllSharesErr   error
+		expectedIDs        []calendar.CalendarID
+	}{
+		{"success free busy", true, calendar.AccessLevelFreeBusy, nil, nil, []calendar.CalendarID{ownID, readID, freeBusyID}},
+		{"success read", true, calendar.AccessLevelRead, nil, nil, []calendar.CalendarID{ownID, readID}},
+		{"success write", true, calendar.AccessLevelWrite, nil, nil, []calendar.CalendarID{ownID}},
+		{"failure find all by user id error", false, calendar.AccessLevelRead, errors.New("find all by user id error"), nil, nil},
+		{"failure find all shares error", false, calendar.AccessLevelRead, nil, errors.New("find all shares error"), nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ownCalendar := calendar.NewCalendar(ownID, userID, calendar.Name("Work"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), false, time.Now(), time.Now())
+			shares := []calendar.Share{
+				calendar.NewShare(readID, userID, calendar.AccessLevelRead, time.Now(), time.Now()),
+				calendar.NewShare(freeBusyID, userID, calendar.AccessLevelFreeBusy, time.Now(), time.Now()),
+			}
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockCalendarRepository.EXPECT().FindAllByUserID(userID).Return([]calendar.Calendar{ownCalendar}, tt.findAllByUserIDErr).AnyTimes()
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockShareRepository.EXPECT().FindAllByUserID(userID).Return(shares, tt.findAllSharesErr).AnyTimes()
+
+			policy := NewPolicy(mockCalendarRepository, mockShareRepository)
+
+			ids, err := policy.AccessibleCalendarIDs(userID, tt.required)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(ids) != len(tt.expectedIDs) {
+				t.Fatalf("len(ids) = %d, want %d", len(ids), len(tt.expectedIDs))
+			}
+			for i := range tt.expectedIDs {
+				if ids[i] != tt.expectedIDs[i] {
+					t.Errorf("ids[%d] = %v, want %v", i, ids[i], tt.expectedIDs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestVisibleCalendarIDs(t *testing.T) {
+	t.Parallel()
+	ownerID := domainuser.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}
+	viewerID := domainuser.UserID{UUID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+	workID := calendar.NewCalendarID()
+	homeID := calendar.NewCalendarID()
+	privateID := calendar.NewCalendarID()
+	tests := []struct {
+		name               string
+		success            bool
+		viewerID           domainuser.UserID
+		required           calendar.AccessLevel
+		findAllByUserIDErr error
+		findAllSharesErr   error
+		expectedIDs        []calendar.CalendarID
+	}{
+		{"success owner sees every calendar", true, ownerID, calendar.AccessLevelFreeBusy, nil, nil, []calendar.CalendarID{workID, homeID, privateID}},
+		{"success free busy", true, viewerID, calendar.AccessLevelFreeBusy, nil, nil, []calendar.CalendarID{workID, homeID}},
+		{"success read", true, viewerID, calendar.AccessLevelRead, nil, nil, []calendar.CalendarID{workID}},
+		{"failure find all by user id error", false, viewerID, calendar.AccessLevelFreeBusy, errors.New("find all by user id error"), nil, nil},
+		{"failure find all shares error", false, viewerID, calendar.AccessLevelFreeBusy, nil, errors.New("find all shares error"), nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			calendars := []calendar.Calendar{
+				calendar.NewCalendar(workID, ownerID, calendar.Name("Work"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now()),
+				calendar.NewCalendar(homeID, ownerID, calendar.Name("Home"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), false, time.Now(), time.Now()),
+				calendar.NewCalendar(privateID, ownerID, calendar.Name("Private"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), false, time.Now(), time.Now()),
+			}
+			shares := []calendar.Share{
+				calendar.NewShare(workID, viewerID, calendar.AccessLevelRead, time.Now(), time.Now()),
+				calendar.NewShare(homeID, viewerID, calendar.AccessLevelFreeBusy, time.Now(), time.Now()),
+				calendar.NewShare(calendar.NewCalendarID(), viewerID, calendar.AccessLevelManage, time.Now(), time.Now()),
+			}
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockCalendarRepository.EXPECT().FindAllByUserID(ownerID).Return(calendars, tt.findAllByUserIDErr).AnyTimes()
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockShareRepository.EXPECT().FindAllByUserID(viewerID).Return(shares, tt.findAllSharesErr).AnyTimes()
+
+			policy := NewPolicy(mockCalendarRepository, mockShareRepository)
+
+			ids, err := policy.VisibleCalendarIDs(tt.viewerID, ownerID, tt.required)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(ids) != len(tt.expectedIDs) {
+				t.Fatalf("len(ids) = %d, want %d", len(ids), len(tt.expectedIDs))
+			}
+			for i := range tt.expectedIDs {
+				if ids[i] != tt.expectedIDs[i] {
+					t.Errorf("ids[%d] = %v, want %v", i, ids[i], tt.expectedIDs[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+)
+
+var updatableFields = []string{"name", "color", "time_zone"}
+
+type CalendarUsecase interface {
+	CreateCalendar(ctx context.Context, name, color, timeZone string) (calendar.Calendar, error)
+	GetCalendar(ctx context.Context, calendarID string) (calendar.Calendar, error)
+	ListCalendars(ctx context.Context) ([]calendar.Calendar, error)
+	UpdateCalendar(ctx context.Context, calendarID, name, color, timeZone string, updateMask []string) (calendar.Calendar, error)
+	DeleteCalendar(ctx context.Context, calendarID string) error
+	ShareCalendar(ctx context.Context, calendarID, userID, accessLevel string) (calendar.Share, error)
+	RevokeShare(ctx context.Context, calendarID, userID string) error
+	ListShares(ctx context.Context, calendarID string) ([]calendar.Share, error)
+}
+
+type calendarUsecase struct {
+	calendarRepo calendar.CalendarRepository
+	shareRepo    calendar.ShareRepository
+	policy       Policy
+}
+
+func NewCalendarUsecase(calendarRepo calendar.CalendarRepository, shareRepo calendar.ShareRepository, policy Policy) CalendarUsecase {
+	return &calendarUsecase{
+		calendarRepo: calendarRepo,
+		shareRepo:    shareRepo,
+		policy:       policy,
+	}
+}
+
+func (s *calendarUsecase) CreateCalendar(ctx context.Context, name, color, timeZone string) (calendar.Calendar, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	newName, err := calendar.NewName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	newColor, err := calendar.NewColor(color)
+	if err != nil {
+		return nil, err
+	}
+
+	newTimeZone, err := calendar.NewTimeZone(timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the user's first calendar is the default one.
+	if _, err := DefaultCalendar(s.calendarRepo, uid); err != nil {
+		return nil, err
+	}
+
+	newCalendar := calendar.NewCalendar(calendar.NewCalendarID(), uid, newName, newColor, newTimeZone, false, time.Now(), time.Now())
+
+	if err := s.calendarRepo.Create(newCalendar); err != nil {
+		return nil, err
+	}
+
+	return newCalendar, nil
+}
+
+func (s *calendarUsecase) GetCalendar(ctx context.Context, calendarID string) (calendar.Calendar, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authorize(principal, calendarID, calendar.AccessLevelFreeBusy)
+}
+
+// ListCalendars lists the caller's own calendars followed by the calendars
+// shared with them.
+func (s *calendarUsecase) ListCalendars(ctx context.Context) ([]calendar.Calendar, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := DefaultCalendar(s.calendarRepo, uid); err != nil {
+		return nil, err
+	}
+
+	calendars, err := s.calendarRepo.FindAllByUserID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.shareRepo.FindAllByUserID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, share := range shares {
+		sharedCalendar, err := s.calendarRepo.FindByID(share.CalendarID())
+		if errors.Is(err, calendar.ErrCalendarNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, sharedCalendar)
+	}
+
+	return calendars, nil
+}
+
+func (s *calendarUsecase) UpdateCalendar(ctx context.Context, calendarID, name, color, timeZone string, updateMask []string) (calendar.Calendar, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	foundCalendar, err := s.authorize(principal, calendarID, calendar.AccessLevelManage)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := parseUpdateMask(updateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	newName := foundCalendar.Name()
+	if fields["name"] {
+		newName, err = calendar.NewName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newColor := foundCalendar.Color()
+	if fields["color"] {
+		newColor, err = calendar.NewColor(color)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newTimeZone := foundCalendar.TimeZone()
+	if fields["time_zone"] {
+		newTimeZone, err = calendar.NewTimeZone(timeZone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	foundCalendar.Update(newName, newColor, newTimeZone)
+
+	if err := s.calendarRepo.Update(foundCalendar); err != nil {
+		return nil, err
+	}
+
+	return foundCalendar, nil
+}
+
+// DeleteCalendar deletes a calendar, moving its events to the trash of the
+// owner's default calendar.
+func (s *calendarUsecase) DeleteCalendar(ctx context.Context, calendarID string) error {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	foundCalendar, err := s.authorize(principal, calendarID, calendar.AccessLevelOwner)
+	if err != nil {
+		return err
+	}
+
+	if foundCalendar.IsDefault() {
+		return calendar.ErrDefaultCalendarDelete
+	}
+
+	return s.calendarRepo.Delete(foundCalendar.ID())
+}
+
+// ShareCalendar grants userID the access level on the calendar, replacing any
+// level granted before. It requires manage access.
+func (s *calendarUsecase) ShareCalendar(ctx context.Context, calendarID, userID, accessLevel string) (calendar.Share, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	foundCalendar, err := s.authorize(principal, calendarID, calendar.AccessLevelManage)
+	if err != nil {
+		return nil, err
+	}
+
+	shareUserID, err := domainuser.NewUserIDFromString(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", calendar.ErrInvalidShareUser, err)
+	}
+	if shareUserID == foundCalendar.UserID() {
+		return nil, fmt.Errorf("%w: the calendar is owned by the user", calendar.ErrInvalidShareUser)
+	}
+
+	newAccessLevel, err := calendar.NewAccessLevel(accessLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	newShare := calendar.NewShare(foundCalendar.ID(), shareUserID, newAccessLevel, time.Now(), time.Now())
+
+	if err := s.shareRepo.Save(newShare); err != nil {
+		return nil, err
+	}
+
+	return newShare, nil
+}
+
+// RevokeShare removes userID's access to the calendar. It requires manage
+// access, except that users may always revoke their own share.
+func (s *calendarUsecase) RevokeShare(ctx context.Context, calendarID, userID string) error {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	required := calendar.AccessLevelManage
+	if userID == principal.UserID {
+		required = calendar.AccessLevelFreeBusy
+	}
+
+	foundCalendar, err := s.authorize(principal, calendarID, required)
+	if err != nil {
+		return err
+	}
+
+	shareUserID, err := domainuser.NewUserIDFromString(userID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", calendar.ErrInvalidShareUser, err)
+	}
+
+	return s.shareRepo.Delete(foundCalendar.ID(), shareUserID)
+}
+
+func (s *calendarUsecase) ListShares(ctx context.Context, calendarID string) ([]calendar.Share, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	foundCalendar, err := s.authorize(principal, calendarID, calendar.AccessLevelManage)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.shareRepo.FindAllByCalendarID(foundCalendar.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return shares, nil
+}
+
+func (s *calendarUsecase) authorize(principal auth.Principal, calendarID string, required calendar.AccessLevel) (calendar.Calendar, error) {
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := calendar.NewCalendarIDFromString(calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.policy.Authorize(uid, id, required)
+}
+
+// DefaultCalendar returns the user's default calendar, creating it on first
+// use. If a concurrent request created it first, that calendar is returned.
+func DefaultCalendar(calendarRepo calendar.CalendarRepository, userID domainuser.UserID) (calendar.Calendar, error) {
+	foundCalendar, err := calendarRepo.FindDefaultByUserID(userID)
+	if err == nil {
+		return foundCalendar, nil
+	}
+	if !errors.Is(err, calendar.ErrCalendarNotFound) {
+		return nil, err
+	}
+
+	newCalendar := calendar.NewCalendar(calendar.NewCalendarID(), userID, calendar.Name(calendar.DefaultName), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now())
+	if err := calendarRepo.Create(newCalendar); err != nil {
+		if foundCalendar, findErr := calendarRepo.FindDefaultByUserID(userID); findErr == nil {
+			return foundCalendar, nil
+		}
+		return nil, err
+	}
+
+	return newCalendar, nil
+}
+
+// parseUpdateMask returns the set of fields to update. An empty mask or "*"
+// selects every updatable field.
+func parseUpdateMask(paths []string) (map[string]bool, error) {
+	fields := make(map[string]bool, len(updatableFields))
+	if len(paths) == 0 || (len(paths) == 1 && paths[0] == "*") {
+		for _, f := range updatableFields {
+			fields[f] = true
+		}
+		return fields, nil
+	}
+
+	for _, path := range paths {
+		if !slices.Contains(updatableFields, path) {
+			return nil, fmt.Errorf("%w: unknown field %q", calendar.ErrInvalidUpdateMask, path)
+		}
+		fields[path] = true
+	}
+
+	return fields, nil
+}
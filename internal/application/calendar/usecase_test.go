@@ -0,0 +1,513 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	mocksappcalendar "github.com/qkitzero/event-service/mocks/application/calendar"
+	mocks "github.com/qkitzero/event-service/mocks/domain/calendar"
+)
+
+func TestCreateCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                   string
+		success                bool
+		ctx                    context.Context
+		userID                 string
+		calendarName           string
+		color                  string
+		timeZone               string
+		findDefaultByUserIDErr error
+		createErr              error
+	}{
+		{"success create calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "Work", "#FF0000", "Asia/Tokyo", nil, nil},
+		{"success create calendar with defaults", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "Work", "", "", nil, nil},
+		{"success create first calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "Work", "", "", calendar.ErrCalendarNotFound, nil},
+		{"failure unauthenticated", false, context.Background(), "", "Work", "", "", nil, nil},
+		{"failure invalid user id", false, context.Background(), "invalid", "Work", "", "", nil, nil},
+		{"failure empty name", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", " ", "", "", nil, nil},
+		{"failure invalid color", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "Work", "red", "", nil, nil},
+		{"failure invalid time zone", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "Work", "", "Mars/Olympus_Mons", nil, nil},
+		{"failure find default by user id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "Work", "", "", errors.New("find default by user id error"), nil},
+		{"failure create error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "Work", "", "", nil, errors.New("create error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendar := mocks.NewMockCalendar(ctrl)
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockCalendarRepository.EXPECT().FindDefaultByUserID(gomock.Any()).Return(mockCalendar, tt.findDefaultByUserIDErr).AnyTimes()
+			mockCalendarRepository.EXPECT().Create(gomock.Any()).Return(tt.createErr).AnyTimes()
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+
+			calendarUsecase := NewCalendarUsecase(mockCalendarRepository, mockShareRepository, mockPolicy)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			createdCalendar, err := calendarUsecase.CreateCalendar(ctx, tt.calendarName, tt.color, tt.timeZone)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && createdCalendar.IsDefault() {
+				t.Errorf("IsDefault() = %v, want %v", createdCalendar.IsDefault(), false)
+			}
+		})
+	}
+}
+
+func TestGetCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		success      bool
+		ctx          context.Context
+		userID       string
+		calendarID   string
+		authorizeErr error
+	}{
+		{"success get calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", nil},
+		{"failure unauthenticated", false, context.Background(), "", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", nil},
+		{"failure invalid user id", false, context.Background(), "invalid", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", nil},
+		{"failure invalid calendar id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "invalid", nil},
+		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", calendar.ErrPermissionDenied},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendar := mocks.NewMockCalendar(ctrl)
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelFreeBusy).Return(mockCalendar, tt.authorizeErr).AnyTimes()
+
+			calendarUsecase := NewCalendarUsecase(mockCalendarRepository, mockShareRepository, mockPolicy)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := calendarUsecase.GetCalendar(ctx, tt.calendarID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestListCalendars(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                   string
+		success                bool
+		ctx                    context.Context
+		userID                 string
+		findDefaultByUserIDErr error
+		findAllByUserIDErr     error
+		findAllSharesErr       error
+		findByIDErr            error
+		expectedLen            int
+	}{
+		{"success list calendars", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, nil, nil, 2},
+		{"success skip deleted shared calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, nil, calendar.ErrCalendarNotFound, 1},
+		{"failure unauthenticated", false, context.Background(), "", nil, nil, nil, nil, 0},
+		{"failure invalid user id", false, context.Background(), "invalid", nil, nil, nil, nil, 0},
+		{"failure find default by user id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", errors.New("find default by user id error"), nil, nil, nil, 0},
+		{"failure find all by user id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, errors.New("find all by user id error"), nil, nil, 0},
+		{"failure find all shares error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, errors.New("find all shares error"), nil, 0},
+		{"failure find by id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, nil, errors.New("find by id error"), 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendar := mocks.NewMockCalendar(ctrl)
+			mockShare := mocks.NewMockShare(ctrl)
+			mockShare.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockCalendarRepository.EXPECT().FindDefaultByUserID(gomock.Any()).Return(mockCalendar, tt.findDefaultByUserIDErr).AnyTimes()
+			mockCalendarRepository.EXPECT().FindAllByUserID(gomock.Any()).Return([]calendar.Calendar{mockCalendar}, tt.findAllByUserIDErr).AnyTimes()
+			mockCalendarRepository.EXPECT().FindByID(gomock.Any()).Return(mockCalendar, tt.findByIDErr).AnyTimes()
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockShareRepository.EXPECT().FindAllByUserID(gomock.Any()).Return([]calendar.Share{mockShare}, tt.findAllSharesErr).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+
+			calendarUsecase := NewCalendarUsecase(mockCalendarRepository, mockShareRepository, mockPolicy)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			calendars, err := calendarUsecase.ListCalendars(ctx)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(calendars) != tt.expectedLen {
+				t.Errorf("len(calendars) = %d, want %d", len(calendars), tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestUpdateCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		success      bool
+		ctx          context.Context
+		userID       string
+		calendarID   string
+		calendarName string
+		color        string
+		timeZone     string
+		updateMask   []string
+		authorizeErr error
+		updateErr    error
+	}{
+		{"success update calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "#FF0000", "Asia/Tokyo", nil, nil, nil},
+		{"success update name only", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "", "", []string{"name"}, nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "", "", nil, nil, nil},
+		{"failure invalid calendar id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "invalid", "Work", "", "", nil, nil, nil},
+		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "", "", nil, calendar.ErrPermissionDenied, nil},
+		{"failure unknown update mask path", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "", "", []string{"is_default"}, nil, nil},
+		{"failure empty name", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "", "", "", []string{"name"}, nil, nil},
+		{"failure invalid color", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "red", "", nil, nil, nil},
+		{"failure invalid time zone", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "", "Asia/Nowhere", []string{"time_zone"}, nil, nil},
+		{"failure update error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "", "", nil, nil, errors.New("update error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendar := mocks.NewMockCalendar(ctrl)
+			mockCalendar.EXPECT().Name().Return(calendar.Name("Default")).AnyTimes()
+			mockCalendar.EXPECT().Color().Return(calendar.Color("#FFFFFF")).AnyTimes()
+			mockCalendar.EXPECT().TimeZone().Return(calendar.TimeZone("UTC")).AnyTimes()
+			mockCalendar.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockCalendarRepository.EXPECT().Update(gomock.Any()).Return(tt.updateErr).AnyTimes()
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelManage).Return(mockCalendar, tt.authorizeErr).AnyTimes()
+
+			calendarUsecase := NewCalendarUsecase(mockCalendarRepository, mockShareRepository, mockPolicy)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := calendarUsecase.UpdateCalendar(ctx, tt.calendarID, tt.calendarName, tt.color, tt.timeZone, tt.updateMask)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestDeleteCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		success      bool
+		ctx          context.Context
+		userID       string
+		calendarID   string
+		isDefault    bool
+		authorizeErr error
+		deleteErr    error
+	}{
+		{"success delete calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", false, nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", false, nil, nil},
+		{"failure invalid calendar id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "invalid", false, nil, nil},
+		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", false, calendar.ErrPermissionDenied, nil},
+		{"failure default calendar", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", true, nil, nil},
+		{"failure delete error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", false, nil, errors.New("delete error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendar := mocks.NewMockCalendar(ctrl)
+			mockCalendar.EXPECT().ID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockCalendar.EXPECT().IsDefault().Return(tt.isDefault).AnyTimes()
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockCalendarRepository.EXPECT().Delete(gomock.Any()).Return(tt.deleteErr).AnyTimes()
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelOwner).Return(mockCalendar, tt.authorizeErr).AnyTimes()
+
+			calendarUsecase := NewCalendarUsecase(mockCalendarRepository, mockShareRepository, mockPolicy)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			err := calendarUsecase.DeleteCalendar(ctx, tt.calendarID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestShareCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		success        bool
+		ctx            context.Context
+		calendarUserID string
+		userID         string
+		calendarID     string
+		shareUserID    string
+		accessLevel    string
+		authorizeErr   error
+		saveErr        error
+	}{
+		{"success share calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", "read", nil, nil},
+		{"failure unauthenticated", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", "read", nil, nil},
+		{"failure invalid calendar id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "invalid", "00000000-0000-0000-0000-000000000001", "read", nil, nil},
+		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", "read", calendar.ErrPermissionDenied, nil},
+		{"failure invalid share user id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "invalid", "read", nil, nil},
+		{"failure share with owner", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "6d322c66-bf4d-427a-970c-874f3745f653", "read", nil, nil},
+		{"failure invalid access level", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", "owner", nil, nil},
+		{"failure save error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", "read", nil, errors.New("save error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendar := mocks.NewMockCalendar(ctrl)
+			mockCalendar.EXPECT().ID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockCalendar.EXPECT().UserID().Return(domainuser.UserID{UUID: uuid.MustParse(tt.calendarUserID)}).AnyTimes()
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockShareRepository.EXPECT().Save(gomock.Any()).Return(tt.saveErr).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelManage).Return(mockCalendar, tt.authorizeErr).AnyTimes()
+
+			calendarUsecase := NewCalendarUsecase(mockCalendarRepository, mockShareRepository, mockPolicy)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			share, err := calendarUsecase.ShareCalendar(ctx, tt.calendarID, tt.shareUserID, tt.accessLevel)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && share.AccessLevel().String() != tt.accessLevel {
+				t.Errorf("AccessLevel() = %v, want %v", share.AccessLevel(), tt.accessLevel)
+			}
+		})
+	}
+}
+
+func TestRevokeShare(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		success       bool
+		ctx           context.Context
+		userID        string
+		calendarID    string
+		shareUserID   string
+		requiredLevel calendar.AccessLevel
+		authorizeErr  error
+		deleteErr     error
+	}{
+		{"success revoke share", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", calendar.AccessLevelManage, nil, nil},
+		{"success revoke own share", true, context.Background(), "00000000-0000-0000-0000-000000000001", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", calendar.AccessLevelFreeBusy, nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", calendar.AccessLevelManage, nil, nil},
+		{"failure invalid calendar id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "invalid", "00000000-0000-0000-0000-000000000001", calendar.AccessLevelManage, nil, nil},
+		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", calendar.AccessLevelManage, calendar.ErrPermissionDenied, nil},
+		{"failure invalid share user id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "invalid", calendar.AccessLevelManage, nil, nil},
+		{"failure delete error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", calendar.AccessLevelManage, nil, calendar.ErrShareNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendar := mocks.NewMockCalendar(ctrl)
+			mockCalendar.EXPECT().ID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockShareRepository.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(tt.deleteErr).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), tt.requiredLevel).Return(mockCalendar, tt.authorizeErr).AnyTimes()
+
+			calendarUsecase := NewCalendarUsecase(mockCalendarRepository, mockShareRepository, mockPolicy)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			err := calendarUsecase.RevokeShare(ctx, tt.calendarID, tt.shareUserID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestListShares(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                   string
+		success                bool
+		ctx                    context.Context
+		userID                 string
+		calendarID             string
+		authorizeErr           error
+		findAllByCalendarIDErr error
+	}{
+		{"success list shares", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", nil, nil},
+		{"failure invalid calendar id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "invalid", nil, nil},
+		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", calendar.ErrPermissionDenied, nil},
+		{"failure find all by calendar id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", nil, errors.New("find all by calendar id error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendar := mocks.NewMockCalendar(ctrl)
+			mockCalendar.EXPECT().ID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockShare := mocks.NewMockShare(ctrl)
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockShareRepository := mocks.NewMockShareRepository(ctrl)
+			mockShareRepository.EXPECT().FindAllByCalendarID(gomock.Any()).Return([]calendar.Share{mockShare}, tt.findAllByCalendarIDErr).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelManage).Return(mockCalendar, tt.authorizeErr).AnyTimes()
+
+			calendarUsecase := NewCalendarUsecase(mockCalendarRepository, mockShareRepository, mockPolicy)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := calendarUsecase.ListShares(ctx, tt.calendarID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestDefaultCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                   string
+		success                bool
+		findDefaultByUserIDErr error
+		createErr              error
+		expectedDefault        bool
+	}{
+		{"success existing default calendar", true, nil, nil, false},
+		{"success create default calendar", true, calendar.ErrCalendarNotFound, nil, true},
+		{"failure find default by user id error", false, errors.New("find default by user id error"), nil, false},
+		{"failure create error", false, calendar.ErrCalendarNotFound, errors.New("create error"), false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			existingCalendar := calendar.NewCalendar(calendar.NewCalendarID(), domainuser.UserID{UUID: uuid.New()}, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), false, time.Now(), time.Now())
+			mockCalendarRepository := mocks.NewMockCalendarRepository(ctrl)
+			mockCalendarRepository.EXPECT().FindDefaultByUserID(gomock.Any()).Return(existingCalendar, tt.findDefaultByUserIDErr).AnyTimes()
+			mockCalendarRepository.EXPECT().Create(gomock.Any()).Return(tt.createErr).AnyTimes()
+
+			defaultCalendar, err := DefaultCalendar(mockCalendarRepository, domainuser.UserID{UUID: uuid.New()})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && defaultCalendar.IsDefault() != tt.expectedDefault {
+				t.Errorf("IsDefault() = %v, want %v", defaultCalendar.IsDefault(), tt.expectedDefault)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package event
+
+import (
+	"context"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+// Notifier delivers due reminders. A reminder that Notify fails on is handed
+// to it again on a later dispatch, until it is more than missedReminderGrace
+// late, so Notify may see a reminder more than once.
+type Notifier interface {
+	Notify(ctx context.Context, reminder event.DueReminder) error
+}
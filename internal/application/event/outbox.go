@@ -0,0 +1,67 @@
+package event
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+// OutboxRelay publishes the changes recorded in the outbox. A change may be
+// published more than once, so subscribers should deduplicate by its ID.
+type OutboxRelay struct {
+	eventRepo event.EventRepository
+	publisher Publisher
+	interval  time.Duration
+	batchSize int
+}
+
+func NewOutboxRelay(
+	eventRepo event.EventRepository,
+	publisher Publisher,
+	interval time.Duration,
+	batchSize int,
+) *OutboxRelay {
+	return &OutboxRelay{
+		eventRepo: eventRepo,
+		publisher: publisher,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run relays changes every interval until ctx is done.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.Relay(ctx); err != nil {
+				log.Printf("failed to relay outbox: %v", err)
+			}
+		}
+	}
+}
+
+// Relay publishes batches of changes until none are left or publishing
+// fails, and returns how many were published.
+func (r *OutboxRelay) Relay(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		n, err := r.eventRepo.RelayChanges(r.batchSize, func(change event.Change) error {
+			return r.publisher.Publish(ctx, change)
+		})
+		published += n
+		if err != nil {
+			return published, err
+		}
+		if n < r.batchSize {
+			return published, nil
+		}
+	}
+}
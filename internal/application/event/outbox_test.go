@@ -0,0 +1,72 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/infrastructure/publisher"
+	mocks "github.com/qkitzero/event-service/mocks/domain/event"
+)
+
+func TestRelay(t *testing.T) {
+	t.Parallel()
+	change := event.Change{ID: 1, Type: event.ChangeTypeCreated, EventID: event.NewEventID(), OccurredAt: time.Now()}
+	tests := []struct {
+		name              string
+		success           bool
+		batches           [][]event.Change
+		relayErr          error
+		expectedPublished int
+	}{
+		{"success relay changes", true, [][]event.Change{{change}}, nil, 1},
+		{"success relay several batches", true, [][]event.Change{{change, change}, {change}}, nil, 3},
+		{"success no changes", true, [][]event.Change{nil}, nil, 0},
+		{"failure relay changes error", false, [][]event.Change{nil}, errors.New("relay changes error"), 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			var calls []any
+			for _, batch := range tt.batches {
+				batch := batch
+				calls = append(calls, mockEventRepository.EXPECT().RelayChanges(2, gomock.Any()).DoAndReturn(func(limit int, publish func(event.Change) error) (int, error) {
+					for _, c := range batch {
+						if err := publish(c); err != nil {
+							return 0, err
+						}
+					}
+					return len(batch), tt.relayErr
+				}).Times(1))
+			}
+			gomock.InOrder(calls...)
+			memoryPublisher := publisher.NewMemoryPublisher()
+
+			relay := NewOutboxRelay(mockEventRepository, memoryPublisher, time.Second, 2)
+
+			published, err := relay.Relay(context.Background())
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if published != tt.expectedPublished {
+				t.Errorf("Relay() = %v, want %v", published, tt.expectedPublished)
+			}
+			if len(memoryPublisher.Changes()) != tt.expectedPublished {
+				t.Errorf("len(Changes()) = %v, want %v", len(memoryPublisher.Changes()), tt.expectedPublished)
+			}
+		})
+	}
+}
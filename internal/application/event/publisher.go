@@ -0,0 +1,29 @@
+package event
+
+import (
+	"context"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+// Publisher hands changes on to consumers. Delivery is at least once: a
+// change is published again when relaying it fails, so consumers dedupe
+// changes by their ID.
+type Publisher interface {
+	Publish(ctx context.Context, change event.Change) error
+}
+
+// Publishers publishes each change to every publisher in turn and stops at
+// the first error. A change that is relayed again reaches the earlier
+// publishers twice, so publishers that drop repeated changes go first.
+type Publishers []Publisher
+
+func (p Publishers) Publish(ctx context.Context, change event.Change) error {
+	for _, publisher := range p {
+		if err := publisher.Publish(ctx, change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
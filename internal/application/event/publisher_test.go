@@ -0,0 +1,58 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/infrastructure/publisher"
+)
+
+type publisherFunc func(ctx context.Context, change event.Change) error
+
+func (f publisherFunc) Publish(ctx context.Context, change event.Change) error {
+	return f(ctx, change)
+}
+
+func TestPublishers(t *testing.T) {
+	t.Parallel()
+	change := event.Change{ID: 1, Type: event.ChangeTypeCreated, EventID: event.NewEventID(), OccurredAt: time.Now()}
+	tests := []struct {
+		name          string
+		success       bool
+		publishErr    error
+		expectedFirst int
+		expectedLast  int
+	}{
+		{"success publish to every publisher", true, nil, 1, 1},
+		{"failure publish error", false, errors.New("publish error"), 1, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			first := publisher.NewMemoryPublisher()
+			last := publisher.NewMemoryPublisher()
+			failing := publisherFunc(func(ctx context.Context, change event.Change) error {
+				return tt.publishErr
+			})
+
+			err := Publishers{first, failing, last}.Publish(context.Background(), change)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(first.Changes()) != tt.expectedFirst {
+				t.Errorf("len(first.Changes()) = %v, want %v", len(first.Changes()), tt.expectedFirst)
+			}
+			if len(last.Changes()) != tt.expectedLast {
+				t.Errorf("len(last.Changes()) = %v, want %v", len(last.Changes()), tt.expectedLast)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package event
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+// TrashPurger permanently removes events that have been in the trash for
+// longer than the retention period, and published changes older than the
+// change retention period.
+type TrashPurger struct {
+	eventRepo       event.EventRepository
+	retention       time.Duration
+	changeRetention time.Duration
+	interval        time.Duration
+}
+
+func NewTrashPurger(
+	eventRepo event.EventRepository,
+	retention time.Duration,
+	changeRetention time.Duration,
+	interval time.Duration,
+) *TrashPurger {
+	return &TrashPurger{
+		eventRepo:       eventRepo,
+		retention:       retention,
+		changeRetention: changeRetention,
+		interval:        interval,
+	}
+}
+
+// Run purges expired events and changes every interval until ctx is done.
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := p.Purge(now); err != nil {
+				log.Printf("failed to purge deleted events: %v", err)
+			}
+			if _, err := p.PurgeChanges(now); err != nil {
+				log.Printf("failed to purge published changes: %v", err)
+			}
+		}
+	}
+}
+
+func (p *TrashPurger) Purge(now time.Time) (int64, error) {
+	return p.eventRepo.PurgeDeletedBefore(now.Add(-p.retention))
+}
+
+func (p *TrashPurger) PurgeChanges(now time.Time) (int64, error) {
+	return p.eventRepo.PurgePublishedChangesBefore(now.Add(-p.changeRetention))
+}
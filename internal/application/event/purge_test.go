@@ -0,0 +1,87 @@
+package event
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	mocks "github.com/qkitzero/event-service/mocks/domain/event"
+)
+
+func TestPurge(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		success        bool
+		retention      time.Duration
+		expectedBefore time.Time
+		purgeErr       error
+	}{
+		{"success purge", true, 30 * 24 * time.Hour, time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC), nil},
+		{"success purge zero retention", true, 0, now, nil},
+		{"failure purge error", false, 30 * 24 * time.Hour, time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC), errors.New("purge error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockEventRepository.EXPECT().PurgeDeletedBefore(tt.expectedBefore).Return(int64(1), tt.purgeErr).Times(1)
+
+			purger := NewTrashPurger(mockEventRepository, tt.retention, 24*time.Hour, time.Hour)
+
+			_, err := purger.Purge(now)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestPurgeChanges(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name            string
+		success         bool
+		changeRetention time.Duration
+		expectedBefore  time.Time
+		purgeErr        error
+	}{
+		{"success purge changes", true, 30 * 24 * time.Hour, time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC), nil},
+		{"success purge changes zero retention", true, 0, now, nil},
+		{"failure purge changes error", false, 30 * 24 * time.Hour, time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC), errors.New("purge error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockEventRepository.EXPECT().PurgePublishedChangesBefore(tt.expectedBefore).Return(int64(1), tt.purgeErr).Times(1)
+
+			purger := NewTrashPurger(mockEventRepository, 24*time.Hour, tt.changeRetention, time.Hour)
+
+			_, err := purger.PurgeChanges(now)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
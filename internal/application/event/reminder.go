@@ -0,0 +1,101 @@
+package event
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+// missedReminderGrace is how late a reminder may still be delivered, for
+// example after the scheduler was down or the event was restored.
+const missedReminderGrace = time.Hour
+
+// ReminderScheduler polls for due reminders and hands them to a notifier.
+// Each reminder is claimed by one of the running schedulers at a time. One
+// the notifier fails on is released after the dispatch and retried by the
+// next one.
+type ReminderScheduler struct {
+	eventRepo event.EventRepository
+	notifier  Notifier
+	interval  time.Duration
+	batchSize int
+}
+
+func NewReminderScheduler(
+	eventRepo event.EventRepository,
+	notifier Notifier,
+	interval time.Duration,
+	batchSize int,
+) *ReminderScheduler {
+	return &ReminderScheduler{
+		eventRepo: eventRepo,
+		notifier:  notifier,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run dispatches due reminders every interval until ctx is done.
+func (s *ReminderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			_, skipped, err := s.Dispatch(ctx, now)
+			if err != nil {
+				log.Printf("failed to dispatch reminders: %v", err)
+			}
+			if skipped > 0 {
+				log.Printf("skipped %d reminders more than %s late", skipped, missedReminderGrace)
+			}
+		}
+	}
+}
+
+// Dispatch claims batches of reminders due by now until none are left and
+// returns how many were delivered and how many were skipped for being more
+// than missedReminderGrace late. Reminders the notifier fails on are released
+// only once all batches are claimed, so that they are not claimed again
+// within the same dispatch.
+func (s *ReminderScheduler) Dispatch(ctx context.Context, now time.Time) (int, int, error) {
+	delivered, skipped := 0, 0
+	var failed []event.DueReminder
+	defer func() {
+		for _, dueReminder := range failed {
+			if err := s.eventRepo.ReleaseReminder(dueReminder); err != nil {
+				log.Printf("failed to release reminder for event %s: %v", dueReminder.EventID, err)
+			}
+		}
+	}()
+
+	for {
+		dueReminders, err := s.eventRepo.ClaimDueReminders(now, s.batchSize)
+		if err != nil {
+			return delivered, skipped, err
+		}
+
+		for _, dueReminder := range dueReminders {
+			if now.Sub(dueReminder.TriggerTime) > missedReminderGrace {
+				log.Printf("skipped missed reminder for event %s at %s", dueReminder.EventID, dueReminder.TriggerTime)
+				skipped++
+				continue
+			}
+			if err := s.notifier.Notify(ctx, dueReminder); err != nil {
+				log.Printf("failed to notify reminder for event %s: %v", dueReminder.EventID, err)
+				failed = append(failed, dueReminder)
+				continue
+			}
+			delivered++
+		}
+
+		if len(dueReminders) < s.batchSize {
+			return delivered, skipped, nil
+		}
+	}
+}
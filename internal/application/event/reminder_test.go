@@ -0,0 +1,83 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+	mocks "github.com/qkitzero/event-service/mocks/domain/event"
+)
+
+type notifierFunc func(ctx context.Context, reminder event.DueReminder) error
+
+func (f notifierFunc) Notify(ctx context.Context, reminder event.DueReminder) error {
+	return f(ctx, reminder)
+}
+
+func TestDispatch(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 1, 6, 9, 50, 0, 0, time.UTC)
+	dueReminder := event.DueReminder{EventID: event.NewEventID(), TriggerTime: now}
+	missedReminder := event.DueReminder{EventID: event.NewEventID(), TriggerTime: now.Add(-2 * time.Hour)}
+	tests := []struct {
+		name              string
+		success           bool
+		batches           [][]event.DueReminder
+		claimErr          error
+		notifyErr         error
+		releaseErr        error
+		expectedDelivered int
+		expectedSkipped   int
+		expectedReleased  int
+	}{
+		{"success dispatch reminders", true, [][]event.DueReminder{{dueReminder}}, nil, nil, nil, 1, 0, 0},
+		{"success dispatch several batches", true, [][]event.DueReminder{{dueReminder, dueReminder}, {dueReminder}}, nil, nil, nil, 3, 0, 0},
+		{"success no due reminders", true, [][]event.DueReminder{nil}, nil, nil, nil, 0, 0, 0},
+		{"success skip missed reminder", true, [][]event.DueReminder{{missedReminder}}, nil, nil, nil, 0, 1, 0},
+		{"success skip missed reminders across batches", true, [][]event.DueReminder{{missedReminder, dueReminder}, {missedReminder}}, nil, nil, nil, 1, 2, 0},
+		{"success notify error is not fatal", true, [][]event.DueReminder{{dueReminder}}, nil, errors.New("notify error"), nil, 0, 0, 1},
+		{"success failed reminders are released after the last batch", true, [][]event.DueReminder{{dueReminder, dueReminder}, {dueReminder}}, nil, errors.New("notify error"), nil, 0, 0, 3},
+		{"success release error is not fatal", true, [][]event.DueReminder{{dueReminder}}, nil, errors.New("notify error"), errors.New("release error"), 0, 0, 1},
+		{"failure claim due reminders error", false, [][]event.DueReminder{nil}, errors.New("claim due reminders error"), nil, nil, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			var calls []any
+			for _, batch := range tt.batches {
+				calls = append(calls, mockEventRepository.EXPECT().ClaimDueReminders(now, 2).Return(batch, tt.claimErr).Times(1))
+			}
+			calls = append(calls, mockEventRepository.EXPECT().ReleaseReminder(dueReminder).Return(tt.releaseErr).Times(tt.expectedReleased))
+			gomock.InOrder(calls...)
+			notifier := notifierFunc(func(ctx context.Context, reminder event.DueReminder) error {
+				return tt.notifyErr
+			})
+
+			scheduler := NewReminderScheduler(mockEventRepository, notifier, time.Minute, 2)
+
+			delivered, skipped, err := scheduler.Dispatch(context.Background(), now)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if delivered != tt.expectedDelivered {
+				t.Errorf("Dispatch() delivered = %v, want %v", delivered, tt.expectedDelivered)
+			}
+			if skipped != tt.expectedSkipped {
+				t.Errorf("Dispatch() skipped = %v, want %v", skipped, tt.expectedSkipped)
+			}
+		})
+	}
+}
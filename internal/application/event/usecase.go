@@ -2,138 +2,742 @@ package event
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
-	"github.com/qkitzero/event-service/internal/application/user"
+	"github.com/qkitzero/event-service/internal/application/auth"
+	appcalendar "github.com/qkitzero/event-service/internal/application/calendar"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
 	"github.com/qkitzero/event-service/internal/domain/event"
 	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	"google.golang.org/genproto/googleapis/type/date"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	defaultPageSize   = 100
+	maxPageSize       = 1000
+	maxFreeBusyUsers  = 50
+	maxFreeBusyWindow = 90 * 24 * time.Hour
+	maxListWindow     = 366 * 24 * time.Hour
+	defaultSlotCount  = 10
+	maxSlotCount      = 100
+	maxMeetingMinutes = 24 * 60
+	maxBufferMinutes  = 120
+	// syncSettleDelay holds back changes this recent from syncs, since an
+	// event updated earlier may not have been committed yet.
+	syncSettleDelay = time.Second
+)
+
+var updatableFields = []string{"calendar_id", "title", "description", "start_time", "end_time", "all_day", "start_date", "end_date", "time_zone", "color", "recurrence", "attendees", "reminders"}
+
+// outputOnlyFields are ignored in update masks, since clients that send back
+// the event they read include them. The etag is checked separately.
+var outputOnlyFields = []string{"id", "etag", "delete_time"}
+
 type EventUsecase interface {
-	CreateEvent(ctx context.Context, title, description string, startTime, endTime *timestamppb.Timestamp, color string) (event.Event, error)
-	UpdateEvent(ctx context.Context, eventID, title, description string, startTime, endTime *timestamppb.Timestamp, color string) (event.Event, error)
+	CreateEvent(ctx context.Context, input CreateEventInput) (event.Event, []event.Event, error)
+	UpdateEvent(ctx context.Context, input UpdateEventInput) (event.Event, []event.Event, error)
 	GetEvent(ctx context.Context, eventID string) (event.Event, error)
-	ListEvents(ctx context.Context) ([]event.Event, error)
-	DeleteEvent(ctx context.Context, eventID string) error
+	ListEvents(ctx context.Context, calendarIDs []string, startTime, endTime *timestamppb.Timestamp, pageSize int32, pageToken string) ([]event.Event, string, error)
+	DeleteEvent(ctx context.Context, eventID, etag string) error
+	ListDeletedEvents(ctx context.Context) ([]event.Event, error)
+	RestoreEvent(ctx context.Context, eventID string) (event.Event, error)
+	RespondToEvent(ctx context.Context, eventID, responseStatus string) (event.Event, error)
+	ExportEvents(ctx context.Context) ([]byte, error)
+	ImportEvents(ctx context.Context, data string) ([]ImportResult, error)
+	ListICalendarEvents(ctx context.Context, startTime, endTime *timestamppb.Timestamp) ([]event.Event, error)
+	GetEventByICalUID(ctx context.Context, icalUID string) (event.Event, error)
+	SaveICalendarEvent(ctx context.Context, icalUID, data, etag string) (event.Event, bool, error)
+	QueryFreeBusy(ctx context.Context, userIDs []string, startTime, endTime *timestamppb.Timestamp) ([]FreeBusy, error)
+	SuggestMeetingTimes(ctx context.Context, userIDs []string, durationMinutes int32, startTime, endTime *timestamppb.Timestamp, constraints SlotConstraints, maxResults int32) ([]event.Interval, error)
+	CheckConflicts(ctx context.Context, startTime, endTime *timestamppb.Timestamp, excludeEventID string) ([]event.Event, error)
+	SyncEvents(ctx context.Context, syncToken string, pageSize int32, pageToken string) ([]event.Event, []event.EventID, string, string, error)
+}
+
+// ConflictCheck says what CreateEvent and UpdateEvent do about other events
+// that keep the organizer busy at the same time.
+type ConflictCheck int
+
+const (
+	// ConflictCheckNone skips conflict detection.
+	ConflictCheckNone ConflictCheck = iota
+	// ConflictCheckReport saves the event and returns its conflicts.
+	ConflictCheckReport
+	// ConflictCheckReject refuses to save an event that has conflicts.
+	ConflictCheckReject
+)
+
+// CreateEventInput is an event as given by its organizer. Timed events take
+// StartTime and EndTime, and all-day events StartDate and EndDate.
+type CreateEventInput struct {
+	CalendarID    string
+	Title         string
+	Description   string
+	StartTime     *timestamppb.Timestamp
+	EndTime       *timestamppb.Timestamp
+	AllDay        bool
+	StartDate     *date.Date
+	EndDate       *date.Date
+	TimeZone      string
+	Color         string
+	Recurrence    []string
+	Invitees      []Invitee
+	Reminders     []Reminder
+	ConflictCheck ConflictCheck
+}
+
+// UpdateEventInput is the new state of the event EventID. Only the fields
+// named in UpdateMask are changed, and an empty ETag skips the check against
+// the event's current version.
+type UpdateEventInput struct {
+	EventID       string
+	CalendarID    string
+	Title         string
+	Description   string
+	StartTime     *timestamppb.Timestamp
+	EndTime       *timestamppb.Timestamp
+	AllDay        bool
+	StartDate     *date.Date
+	EndDate       *date.Date
+	TimeZone      string
+	Color         string
+	Recurrence    []string
+	Invitees      []Invitee
+	Reminders     []Reminder
+	UpdateMask    []string
+	ETag          string
+	ConflictCheck ConflictCheck
+}
+
+// Invitee is an attendee as given by the organizer, identified by either a
+// user ID or an email address.
+type Invitee struct {
+	UserID string
+	Email  string
+	Role   string
+}
+
+// Reminder is a reminder as given by the organizer. An empty channel
+// defaults to a popup.
+type Reminder struct {
+	OffsetMinutes int32
+	Channel       string
+}
+
+// ImportResult is the outcome of importing a single VEVENT. Err is set when
+// the VEVENT was skipped; otherwise Event holds the created or updated event.
+type ImportResult struct {
+	UID     string
+	Event   event.Event
+	Created bool
+	Err     error
+}
+
+// FreeBusy is when a single user is busy. Err is set when the caller may not
+// see the user's schedule.
+type FreeBusy struct {
+	UserID string
+	Busy   []event.Interval
+	Err    error
+}
+
+// SlotConstraints narrows down where SuggestMeetingTimes may place a meeting.
+// Working hours are "HH:MM" in TimeZone, which defaults to UTC; when both are
+// empty any time of day is allowed. EarliestStartTime and LatestEndTime, when
+// set, further narrow the search window.
+type SlotConstraints struct {
+	WorkingHoursStart string
+	WorkingHoursEnd   string
+	TimeZone          string
+	BufferMinutes     int32
+	EarliestStartTime *timestamppb.Timestamp
+	LatestEndTime     *timestamppb.Timestamp
 }
 
 type eventUsecase struct {
-	userService user.UserService
-	eventRepo   event.EventRepository
+	eventRepo    event.EventRepository
+	calendarRepo calendar.CalendarRepository
+	policy       appcalendar.Policy
+	maxDuration  time.Duration
+	syncWindow   time.Duration
 }
 
+// NewEventUsecase returns an EventUsecase. syncWindow is how long deleted
+// events and changes are kept, which bounds how old a sync token may be.
 func NewEventUsecase(
-	userService user.UserService,
 	eventRepo event.EventRepository,
+	calendarRepo calendar.CalendarRepository,
+	policy appcalendar.Policy,
+	maxDuration time.Duration,
+	syncWindow time.Duration,
 ) EventUsecase {
 	return &eventUsecase{
-		userService: userService,
-		eventRepo:   eventRepo,
+		eventRepo:    eventRepo,
+		calendarRepo: calendarRepo,
+		policy:       policy,
+		maxDuration:  maxDuration,
+		syncWindow:   syncWindow,
+	}
+}
+
+// CreateEvent creates an event in the given calendar, or in the caller's
+// default calendar when input.CalendarID is empty. The event belongs to the
+// owner of the calendar. An empty time zone or color is taken from the
+// calendar. The returned conflicts are only looked for as input.ConflictCheck
+// says.
+func (s *eventUsecase) CreateEvent(ctx context.Context, input CreateEventInput) (event.Event, []event.Event, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	foundCalendar, err := s.findCalendar(uid, input.CalendarID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newTitle, err := event.NewTitle(input.Title)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newDescription, err := event.NewDescription(input.Description)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newStartTime, newEndTime time.Time
+	if input.AllDay {
+		if input.StartDate == nil {
+			return nil, nil, event.ErrStartDateRequired
+		}
+		newStartTime, err = toDate(input.StartDate)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if input.EndDate == nil {
+			return nil, nil, event.ErrEndDateRequired
+		}
+		newEndTime, err = toDate(input.EndDate)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		if input.StartTime == nil {
+			return nil, nil, event.ErrStartTimeRequired
+		}
+		newStartTime = input.StartTime.AsTime()
+
+		if input.EndTime == nil {
+			return nil, nil, event.ErrEndTimeRequired
+		}
+		newEndTime = input.EndTime.AsTime()
+	}
+
+	timeRange, err := s.newTimeRange(input.AllDay, newStartTime, newEndTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	timeZone := input.TimeZone
+	if timeZone == "" {
+		timeZone = foundCalendar.TimeZone().String()
+	}
+	newTimeZone, err := event.NewTimeZone(timeZone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	color := input.Color
+	if color == "" {
+		color = foundCalendar.Color().String()
+	}
+	newColor, err := event.NewColor(color)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newRecurrence, err := event.NewRecurrenceIn(input.Recurrence, timeRange.AllDay(), newTimeZone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newAttendees, err := toAttendees(input.Invitees)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newReminders, err := toReminders(input.Reminders)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newEvent := event.NewEvent(event.NewEventID(), foundCalendar.UserID(), foundCalendar.ID(), newTitle, newDescription, timeRange.Start(), timeRange.End(), timeRange.AllDay(), newTimeZone, newColor, newRecurrence, newAttendees, newReminders, "", 1, time.Now(), time.Now(), time.Time{})
+
+	conflicts, err := s.checkConflicts(uid, newEvent, input.ConflictCheck)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.eventRepo.Create(newEvent); err != nil {
+		return nil, nil, err
+	}
+
+	return newEvent, conflicts, nil
+}
+
+// UpdateEvent updates an event as its organizer. Callers with write access to
+// the event's calendar act as the organizer; attendees cannot edit the event.
+// The fields in input.UpdateMask, or all of them for "*", are replaced with
+// what was sent, empty or not. Without a mask, only the fields that are set
+// change.
+func (s *eventUsecase) UpdateEvent(ctx context.Context, input UpdateEventInput) (event.Event, []event.Event, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id, err := event.NewEventIDFromString(input.EventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	foundEvent, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	editor, err := s.editor(uid, foundEvent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkETag(foundEvent, input.ETag); err != nil {
+		return nil, nil, err
+	}
+	version := foundEvent.Version()
+
+	fields, err := parseUpdateMask(input.UpdateMask)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Without an update mask only the fields that are set change, since
+	// unset and empty fields cannot be told apart.
+	update := func(field string, set bool) bool {
+		return fields[field] && (set || len(input.UpdateMask) > 0)
+	}
+
+	newCalendarID := foundEvent.CalendarID()
+	if update("calendar_id", input.CalendarID != "") {
+		foundCalendar, err := s.findCalendar(uid, input.CalendarID)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Events belong to the owner of their calendar, so they can only move
+		// between calendars of the same owner.
+		if foundCalendar.UserID() != foundEvent.UserID() {
+			return nil, nil, calendar.ErrPermissionDenied
+		}
+		newCalendarID = foundCalendar.ID()
+	}
+
+	newTitle := foundEvent.Title()
+	if update("title", input.Title != "") {
+		newTitle, err = event.NewTitle(input.Title)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	newDescription := foundEvent.Description()
+	if update("description", input.Description != "") {
+		newDescription, err = event.NewDescription(input.Description)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	newAllDay := foundEvent.AllDay()
+	if update("all_day", input.AllDay) {
+		newAllDay = input.AllDay
+	}
+
+	newStartTime := foundEvent.StartTime()
+	newEndTime := foundEvent.EndTime()
+	if newAllDay {
+		if update("start_date", input.StartDate != nil) {
+			if input.StartDate == nil {
+				return nil, nil, event.ErrStartDateRequired
+			}
+			newStartTime, err = toDate(input.StartDate)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if update("end_date", input.EndDate != nil) {
+			if input.EndDate == nil {
+				return nil, nil, event.ErrEndDateRequired
+			}
+			newEndTime, err = toDate(input.EndDate)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	} else {
+		if update("start_time", input.StartTime != nil) {
+			if input.StartTime == nil {
+				return nil, nil, event.ErrStartTimeRequired
+			}
+			newStartTime = input.StartTime.AsTime()
+		}
+
+		if update("end_time", input.EndTime != nil) {
+			if input.EndTime == nil {
+				return nil, nil, event.ErrEndTimeRequired
+			}
+			newEndTime = input.EndTime.AsTime()
+		}
+	}
+
+	timeRange, err := s.newTimeRange(newAllDay, newStartTime, newEndTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newTimeZone := foundEvent.TimeZone()
+	if update("time_zone", input.TimeZone != "") {
+		newTimeZone, err = event.NewTimeZone(input.TimeZone)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	newColor := foundEvent.Color()
+	if update("color", input.Color != "") {
+		newColor, err = event.NewColor(input.Color)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	newRecurrence := foundEvent.Recurrence()
+	if update("recurrence", len(input.Recurrence) > 0) {
+		newRecurrence, err = event.NewRecurrenceIn(input.Recurrence, timeRange.AllDay(), newTimeZone)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	newAttendees := foundEvent.Attendees()
+	if update("attendees", len(input.Invitees) > 0) {
+		newAttendees, err = toAttendees(input.Invitees)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	newReminders := foundEvent.Reminders()
+	if update("reminders", len(input.Reminders) > 0) {
+		newReminders, err = toReminders(input.Reminders)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := foundEvent.Update(editor, newCalendarID, newTitle, newDescription, timeRange, newTimeZone, newColor, newRecurrence, newAttendees, newReminders); err != nil {
+		return nil, nil, err
+	}
+
+	conflicts, err := s.checkConflicts(uid, foundEvent, input.ConflictCheck)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.eventRepo.Update(foundEvent, version); err != nil {
+		return nil, nil, err
 	}
+
+	return foundEvent, conflicts, nil
 }
 
-func (s *eventUsecase) CreateEvent(ctx context.Context, title, description string, startTime, endTime *timestamppb.Timestamp, color string) (event.Event, error) {
-	userID, err := s.userService.GetUser(ctx)
+func (s *eventUsecase) GetEvent(ctx context.Context, eventID string) (event.Event, error) {
+	principal, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	newUserID, err := domainuser.NewUserIDFromString(userID)
+	id, err := event.NewEventIDFromString(eventID)
 	if err != nil {
 		return nil, err
 	}
 
-	newTitle, err := event.NewTitle(title)
+	foundEvent, err := s.eventRepo.FindByID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	newDescription, err := event.NewDescription(description)
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
 	if err != nil {
 		return nil, err
 	}
 
+	if !foundEvent.IsAttendee(uid) {
+		if _, err := s.policy.Authorize(uid, foundEvent.CalendarID(), calendar.AccessLevelRead); err != nil {
+			return nil, err
+		}
+	}
+
+	return foundEvent, nil
+}
+
+// ListEvents lists the events of the given calendars, or of every calendar the
+// caller can read together with the events they are invited to when
+// calendarIDs is empty. A window may span at most maxListWindow, since the
+// recurring events in it are expanded into occurrences.
+func (s *eventUsecase) ListEvents(ctx context.Context, calendarIDs []string, startTime, endTime *timestamppb.Timestamp, pageSize int32, pageToken string) ([]event.Event, string, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ids := make([]calendar.CalendarID, 0, len(calendarIDs))
+	for _, calendarID := range calendarIDs {
+		id, err := calendar.NewCalendarIDFromString(calendarID)
+		if err != nil {
+			return nil, "", err
+		}
+		ids = append(ids, id)
+	}
+
+	if (startTime == nil) != (endTime == nil) {
+		return nil, "", event.ErrInvalidTimeWindow
+	}
+	if startTime == nil && (pageSize != 0 || pageToken != "") {
+		return nil, "", event.ErrInvalidTimeWindow
+	}
+
+	var attendeeID domainuser.UserID
+	if len(ids) == 0 {
+		ids, err = s.policy.AccessibleCalendarIDs(uid, calendar.AccessLevelRead)
+		if err != nil {
+			return nil, "", err
+		}
+		attendeeID = uid
+	} else {
+		for _, id := range ids {
+			if _, err := s.policy.Authorize(uid, id, calendar.AccessLevelRead); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
 	if startTime == nil {
-		return nil, event.ErrStartTimeRequired
+		events, err := s.eventRepo.FindAllByCalendarIDs(ids, attendeeID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return events, "", nil
 	}
-	newStartTime := startTime.AsTime()
 
-	if endTime == nil {
-		return nil, event.ErrEndTimeRequired
+	from, to := startTime.AsTime(), endTime.AsTime()
+	if !from.Before(to) || to.Sub(from) > maxListWindow {
+		return nil, "", event.ErrInvalidTimeWindow
 	}
-	newEndTime := endTime.AsTime()
 
-	newColor, err := event.NewColor(color)
+	if pageSize < 0 {
+		return nil, "", event.ErrInvalidPageSize
+	}
+	limit := int(pageSize)
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	var after *event.Cursor
+	if pageToken != "" {
+		cursor, err := event.NewCursorFromToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		after = &cursor
+	}
+
+	// All-day events are floating dates, so both queries below match them
+	// against the window widened to every UTC offset (see event.AllDayWindow).
+	events, err := s.eventRepo.FindByCalendarIDsInRange(ids, attendeeID, from, to, after, limit+1)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	recurringEvents, err := s.eventRepo.FindRecurringByCalendarIDs(ids, attendeeID, to)
+	if err != nil {
+		return nil, "", err
 	}
 
-	newEvent := event.NewEvent(event.NewEventID(), newUserID, newTitle, newDescription, newStartTime, newEndTime, newColor, time.Now(), time.Now())
+	for _, e := range recurringEvents {
+		for _, occurrence := range e.Occurrences(from, to) {
+			if after == nil || after.After(occurrence.StartTime(), occurrence.ID()) {
+				events = append(events, occurrence)
+			}
+		}
+	}
 
-	if err := s.eventRepo.Create(newEvent); err != nil {
-		return nil, err
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].StartTime().Equal(events[j].StartTime()) {
+			return events[i].StartTime().Before(events[j].StartTime())
+		}
+		return events[i].ID().String() < events[j].ID().String()
+	})
+
+	if len(events) <= limit {
+		return events, "", nil
 	}
 
-	return newEvent, nil
+	events = events[:limit]
+	last := events[limit-1]
+
+	return events, event.NewCursor(last.StartTime(), last.ID()).Token(), nil
 }
 
-func (s *eventUsecase) UpdateEvent(ctx context.Context, eventID, title, description string, startTime, endTime *timestamppb.Timestamp, color string) (event.Event, error) {
-	userID, err := s.userService.GetUser(ctx)
+func (s *eventUsecase) DeleteEvent(ctx context.Context, eventID, etag string) error {
+	principal, err := auth.FromContext(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	id, err := event.NewEventIDFromString(eventID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	foundEvent, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return err
+	}
+
+	editor, err := s.editor(uid, foundEvent)
+	if err != nil {
+		return err
+	}
+
+	if err := foundEvent.AuthorizeEdit(editor); err != nil {
+		return err
+	}
+
+	if err := checkETag(foundEvent, etag); err != nil {
+		return err
+	}
+
+	if err := s.eventRepo.Delete(id, foundEvent.Version()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *eventUsecase) ListDeletedEvents(ctx context.Context) ([]event.Event, error) {
+	principal, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if foundEvent.UserID().String() != userID {
-		return nil, event.ErrPermissionDenied
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
 	}
 
-	newTitle, err := event.NewTitle(title)
+	// Only events the caller could restore are listed, which takes write
+	// access to their calendar.
+	calendarIDs, err := s.policy.AccessibleCalendarIDs(uid, calendar.AccessLevelWrite)
 	if err != nil {
 		return nil, err
 	}
 
-	newDescription, err := event.NewDescription(description)
+	events, err := s.eventRepo.FindDeletedByCalendarIDs(calendarIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	newStartTime := foundEvent.StartTime()
-	if startTime != nil {
-		newStartTime = startTime.AsTime()
+	return events, nil
+}
+
+func (s *eventUsecase) RestoreEvent(ctx context.Context, eventID string) (event.Event, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	newEndTime := foundEvent.EndTime()
-	if endTime != nil {
-		newEndTime = endTime.AsTime()
+	id, err := event.NewEventIDFromString(eventID)
+	if err != nil {
+		return nil, err
 	}
 
-	newColor, err := event.NewColor(color)
+	deletedEvent, err := s.eventRepo.FindDeletedByID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	foundEvent.Update(newTitle, newDescription, newStartTime, newEndTime, newColor)
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := s.eventRepo.Update(foundEvent); err != nil {
+	if _, err := s.policy.Authorize(uid, deletedEvent.CalendarID(), calendar.AccessLevelWrite); err != nil {
 		return nil, err
 	}
 
-	return foundEvent, nil
+	if err := s.eventRepo.Restore(id); err != nil {
+		return nil, err
+	}
+
+	restoredEvent, err := s.eventRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return restoredEvent, nil
 }
 
-func (s *eventUsecase) GetEvent(ctx context.Context, eventID string) (event.Event, error) {
-	userID, err := s.userService.GetUser(ctx)
+// RespondToEvent records the caller's response to an event they are invited
+// to.
+func (s *eventUsecase) RespondToEvent(ctx context.Context, eventID, responseStatus string) (event.Event, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -143,25 +747,35 @@ func (s *eventUsecase) GetEvent(ctx context.Context, eventID string) (event.Even
 		return nil, err
 	}
 
+	newResponseStatus, err := event.NewResponseStatus(responseStatus)
+	if err != nil {
+		return nil, err
+	}
+
 	foundEvent, err := s.eventRepo.FindByID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	if foundEvent.UserID().String() != userID {
-		return nil, event.ErrPermissionDenied
+	version := foundEvent.Version()
+	if err := foundEvent.Respond(uid, newResponseStatus); err != nil {
+		return nil, err
+	}
+
+	if err := s.eventRepo.Update(foundEvent, version); err != nil {
+		return nil, err
 	}
 
 	return foundEvent, nil
 }
 
-func (s *eventUsecase) ListEvents(ctx context.Context) ([]event.Event, error) {
-	userID, err := s.userService.GetUser(ctx)
+func (s *eventUsecase) ExportEvents(ctx context.Context) ([]byte, error) {
+	principal, err := auth.FromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	uid, err := domainuser.NewUserIDFromString(userID)
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -171,32 +785,753 @@ func (s *eventUsecase) ListEvents(ctx context.Context) ([]event.Event, error) {
 		return nil, err
 	}
 
-	return events, nil
+	return event.MarshalICalendar(events), nil
 }
 
-func (s *eventUsecase) DeleteEvent(ctx context.Context, eventID string) error {
-	userID, err := s.userService.GetUser(ctx)
+// ImportEvents creates an event for each VEVENT in data, or updates the
+// caller's existing event with the same UID. Invalid VEVENTs are reported in
+// their ImportResult and do not stop the import.
+func (s *eventUsecase) ImportEvents(ctx context.Context, data string) ([]ImportResult, error) {
+	principal, err := auth.FromContext(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	id, err := event.NewEventIDFromString(eventID)
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	foundEvent, err := s.eventRepo.FindByID(id)
+	items, err := event.ParseICalendar([]byte(data))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if foundEvent.UserID().String() != userID {
-		return event.ErrPermissionDenied
+	defaultCalendar, err := appcalendar.DefaultCalendar(s.calendarRepo, uid)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.eventRepo.Delete(id); err != nil {
-		return err
+	results := make([]ImportResult, 0, len(items))
+	for _, item := range items {
+		result, err := s.importEvent(uid, defaultCalendar.ID(), item, "")
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
 	}
 
-	return nil
+	return results, nil
+}
+
+// ListICalendarEvents lists the caller's own events, which are the events
+// GetEventByICalUID finds, overlapping [startTime, endTime) unless both are
+// nil. Recurring events are listed when any of their occurrences overlaps.
+func (s *eventUsecase) ListICalendarEvents(ctx context.Context, startTime, endTime *timestamppb.Timestamp) ([]event.Event, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if (startTime == nil) != (endTime == nil) {
+		return nil, event.ErrInvalidTimeWindow
+	}
+	if startTime == nil {
+		events, err := s.eventRepo.FindAllByUserID(uid)
+		if err != nil {
+			return nil, err
+		}
+
+		return events, nil
+	}
+
+	from, to := startTime.AsTime(), endTime.AsTime()
+	if !from.Before(to) {
+		return nil, event.ErrInvalidTimeWindow
+	}
+
+	calendars, err := s.calendarRepo.FindAllByUserID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]calendar.CalendarID, 0, len(calendars))
+	for _, c := range calendars {
+		ids = append(ids, c.ID())
+	}
+
+	events, err := s.eventRepo.FindByCalendarIDsInRange(ids, domainuser.UserID{}, from, to, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	recurringEvents, err := s.eventRepo.FindRecurringByCalendarIDs(ids, domainuser.UserID{}, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range recurringEvents {
+		if len(e.Occurrences(from, to)) > 0 {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+func (s *eventUsecase) GetEventByICalUID(ctx context.Context, icalUID string) (event.Event, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	foundEvent, err := s.eventRepo.FindByICalUID(uid, icalUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return foundEvent, nil
+}
+
+// SaveICalendarEvent creates or replaces the caller's event identified by
+// icalUID from a calendar object holding exactly one VEVENT with that UID. A
+// non-empty etag requires the event to exist at that version. The returned
+// bool reports whether the event was created.
+func (s *eventUsecase) SaveICalendarEvent(ctx context.Context, icalUID, data, etag string) (event.Event, bool, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items, err := event.ParseICalendar([]byte(data))
+	if err != nil {
+		return nil, false, err
+	}
+	if len(items) != 1 {
+		return nil, false, fmt.Errorf("%w: expected exactly one VEVENT, got %d", event.ErrInvalidICalendar, len(items))
+	}
+	if items[0].UID != icalUID {
+		return nil, false, fmt.Errorf("%w: UID %q does not match %q", event.ErrInvalidICalendar, items[0].UID, icalUID)
+	}
+
+	defaultCalendar, err := appcalendar.DefaultCalendar(s.calendarRepo, uid)
+	if err != nil {
+		return nil, false, err
+	}
+
+	result, err := s.importEvent(uid, defaultCalendar.ID(), items[0], etag)
+	if err != nil {
+		return nil, false, err
+	}
+	if result.Err != nil {
+		return nil, false, result.Err
+	}
+
+	return result.Event, result.Created, nil
+}
+
+// importEvent returns an error only for failures that should abort the whole
+// import, such as repository errors. New events are created in calendarID,
+// and a non-empty etag must match the existing event.
+func (s *eventUsecase) importEvent(userID domainuser.UserID, calendarID calendar.CalendarID, item event.ICalendarEvent, etag string) (ImportResult, error) {
+	result := ImportResult{UID: item.UID}
+	if item.Err != nil {
+		result.Err = item.Err
+		return result, nil
+	}
+
+	newTitle, err := event.NewTitle(item.Summary)
+	if err != nil {
+		result.Err = err
+		return result, nil
+	}
+
+	// DESCRIPTION is optional in iCalendar, and MarshalICalendar leaves it
+	// out for events without one.
+	var newDescription event.Description
+	if item.Description != "" {
+		newDescription, err = event.NewDescription(item.Description)
+		if err != nil {
+			result.Err = err
+			return result, nil
+		}
+	}
+
+	timeRange, err := s.newTimeRange(item.AllDay, item.StartTime, item.EndTime)
+	if err != nil {
+		result.Err = err
+		return result, nil
+	}
+
+	newTimeZone, err := event.NewTimeZone(item.TimeZone)
+	if err != nil {
+		result.Err = err
+		return result, nil
+	}
+
+	newColor, err := event.NewColor(item.Color)
+	if err != nil {
+		result.Err = err
+		return result, nil
+	}
+
+	newRecurrence, err := event.NewRecurrenceIn(item.Recurrence, timeRange.AllDay(), newTimeZone)
+	if err != nil {
+		result.Err = err
+		return result, nil
+	}
+
+	foundEvent, err := s.eventRepo.FindByICalUID(userID, item.UID)
+	if err != nil && !errors.Is(err, event.ErrEventNotFound) {
+		return ImportResult{}, err
+	}
+
+	if foundEvent == nil {
+		if etag != "" {
+			result.Err = event.ErrETagMismatch
+			return result, nil
+		}
+
+		newEvent := event.NewEvent(event.NewEventID(), userID, calendarID, newTitle, newDescription, timeRange.Start(), timeRange.End(), timeRange.AllDay(), newTimeZone, newColor, newRecurrence, nil, nil, item.UID, 1, time.Now(), time.Now(), time.Time{})
+		if err := s.eventRepo.Create(newEvent); err != nil {
+			return ImportResult{}, err
+		}
+
+		result.Event = newEvent
+		result.Created = true
+		return result, nil
+	}
+
+	if err := checkETag(foundEvent, etag); err != nil {
+		result.Err = err
+		return result, nil
+	}
+
+	version := foundEvent.Version()
+	if err := foundEvent.Update(userID, foundEvent.CalendarID(), newTitle, newDescription, timeRange, newTimeZone, newColor, newRecurrence, foundEvent.Attendees(), foundEvent.Reminders()); err != nil {
+		result.Err = err
+		return result, nil
+	}
+
+	if err := s.eventRepo.Update(foundEvent, version); err != nil {
+		if errors.Is(err, event.ErrVersionConflict) {
+			result.Err = err
+			return result, nil
+		}
+		return ImportResult{}, err
+	}
+
+	result.Event = foundEvent
+	return result, nil
+}
+
+// findCalendar returns the calendar with the given ID if the user may write to
+// it, or the user's default calendar when calendarID is empty.
+func (s *eventUsecase) findCalendar(userID domainuser.UserID, calendarID string) (calendar.Calendar, error) {
+	if calendarID == "" {
+		return appcalendar.DefaultCalendar(s.calendarRepo, userID)
+	}
+
+	id, err := calendar.NewCalendarIDFromString(calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.policy.Authorize(userID, id, calendar.AccessLevelWrite)
+}
+
+// editor returns the user the caller edits e as. Callers with write access to
+// the event's calendar act as its owner, the organizer. Attendees act as
+// themselves, so the event rejects their edits.
+func (s *eventUsecase) editor(userID domainuser.UserID, e event.Event) (domainuser.UserID, error) {
+	foundCalendar, err := s.policy.Authorize(userID, e.CalendarID(), calendar.AccessLevelWrite)
+	if errors.Is(err, calendar.ErrPermissionDenied) && e.IsAttendee(userID) {
+		return userID, nil
+	}
+	if err != nil {
+		return domainuser.UserID{}, err
+	}
+
+	return foundCalendar.UserID(), nil
+}
+
+// checkConflicts finds the organizer's other events that overlap e and acts on
+// them as conflictCheck says. Only the conflicts viewerID may read are
+// returned; rejection considers them all.
+func (s *eventUsecase) checkConflicts(viewerID domainuser.UserID, e event.Event, conflictCheck ConflictCheck) ([]event.Event, error) {
+	if conflictCheck == ConflictCheckNone {
+		return nil, nil
+	}
+
+	spans := event.ConflictSpans(e)
+	if len(spans) == 0 {
+		return nil, nil
+	}
+
+	others, err := s.eventRepo.FindByUserIDInRange(e.UserID(), spans[0].Start, spans[len(spans)-1].End)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := event.Conflicts(spans, others, e.UserID(), e.ID())
+	if len(conflicts) > 0 && conflictCheck == ConflictCheckReject {
+		return nil, event.ErrConflictingEvents
+	}
+	if len(conflicts) == 0 || viewerID == e.UserID() {
+		return conflicts, nil
+	}
+
+	calendarIDs, err := s.policy.AccessibleCalendarIDs(viewerID, calendar.AccessLevelRead)
+	if err != nil {
+		return nil, err
+	}
+
+	var visible []event.Event
+	for _, c := range conflicts {
+		if slices.Contains(calendarIDs, c.CalendarID()) || c.IsAttendee(viewerID) {
+			visible = append(visible, c)
+		}
+	}
+
+	return visible, nil
+}
+
+func (s *eventUsecase) newTimeRange(allDay bool, start, end time.Time) (event.TimeRange, error) {
+	if allDay {
+		return event.NewAllDayTimeRange(start, end, s.maxDuration)
+	}
+	return event.NewTimeRange(start, end, s.maxDuration)
+}
+
+// toAttendees returns the attendees for invitees, who have not responded
+// yet.
+func toAttendees(invitees []Invitee) ([]event.Attendee, error) {
+	attendees := make([]event.Attendee, 0, len(invitees))
+	for _, invitee := range invitees {
+		var userID domainuser.UserID
+		if invitee.UserID != "" {
+			id, err := domainuser.NewUserIDFromString(invitee.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", event.ErrInvalidAttendee, err)
+			}
+			userID = id
+		}
+
+		role, err := event.NewAttendeeRole(invitee.Role)
+		if err != nil {
+			return nil, err
+		}
+
+		attendee, err := event.NewAttendee(userID, invitee.Email, role, event.ResponseStatusNeedsAction)
+		if err != nil {
+			return nil, err
+		}
+		attendees = append(attendees, attendee)
+	}
+
+	if err := event.ValidateAttendees(attendees); err != nil {
+		return nil, err
+	}
+
+	return attendees, nil
+}
+
+func toReminders(reminders []Reminder) ([]event.Reminder, error) {
+	newReminders := make([]event.Reminder, 0, len(reminders))
+	for _, r := range reminders {
+		channel, err := event.NewReminderChannel(r.Channel)
+		if err != nil {
+			return nil, err
+		}
+
+		reminder, err := event.NewReminder(time.Duration(r.OffsetMinutes)*time.Minute, channel)
+		if err != nil {
+			return nil, err
+		}
+		newReminders = append(newReminders, reminder)
+	}
+
+	if err := event.ValidateReminders(newReminders); err != nil {
+		return nil, err
+	}
+
+	return newReminders, nil
+}
+
+func toDate(d *date.Date) (time.Time, error) {
+	return event.NewDate(int(d.GetYear()), int(d.GetMonth()), int(d.GetDay()))
+}
+
+// parseUpdateMask returns the set of fields to update. An empty mask or "*"
+// selects every updatable field. Paths into a field, such as
+// "start_date.year", select the whole field.
+func parseUpdateMask(paths []string) (map[string]bool, error) {
+	fields := make(map[string]bool, len(updatableFields))
+	if len(paths) == 0 || (len(paths) == 1 && paths[0] == "*") {
+		for _, f := range updatableFields {
+			fields[f] = true
+		}
+		return fields, nil
+	}
+
+	for _, path := range paths {
+		field, _, _ := strings.Cut(path, ".")
+		if slices.Contains(outputOnlyFields, field) {
+			continue
+		}
+		if !slices.Contains(updatableFields, field) {
+			return nil, fmt.Errorf("%w: unknown field %q", event.ErrInvalidUpdateMask, path)
+		}
+		fields[field] = true
+	}
+
+	return fields, nil
+}
+
+// checkETag verifies that a client-supplied etag matches the stored event. An
+// empty etag skips the check.
+func checkETag(e event.Event, etag string) error {
+	if etag == "" {
+		return nil
+	}
+
+	version, err := event.ParseETag(etag)
+	if err != nil {
+		return err
+	}
+
+	if version != e.Version() {
+		return event.ErrETagMismatch
+	}
+
+	return nil
+}
+
+// QueryFreeBusy returns when each of the users is busy within the window,
+// based on their calendars the caller holds at least free/busy access to and
+// on the events they are invited to and have not declined.
+func (s *eventUsecase) QueryFreeBusy(ctx context.Context, userIDs []string, startTime, endTime *timestamppb.Timestamp) ([]FreeBusy, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(userIDs) == 0 || len(userIDs) > maxFreeBusyUsers {
+		return nil, event.ErrInvalidUserIDs
+	}
+	ids := make([]domainuser.UserID, 0, len(userIDs))
+	for _, userID := range userIDs {
+		id, err := domainuser.NewUserIDFromString(userID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", event.ErrInvalidUserIDs, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if startTime == nil || endTime == nil {
+		return nil, event.ErrInvalidTimeWindow
+	}
+	from, to := startTime.AsTime(), endTime.AsTime()
+	if !from.Before(to) || to.Sub(from) > maxFreeBusyWindow {
+		return nil, event.ErrInvalidTimeWindow
+	}
+
+	results := make([]FreeBusy, 0, len(ids))
+	for _, id := range ids {
+		busy, err := s.busyIntervals(uid, id, from, to)
+		if errors.Is(err, calendar.ErrPermissionDenied) {
+			results = append(results, FreeBusy{UserID: id.String(), Err: err})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, FreeBusy{UserID: id.String(), Busy: busy})
+	}
+
+	return results, nil
+}
+
+func (s *eventUsecase) busyIntervals(viewerID, userID domainuser.UserID, from, to time.Time) ([]event.Interval, error) {
+	calendarIDs, err := s.policy.VisibleCalendarIDs(viewerID, userID, calendar.AccessLevelFreeBusy)
+	if err != nil {
+		return nil, err
+	}
+	if len(calendarIDs) == 0 && viewerID != userID {
+		return nil, calendar.ErrPermissionDenied
+	}
+
+	events, err := s.eventRepo.FindByCalendarIDsInRange(calendarIDs, userID, from, to, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	recurringEvents, err := s.eventRepo.FindRecurringByCalendarIDs(calendarIDs, userID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range recurringEvents {
+		events = append(events, e.Occurrences(from, to)...)
+	}
+
+	return event.BusyIntervals(events, userID, from, to), nil
+}
+
+// SuggestMeetingTimes returns, earliest first, slots within the window where
+// the caller and every one of the users are free. Slots keep the buffer away
+// from any busy time on either side, do not overlap one another and are
+// spread across the days of the window.
+func (s *eventUsecase) SuggestMeetingTimes(ctx context.Context, userIDs []string, durationMinutes int32, startTime, endTime *timestamppb.Timestamp, constraints SlotConstraints, maxResults int32) ([]event.Interval, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(userIDs) == 0 || len(userIDs) > maxFreeBusyUsers {
+		return nil, event.ErrInvalidUserIDs
+	}
+	ids := []domainuser.UserID{uid}
+	for _, userID := range userIDs {
+		id, err := domainuser.NewUserIDFromString(userID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", event.ErrInvalidUserIDs, err)
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	if durationMinutes <= 0 || durationMinutes > maxMeetingMinutes {
+		return nil, event.ErrInvalidDuration
+	}
+	duration := time.Duration(durationMinutes) * time.Minute
+
+	if startTime == nil || endTime == nil {
+		return nil, event.ErrInvalidTimeWindow
+	}
+	from, to := startTime.AsTime(), endTime.AsTime()
+	if !from.Before(to) || to.Sub(from) > maxFreeBusyWindow {
+		return nil, event.ErrInvalidTimeWindow
+	}
+	if constraints.EarliestStartTime != nil && constraints.EarliestStartTime.AsTime().After(from) {
+		from = constraints.EarliestStartTime.AsTime()
+	}
+	if constraints.LatestEndTime != nil && constraints.LatestEndTime.AsTime().Before(to) {
+		to = constraints.LatestEndTime.AsTime()
+	}
+	if !from.Before(to) {
+		return nil, event.ErrInvalidTimeWindow
+	}
+
+	hours, err := event.NewWorkingHours(constraints.WorkingHoursStart, constraints.WorkingHoursEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	timeZone, err := event.NewTimeZone(constraints.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	if constraints.BufferMinutes < 0 || constraints.BufferMinutes > maxBufferMinutes {
+		return nil, event.ErrInvalidBuffer
+	}
+	buffer := time.Duration(constraints.BufferMinutes) * time.Minute
+
+	if maxResults < 0 || maxResults > maxSlotCount {
+		return nil, event.ErrInvalidMaxResults
+	}
+	if maxResults == 0 {
+		maxResults = defaultSlotCount
+	}
+
+	var busy []event.Interval
+	for _, id := range ids {
+		intervals, err := s.busyIntervals(uid, id, from.Add(-buffer), to.Add(buffer))
+		if err != nil {
+			return nil, err
+		}
+		busy = append(busy, intervals...)
+	}
+
+	slots := event.FreeSlots(busy, from, to, duration, buffer, hours, timeZone.Location())
+
+	return event.PickSlots(slots, int(maxResults), timeZone.Location()), nil
+}
+
+// CheckConflicts returns the caller's events and invitations that keep them
+// busy at some point within the window, except the event with excludeEventID.
+func (s *eventUsecase) CheckConflicts(ctx context.Context, startTime, endTime *timestamppb.Timestamp, excludeEventID string) ([]event.Event, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if startTime == nil || endTime == nil {
+		return nil, event.ErrInvalidTimeWindow
+	}
+	from, to := startTime.AsTime(), endTime.AsTime()
+	if !from.Before(to) || to.Sub(from) > maxFreeBusyWindow {
+		return nil, event.ErrInvalidTimeWindow
+	}
+
+	var excludeID event.EventID
+	if excludeEventID != "" {
+		excludeID, err = event.NewEventIDFromString(excludeEventID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	others, err := s.eventRepo.FindByUserIDInRange(uid, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.Conflicts([]event.Interval{{Start: from, End: to}}, others, uid, excludeID), nil
+}
+
+// SyncEvents returns the caller's events and invitations that changed since
+// syncToken, the IDs of those deleted or no longer visible to the caller
+// since, and the token for the next sync. Without a token it returns every
+// event, a page at a time, and the sync token comes with the last page.
+// Deletions are only known until the trash is purged, so older tokens fail
+// with event.ErrSyncTokenExpired and the client has to sync from scratch,
+// as do tokens issued before the calendars the caller can read changed.
+func (s *eventUsecase) SyncEvents(ctx context.Context, syncToken string, pageSize int32, pageToken string) ([]event.Event, []event.EventID, string, string, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+
+	calendarIDs, err := s.policy.AccessibleCalendarIDs(uid, calendar.AccessLevelRead)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+
+	if syncToken == "" {
+		return s.fullSync(uid, calendarIDs, pageSize, pageToken)
+	}
+	if pageSize != 0 || pageToken != "" {
+		return nil, nil, "", "", event.ErrInvalidPageToken
+	}
+
+	token, err := event.NewSyncTokenFromToken(syncToken)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+	now := time.Now()
+	if token.SyncTime().Before(now.Add(-s.syncWindow)) || !token.SameCalendars(calendarIDs) {
+		return nil, nil, "", "", event.ErrSyncTokenExpired
+	}
+	since := token.SyncTime()
+	nextSyncToken := event.NewSyncToken(now.Add(-syncSettleDelay), calendarIDs).Token()
+
+	found, err := s.eventRepo.FindChangedByCalendarIDs(calendarIDs, uid, since)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+
+	departedIDs, err := s.eventRepo.FindDepartedByCalendarIDs(calendarIDs, uid, since)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+
+	var changed []event.Event
+	var deletedIDs []event.EventID
+	visible := make(map[event.EventID]struct{}, len(found))
+	for _, e := range found {
+		visible[e.ID()] = struct{}{}
+		if e.IsDeleted() {
+			deletedIDs = append(deletedIDs, e.ID())
+			continue
+		}
+		changed = append(changed, e)
+	}
+
+	// Events that changed since the last sync and are not found among the
+	// caller's changed events have left the caller's view.
+	for _, id := range departedIDs {
+		if _, ok := visible[id]; !ok {
+			deletedIDs = append(deletedIDs, id)
+		}
+	}
+
+	return changed, deletedIDs, "", nextSyncToken, nil
+}
+
+// fullSync returns a page of every event the caller can see. The first page
+// fixes the sync token that the last one returns.
+func (s *eventUsecase) fullSync(uid domainuser.UserID, calendarIDs []calendar.CalendarID, pageSize int32, pageToken string) ([]event.Event, []event.EventID, string, string, error) {
+	if pageSize < 0 {
+		return nil, nil, "", "", event.ErrInvalidPageSize
+	}
+	limit := int(pageSize)
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	syncToken := event.NewSyncToken(time.Now().Add(-syncSettleDelay), calendarIDs)
+	var afterID *event.EventID
+	if pageToken != "" {
+		token, err := event.NewSyncPageTokenFromToken(pageToken)
+		if err != nil {
+			return nil, nil, "", "", err
+		}
+		syncToken = token.SyncToken()
+		id := token.AfterID()
+		afterID = &id
+	}
+
+	events, err := s.eventRepo.FindByCalendarIDsAfterID(calendarIDs, uid, afterID, limit+1)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+
+	if len(events) <= limit {
+		return events, nil, "", syncToken.Token(), nil
+	}
+
+	events = events[:limit]
+
+	return events, nil, event.NewSyncPageToken(syncToken, events[limit-1].ID()).Token(), "", nil
 }
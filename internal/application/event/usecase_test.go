@@ -1,6 +1,7 @@
 package event
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
@@ -8,38 +9,84 @@ import (
 
 	"github.com/google/uuid"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/type/date"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/qkitzero/event-service/internal/application/auth"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
 	"github.com/qkitzero/event-service/internal/domain/event"
 	domainuser "github.com/qkitzero/event-service/internal/domain/user"
-	mocksuser "github.com/qkitzero/event-service/mocks/application/user"
+	mocksappcalendar "github.com/qkitzero/event-service/mocks/application/calendar"
+	mockscalendar "github.com/qkitzero/event-service/mocks/domain/calendar"
 	mocks "github.com/qkitzero/event-service/mocks/domain/event"
 )
 
 func TestCreateEvent(t *testing.T) {
 	t.Parallel()
+	startTime := timestamppb.New(time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC))
+	endTime := timestamppb.New(time.Date(2025, 1, 6, 11, 0, 0, 0, time.UTC))
 	tests := []struct {
-		name        string
-		success     bool
-		ctx         context.Context
-		userID      string
-		getUserErr  error
-		title       string
-		description string
-		startTime   *timestamppb.Timestamp
-		endTime     *timestamppb.Timestamp
-		color       string
-		createErr   error
+		name              string
+		success           bool
+		ctx               context.Context
+		userID            string
+		calendarUserID    string
+		calendarID        string
+		title             string
+		description       string
+		startTime         *timestamppb.Timestamp
+		endTime           *timestamppb.Timestamp
+		allDay            bool
+		startDate         *date.Date
+		endDate           *date.Date
+		timeZone          string
+		color             string
+		recurrence        []string
+		invitees          []Invitee
+		reminders         []Reminder
+		findCalendarErr   error
+		createErr         error
+		conflictCheck     ConflictCheck
+		findInRangeErr    error
+		expectedConflicts int
 	}{
-		{"success create event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil},
-		{"failure get user error", false, context.Background(), "", errors.New("get user error"), "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil},
-		{"failure empty user id", false, context.Background(), "", nil, "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil},
-		{"failure empty title", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, "", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil},
-		{"failure empty description", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, "title", "", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil},
-		{"failure nil start time", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, "title", "description", nil, timestamppb.Now(), "#FFFFFF", nil},
-		{"failure nil end time", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, "title", "description", timestamppb.Now(), nil, "#FFFFFF", nil},
-		{"failure invalid color", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, "title", "description", timestamppb.Now(), timestamppb.Now(), "red", nil},
-		{"failure create error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", errors.New("create error")},
+		{"success create event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"success create recurring event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure unauthenticated", false, context.Background(), "", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid user id", false, context.Background(), "invalid", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure empty title", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure empty description", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure nil start time", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", nil, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure nil end time", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, nil, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"success create all-day event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", nil, nil, true, &date.Date{Year: 2025, Month: 1, Day: 6}, &date.Date{Year: 2025, Month: 1, Day: 8}, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure all-day event without start date", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", nil, nil, true, nil, &date.Date{Year: 2025, Month: 1, Day: 8}, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure all-day event without end date", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", nil, nil, true, &date.Date{Year: 2025, Month: 1, Day: 6}, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure all-day event with invalid date", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", nil, nil, true, &date.Date{Year: 2025, Month: 2, Day: 30}, &date.Date{Year: 2025, Month: 3, Day: 2}, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"success create event with time zone", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "America/New_York", "#FFFFFF", []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid time zone", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "Mars/Olympus_Mons", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure end time before start time", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", endTime, startTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure zero duration", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, startTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure duration too long", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, timestamppb.New(startTime.AsTime().Add(event.DefaultMaxDuration + time.Hour)), false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid color", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "red", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid recurrence", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", []string{"RRULE:FREQ=HOURLY"}, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"success create event in calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "title", "description", startTime, endTime, false, nil, nil, "", "", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"success create event with attendees", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, []Invitee{{UserID: "00000000-0000-0000-0000-000000000001"}, {Email: "guest@example.com", Role: "optional"}}, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid attendee", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, []Invitee{{UserID: "invalid"}}, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure duplicate attendee", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, []Invitee{{Email: "guest@example.com"}, {Email: "Guest@example.com"}}, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid attendee role", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, []Invitee{{Email: "guest@example.com", Role: "chair"}}, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"success create event with reminders", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, []Reminder{{OffsetMinutes: 10}, {OffsetMinutes: 60, Channel: "email"}}, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid reminder", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, []Reminder{{OffsetMinutes: -10}}, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid reminder channel", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, []Reminder{{OffsetMinutes: 10, Channel: "sms"}}, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid calendar id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "invalid", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, nil, 0},
+		{"failure calendar permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, calendar.ErrPermissionDenied, nil, ConflictCheckNone, nil, 0},
+		{"failure calendar not found", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, calendar.ErrCalendarNotFound, nil, ConflictCheckNone, nil, 0},
+		{"failure find default calendar error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, errors.New("find default calendar error"), nil, ConflictCheckNone, nil, 0},
+		{"success create event reporting conflicts", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckReport, nil, 1},
+		{"success create event without conflict check", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckNone, errors.New("find by user id in range error"), 0},
+		{"success create all-day event reporting conflicts", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", nil, nil, true, &date.Date{Year: 2025, Month: 1, Day: 6}, &date.Date{Year: 2025, Month: 1, Day: 8}, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckReport, nil, 1},
+		{"failure create event rejecting conflicts", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckReject, nil, 0},
+		{"failure find by user id in range error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, ConflictCheckReport, errors.New("find by user id in range error"), 0},
+		{"failure create error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, errors.New("create error"), ConflictCheckNone, nil, 0},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -49,52 +96,132 @@ func TestCreateEvent(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockUserService := mocksuser.NewMockUserService(ctrl)
 			mockEventRepository := mocks.NewMockEventRepository(ctrl)
-			mockUserService.EXPECT().GetUser(tt.ctx).Return(tt.userID, tt.getUserErr).AnyTimes()
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
 			mockEventRepository.EXPECT().Create(gomock.Any()).Return(tt.createErr).AnyTimes()
+			mockCalendar := calendar.NewCalendar(calendar.NewCalendarID(), domainuser.UserID{UUID: uuid.MustParse(tt.calendarUserID)}, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now())
+			mockCalendarRepository.EXPECT().FindDefaultByUserID(gomock.Any()).Return(mockCalendar, tt.findCalendarErr).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelWrite).Return(mockCalendar, tt.findCalendarErr).AnyTimes()
+			overlappingEvent := event.NewEvent(event.NewEventID(), mockCalendar.UserID(), mockCalendar.ID(), event.Title("title"), event.Description("description"), startTime.AsTime(), endTime.AsTime(), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+			mockEventRepository.EXPECT().FindByUserIDInRange(mockCalendar.UserID(), gomock.Any(), gomock.Any()).Return([]event.Event{overlappingEvent}, tt.findInRangeErr).AnyTimes()
 
-			eventUsecase := NewEventUsecase(mockUserService, mockEventRepository)
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
 
-			_, err := eventUsecase.CreateEvent(tt.ctx, tt.title, tt.description, tt.startTime, tt.endTime, tt.color)
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, conflicts, err := eventUsecase.CreateEvent(ctx, CreateEventInput{
+				CalendarID:    tt.calendarID,
+				Title:         tt.title,
+				Description:   tt.description,
+				StartTime:     tt.startTime,
+				EndTime:       tt.endTime,
+				AllDay:        tt.allDay,
+				StartDate:     tt.startDate,
+				EndDate:       tt.endDate,
+				TimeZone:      tt.timeZone,
+				Color:         tt.color,
+				Recurrence:    tt.recurrence,
+				Invitees:      tt.invitees,
+				Reminders:     tt.reminders,
+				ConflictCheck: tt.conflictCheck,
+			})
 			if tt.success && err != nil {
 				t.Errorf("expected no error, but got %v", err)
 			}
 			if !tt.success && err == nil {
 				t.Errorf("expected error, but got nil")
 			}
+			if tt.success && len(conflicts) != tt.expectedConflicts {
+				t.Errorf("len(conflicts) = %d, want %d", len(conflicts), tt.expectedConflicts)
+			}
 		})
 	}
 }
 
 func TestUpdateEvent(t *testing.T) {
 	t.Parallel()
+	startTime := timestamppb.New(time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC))
+	endTime := timestamppb.New(time.Date(2025, 1, 6, 11, 0, 0, 0, time.UTC))
 	tests := []struct {
-		name        string
-		success     bool
-		ctx         context.Context
-		eventUserID string
-		userID      string
-		getUserErr  error
-		eventID     string
-		title       string
-		description string
-		startTime   *timestamppb.Timestamp
-		endTime     *timestamppb.Timestamp
-		color       string
-		findByIDErr error
-		updateErr   error
+		name              string
+		success           bool
+		ctx               context.Context
+		eventUserID       string
+		userID            string
+		eventID           string
+		calendarUserID    string
+		calendarID        string
+		title             string
+		description       string
+		startTime         *timestamppb.Timestamp
+		endTime           *timestamppb.Timestamp
+		allDay            bool
+		startDate         *date.Date
+		endDate           *date.Date
+		timeZone          string
+		color             string
+		recurrence        []string
+		invitees          []Invitee
+		reminders         []Reminder
+		updateMask        []string
+		etag              string
+		findByIDErr       error
+		authorizeErr      error
+		findCalendarErr   error
+		updateErr         error
+		isAttendee        bool
+		updateEventErr    error
+		conflictCheck     ConflictCheck
+		findInRangeErr    error
+		expectedConflicts int
 	}{
-		{"success update event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil, nil},
-		{"success update event with nil times", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", nil, nil, "#FFFFFF", nil, nil},
-		{"failure get user error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", errors.New("get user error"), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil, nil},
-		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "00000000-0000-0000-0000-000000000001", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil, nil},
-		{"failure empty event id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "", "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil, nil},
-		{"failure empty title", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil, nil},
-		{"failure empty description", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil, nil},
-		{"failure invalid color", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), "red", nil, nil},
-		{"failure find by id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", errors.New("find by id error"), nil},
-		{"failure update error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil, errors.New("update error")},
+		{"success update event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success update event with nil times", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", nil, nil, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success update title only", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"title"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success update with wildcard mask", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, []string{"*"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success update with matching etag", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, `"1"`, nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success update attendees", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, false, nil, nil, "", "", nil, []Invitee{{Email: "guest@example.com"}}, nil, []string{"attendees"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid attendee", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, false, nil, nil, "", "", nil, []Invitee{{Email: "invalid"}}, nil, []string{"attendees"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success update reminders", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, false, nil, nil, "", "", nil, nil, []Reminder{{OffsetMinutes: 30}}, []string{"reminders"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid reminder", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, false, nil, nil, "", "", nil, nil, []Reminder{{OffsetMinutes: -30}}, []string{"reminders"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure attendee is not organizer", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"title"}, "", nil, calendar.ErrPermissionDenied, nil, nil, true, event.ErrNotOrganizer, ConflictCheckNone, nil, 0},
+		{"failure unauthenticated", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, calendar.ErrPermissionDenied, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure empty event id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success empty title without mask is kept", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure empty title with wildcard mask", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, []string{"*"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure empty description with wildcard mask", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, []string{"*"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success date sub-paths select the dates", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, true, &date.Date{Year: 2025, Month: 1, Day: 6}, &date.Date{Year: 2025, Month: 1, Day: 7}, "", "", nil, nil, nil, []string{"all_day", "start_date.year", "start_date.month", "start_date.day", "end_date.year", "end_date.month", "end_date.day"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success output-only fields are ignored", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"id", "etag", "delete_time", "title"}, `"1"`, nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success update to all-day event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, true, &date.Date{Year: 2025, Month: 1, Day: 6}, &date.Date{Year: 2025, Month: 1, Day: 7}, "", "", nil, nil, nil, []string{"all_day", "start_date", "end_date"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure update to all-day event without dates", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, true, nil, nil, "", "", nil, nil, nil, []string{"all_day"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure masked start date missing", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, true, nil, &date.Date{Year: 2025, Month: 1, Day: 7}, "", "", nil, nil, nil, []string{"all_day", "start_date", "end_date"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success update time zone only", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, false, nil, nil, "Asia/Tokyo", "", nil, nil, nil, []string{"time_zone"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid time zone", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, false, nil, nil, "Asia/Nowhere", "", nil, nil, nil, []string{"time_zone"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure end time before start time", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", endTime, startTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure end time before existing start time", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", nil, timestamppb.New(time.Now().Add(-time.Hour)), false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid color", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "red", nil, nil, nil, nil, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid recurrence", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", []string{"EXDATE:20250101T000000Z"}, nil, nil, nil, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure unknown update mask path", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, []string{"user_id"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure masked start time missing", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", nil, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, []string{"start_time"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure masked empty description", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, []string{"title", "description"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure stale etag", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, `"2"`, nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid etag", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "abc", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success move event to calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"calendar_id"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success move event to default calendar", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"calendar_id"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure invalid calendar id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "invalid", "", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"calendar_id"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure move event to calendar of another user", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "00000000-0000-0000-0000-000000000001", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"calendar_id"}, "", nil, nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure calendar not found", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, nil, calendar.ErrCalendarNotFound, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"failure find by id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", errors.New("find by id error"), nil, nil, nil, false, nil, ConflictCheckNone, nil, 0},
+		{"success update event reporting conflicts", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"title"}, "", nil, nil, nil, nil, false, nil, ConflictCheckReport, nil, 1},
+		{"success shared calendar writer only sees readable conflicts", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"title"}, "", nil, nil, nil, nil, false, nil, ConflictCheckReport, nil, 0},
+		{"failure update event rejecting conflicts", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"title"}, "", nil, nil, nil, nil, false, nil, ConflictCheckReject, nil, 0},
+		{"failure find by user id in range error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []string{"title"}, "", nil, nil, nil, nil, false, nil, ConflictCheckReport, errors.New("find by user id in range error"), 0},
+		{"failure update error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "6d322c66-bf4d-427a-970c-874f3745f653", "", "title", "description", startTime, endTime, false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, "", nil, nil, nil, errors.New("update error"), false, nil, ConflictCheckNone, nil, 0},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -104,26 +231,189 @@ func TestUpdateEvent(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockUserService := mocksuser.NewMockUserService(ctrl)
-			mockUserService.EXPECT().GetUser(tt.ctx).Return(tt.userID, tt.getUserErr).AnyTimes()
+			now := time.Now()
+			eventOwnerID := domainuser.UserID{UUID: uuid.MustParse(tt.eventUserID)}
+			occurrence := event.NewEvent(event.NewEventID(), eventOwnerID, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+			overlappingEvent := event.NewEvent(event.NewEventID(), eventOwnerID, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
 			mockEvent := mocks.NewMockEvent(ctrl)
-			mockEvent.EXPECT().UserID().Return(domainuser.UserID{UUID: uuid.MustParse(tt.eventUserID)}).AnyTimes()
-			mockEvent.EXPECT().StartTime().Return(time.Now()).AnyTimes()
-			mockEvent.EXPECT().EndTime().Return(time.Now()).AnyTimes()
-			mockEvent.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
+			mockEvent.EXPECT().ID().Return(event.NewEventID()).AnyTimes()
+			mockEvent.EXPECT().IsBusyFor(eventOwnerID).Return(true).AnyTimes()
+			mockEvent.EXPECT().Occurrences(gomock.Any(), gomock.Any()).Return([]event.Event{occurrence}).AnyTimes()
+			mockEvent.EXPECT().UserID().Return(eventOwnerID).AnyTimes()
+			mockEvent.EXPECT().Title().Return(event.Title("title")).AnyTimes()
+			mockEvent.EXPECT().Description().Return(event.Description("description")).AnyTimes()
+			mockEvent.EXPECT().StartTime().Return(now).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(false).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
+			mockEvent.EXPECT().EndTime().Return(now.Add(time.Hour)).AnyTimes()
+			mockEvent.EXPECT().Color().Return(event.Color("#FFFFFF")).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().Version().Return(int64(1)).AnyTimes()
+			eventCalendarID := calendar.NewCalendarID()
+			mockEvent.EXPECT().CalendarID().Return(eventCalendarID).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
+			mockEvent.EXPECT().IsAttendee(gomock.Any()).Return(tt.isAttendee).AnyTimes()
+			mockEvent.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.updateEventErr).AnyTimes()
 			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
 			mockEventRepository.EXPECT().FindByID(gomock.Any()).Return(mockEvent, tt.findByIDErr).AnyTimes()
-			mockEventRepository.EXPECT().Update(gomock.Any()).Return(tt.updateErr).AnyTimes()
+			mockEventRepository.EXPECT().Update(gomock.Any(), int64(1)).Return(tt.updateErr).AnyTimes()
+			mockEventRepository.EXPECT().FindByUserIDInRange(eventOwnerID, gomock.Any(), gomock.Any()).Return([]event.Event{overlappingEvent}, tt.findInRangeErr).AnyTimes()
+			mockCalendar := calendar.NewCalendar(calendar.NewCalendarID(), domainuser.UserID{UUID: uuid.MustParse(tt.calendarUserID)}, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now())
+			mockCalendarRepository.EXPECT().FindDefaultByUserID(gomock.Any()).Return(mockCalendar, tt.findCalendarErr).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), eventCalendarID, calendar.AccessLevelWrite).Return(mockCalendar, tt.authorizeErr).AnyTimes()
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelWrite).Return(mockCalendar, tt.findCalendarErr).AnyTimes()
+			mockPolicy.EXPECT().AccessibleCalendarIDs(gomock.Any(), calendar.AccessLevelRead).Return(nil, nil).AnyTimes()
 
-			eventUsecase := NewEventUsecase(mockUserService, mockEventRepository)
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
 
-			_, err := eventUsecase.UpdateEvent(tt.ctx, tt.eventID, tt.title, tt.description, tt.startTime, tt.endTime, tt.color)
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, conflicts, err := eventUsecase.UpdateEvent(ctx, UpdateEventInput{
+				EventID:       tt.eventID,
+				CalendarID:    tt.calendarID,
+				Title:         tt.title,
+				Description:   tt.description,
+				StartTime:     tt.startTime,
+				EndTime:       tt.endTime,
+				AllDay:        tt.allDay,
+				StartDate:     tt.startDate,
+				EndDate:       tt.endDate,
+				TimeZone:      tt.timeZone,
+				Color:         tt.color,
+				Recurrence:    tt.recurrence,
+				Invitees:      tt.invitees,
+				Reminders:     tt.reminders,
+				UpdateMask:    tt.updateMask,
+				ETag:          tt.etag,
+				ConflictCheck: tt.conflictCheck,
+			})
 			if tt.success && err != nil {
 				t.Errorf("expected no error, but got %v", err)
 			}
 			if !tt.success && err == nil {
 				t.Errorf("expected error, but got nil")
 			}
+			if tt.success && len(conflicts) != tt.expectedConflicts {
+				t.Errorf("len(conflicts) = %d, want %d", len(conflicts), tt.expectedConflicts)
+			}
+		})
+	}
+}
+
+func TestUpdateEventUnsetFields(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	attendee, err := event.NewAttendee(domainuser.UserID{}, "guest@example.com", event.AttendeeRoleRequired, event.ResponseStatusNeedsAction)
+	if err != nil {
+		t.Fatalf("failed to new attendee: %v", err)
+	}
+	tests := []struct {
+		name              string
+		updateMask        []string
+		expectedAttendees int
+	}{
+		{"without mask unset attendees are kept", nil, 1},
+		{"with wildcard mask unset attendees are cleared", []string{"*"}, 0},
+		{"with attendees in mask unset attendees are cleared", []string{"attendees"}, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userID := "6d322c66-bf4d-427a-970c-874f3745f653"
+			ownerID := domainuser.UserID{UUID: uuid.MustParse(userID)}
+			storedCalendar := calendar.NewCalendar(calendar.NewCalendarID(), ownerID, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now())
+			storedEvent := event.NewEvent(event.NewEventID(), ownerID, storedCalendar.ID(), event.Title("title"), event.Description("description"), start, start.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, []event.Attendee{attendee}, nil, "", 1, time.Now(), time.Now(), time.Time{})
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockEventRepository.EXPECT().FindByID(storedEvent.ID()).Return(storedEvent, nil).AnyTimes()
+			mockEventRepository.EXPECT().Update(gomock.Any(), int64(1)).Return(nil).AnyTimes()
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockCalendarRepository.EXPECT().FindDefaultByUserID(ownerID).Return(storedCalendar, nil).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(ownerID, storedCalendar.ID(), calendar.AccessLevelWrite).Return(storedCalendar, nil).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := auth.NewContext(context.Background(), auth.Principal{UserID: userID})
+			updated, _, err := eventUsecase.UpdateEvent(ctx, UpdateEventInput{
+				EventID:     storedEvent.ID().String(),
+				Title:       "new title",
+				Description: "description",
+				StartTime:   timestamppb.New(start),
+				EndTime:     timestamppb.New(start.Add(time.Hour)),
+				Color:       "#FFFFFF",
+				UpdateMask:  tt.updateMask,
+			})
+			if err != nil {
+				t.Fatalf("expected no error, but got %v", err)
+			}
+			if len(updated.Attendees()) != tt.expectedAttendees {
+				t.Errorf("len(Attendees()) = %d, want %d", len(updated.Attendees()), tt.expectedAttendees)
+			}
+		})
+	}
+}
+
+func TestUpdateEventKeepsStoredAllDayDates(t *testing.T) {
+	t.Parallel()
+	// Times read back from the database carry time.Local, which is a
+	// different *time.Location from time.UTC even when its offset is zero.
+	local := time.FixedZone("Local", 0)
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC).In(local)
+	tests := []struct {
+		name    string
+		success bool
+		start   time.Time
+	}{
+		{"success update title of all-day event", true, start},
+		{"failure stored start not aligned", false, start.Add(time.Hour)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userID := "6d322c66-bf4d-427a-970c-874f3745f653"
+			ownerID := domainuser.UserID{UUID: uuid.MustParse(userID)}
+			storedCalendar := calendar.NewCalendar(calendar.NewCalendarID(), ownerID, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now())
+			storedEvent := event.NewEvent(event.NewEventID(), ownerID, storedCalendar.ID(), event.Title("title"), event.Description("description"), tt.start, start.AddDate(0, 0, 1), true, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockEventRepository.EXPECT().FindByID(storedEvent.ID()).Return(storedEvent, nil).AnyTimes()
+			mockEventRepository.EXPECT().Update(gomock.Any(), int64(1)).Return(nil).AnyTimes()
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(ownerID, storedCalendar.ID(), calendar.AccessLevelWrite).Return(storedCalendar, nil).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := auth.NewContext(context.Background(), auth.Principal{UserID: userID})
+			updated, _, err := eventUsecase.UpdateEvent(ctx, UpdateEventInput{
+				EventID:    storedEvent.ID().String(),
+				Title:      "new title",
+				UpdateMask: []string{"title"},
+			})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && !updated.AllDay() {
+				t.Errorf("AllDay() = %v, want %v", updated.AllDay(), true)
+			}
 		})
 	}
 }
@@ -131,20 +421,21 @@ func TestUpdateEvent(t *testing.T) {
 func TestGetEvent(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name        string
-		success     bool
-		ctx         context.Context
-		eventUserID string
-		userID      string
-		getUserErr  error
-		eventID     string
-		findByIDErr error
+		name         string
+		success      bool
+		ctx          context.Context
+		userID       string
+		eventID      string
+		findByIDErr  error
+		authorizeErr error
+		isAttendee   bool
 	}{
-		{"success get event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil},
-		{"failure get user error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", errors.New("get user error"), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil},
-		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "00000000-0000-0000-0000-000000000001", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil},
-		{"failure empty event id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "", nil},
-		{"failure find by id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", errors.New("find by id error")},
+		{"success get event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, nil, false},
+		{"failure unauthenticated", false, context.Background(), "", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, nil, false},
+		{"failure permission denied", false, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, calendar.ErrPermissionDenied, false},
+		{"success get invited event", true, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, calendar.ErrPermissionDenied, true},
+		{"failure empty event id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", nil, nil, false},
+		{"failure find by id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", errors.New("find by id error"), nil, false},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -154,16 +445,23 @@ func TestGetEvent(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockUserService := mocksuser.NewMockUserService(ctrl)
-			mockUserService.EXPECT().GetUser(tt.ctx).Return(tt.userID, tt.getUserErr).AnyTimes()
 			mockEvent := mocks.NewMockEvent(ctrl)
-			mockEvent.EXPECT().UserID().Return(domainuser.UserID{UUID: uuid.MustParse(tt.eventUserID)}).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().IsAttendee(gomock.Any()).Return(tt.isAttendee).AnyTimes()
 			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelRead).Return(nil, tt.authorizeErr).AnyTimes()
 			mockEventRepository.EXPECT().FindByID(gomock.Any()).Return(mockEvent, tt.findByIDErr).AnyTimes()
 
-			eventUsecase := NewEventUsecase(mockUserService, mockEventRepository)
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
 
-			_, err := eventUsecase.GetEvent(tt.ctx, tt.eventID)
+			_, err := eventUsecase.GetEvent(ctx, tt.eventID)
 			if tt.success && err != nil {
 				t.Errorf("expected no error, but got %v", err)
 			}
@@ -175,19 +473,226 @@ func TestGetEvent(t *testing.T) {
 }
 
 func TestListEvents(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	tests := []struct {
+		name                          string
+		success                       bool
+		ctx                           context.Context
+		userID                        string
+		calendarIDs                   []string
+		startTime                     *timestamppb.Timestamp
+		endTime                       *timestamppb.Timestamp
+		pageSize                      int32
+		pageToken                     string
+		accessibleErr                 error
+		authorizeErr                  error
+		findAllByCalendarIDsErr       error
+		findByCalendarIDsInRangeErr   error
+		findRecurringByCalendarIDsErr error
+	}{
+		{"success list events", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, nil, 0, "", nil, nil, nil, nil, nil},
+		{"success list events in window", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now), timestamppb.New(now.Add(time.Hour)), 0, "", nil, nil, nil, nil, nil},
+		{"success list events with page token", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now), timestamppb.New(now.Add(time.Hour)), 1, event.NewCursor(now, event.NewEventID()).Token(), nil, nil, nil, nil, nil},
+		{"success list events in calendars", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", []string{"0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e"}, nil, nil, 0, "", nil, nil, nil, nil, nil},
+		{"success list events in calendars in window", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", []string{"0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e"}, timestamppb.New(now), timestamppb.New(now.Add(time.Hour)), 0, "", nil, nil, nil, nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", nil, nil, nil, 0, "", nil, nil, nil, nil, nil},
+		{"failure invalid user id", false, context.Background(), "invalid", nil, nil, nil, 0, "", nil, nil, nil, nil, nil},
+		{"failure invalid calendar id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", []string{"invalid"}, nil, nil, 0, "", nil, nil, nil, nil, nil},
+		{"failure accessible calendar ids error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, nil, 0, "", errors.New("accessible calendar ids error"), nil, nil, nil, nil},
+		{"failure calendar permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", []string{"0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e"}, nil, nil, 0, "", nil, calendar.ErrPermissionDenied, nil, nil, nil},
+		{"failure missing end time", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now), nil, 0, "", nil, nil, nil, nil, nil},
+		{"failure inverted window", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now.Add(time.Hour)), timestamppb.New(now), 0, "", nil, nil, nil, nil, nil},
+		{"success longest window", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now), timestamppb.New(now.Add(maxListWindow)), 0, "", nil, nil, nil, nil, nil},
+		{"failure window too long", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now), timestamppb.New(now.Add(maxListWindow + time.Second)), 0, "", nil, nil, nil, nil, nil},
+		{"failure page size without window", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, nil, 10, "", nil, nil, nil, nil, nil},
+		{"failure negative page size", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now), timestamppb.New(now.Add(time.Hour)), -1, "", nil, nil, nil, nil, nil},
+		{"failure invalid page token", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now), timestamppb.New(now.Add(time.Hour)), 0, "invalid", nil, nil, nil, nil, nil},
+		{"failure find all by calendar ids error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, nil, 0, "", nil, nil, errors.New("find all by calendar ids error"), nil, nil},
+		{"failure find by calendar ids in range error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now), timestamppb.New(now.Add(time.Hour)), 0, "", nil, nil, nil, errors.New("find by calendar ids in range error"), nil},
+		{"failure find recurring by calendar ids error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, timestamppb.New(now), timestamppb.New(now.Add(time.Hour)), 0, "", nil, nil, nil, nil, errors.New("find recurring by calendar ids error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEvent := mocks.NewMockEvent(ctrl)
+			mockEvent.EXPECT().ID().Return(event.NewEventID()).AnyTimes()
+			mockEvent.EXPECT().StartTime().Return(now.Add(time.Minute)).AnyTimes()
+			mockEvent.EXPECT().EndTime().Return(now.Add(2 * time.Minute)).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockRecurringEvent := mocks.NewMockEvent(ctrl)
+			mockRecurringEvent.EXPECT().Occurrences(gomock.Any(), gomock.Any()).Return([]event.Event{mockEvent}).AnyTimes()
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockEventRepository.EXPECT().FindAllByCalendarIDs(gomock.Any(), gomock.Any()).Return([]event.Event{mockEvent}, tt.findAllByCalendarIDsErr).AnyTimes()
+			mockEventRepository.EXPECT().FindByCalendarIDsInRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]event.Event{mockEvent}, tt.findByCalendarIDsInRangeErr).AnyTimes()
+			mockEventRepository.EXPECT().FindRecurringByCalendarIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]event.Event{mockRecurringEvent}, tt.findRecurringByCalendarIDsErr).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().AccessibleCalendarIDs(gomock.Any(), calendar.AccessLevelRead).Return([]calendar.CalendarID{calendar.NewCalendarID()}, tt.accessibleErr).AnyTimes()
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelRead).Return(nil, tt.authorizeErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			events, nextPageToken, err := eventUsecase.ListEvents(ctx, tt.calendarIDs, tt.startTime, tt.endTime, tt.pageSize, tt.pageToken)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && tt.pageSize > 0 && len(events) > int(tt.pageSize) {
+				t.Errorf("len(events) = %v, want <= %v", len(events), tt.pageSize)
+			}
+			if tt.success && tt.pageSize > 0 && nextPageToken == "" {
+				t.Errorf("expected next page token, but got empty")
+			}
+		})
+	}
+}
+
+func TestDeleteEvent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		userID           string
+		eventID          string
+		etag             string
+		findByIDErr      error
+		deleteErr        error
+		authorizeErr     error
+		isAttendee       bool
+		authorizeEditErr error
+	}{
+		{"success delete event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", nil, nil, nil, false, nil},
+		{"success delete event with matching etag", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", `"1"`, nil, nil, nil, false, nil},
+		{"failure unauthenticated", false, context.Background(), "", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", nil, nil, nil, false, nil},
+		{"failure permission denied", false, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", nil, nil, calendar.ErrPermissionDenied, false, nil},
+		{"failure attendee is not organizer", false, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", nil, nil, calendar.ErrPermissionDenied, true, event.ErrNotOrganizer},
+		{"failure empty event id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", "", nil, nil, nil, false, nil},
+		{"failure stale etag", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", `"2"`, nil, nil, nil, false, nil},
+		{"failure find by id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", errors.New("find by id error"), nil, nil, false, nil},
+		{"failure delete error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", nil, errors.New("delete error"), nil, false, nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEvent := mocks.NewMockEvent(ctrl)
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().Version().Return(int64(1)).AnyTimes()
+			mockEvent.EXPECT().IsAttendee(gomock.Any()).Return(tt.isAttendee).AnyTimes()
+			mockEvent.EXPECT().AuthorizeEdit(gomock.Any()).Return(tt.authorizeEditErr).AnyTimes()
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockCalendar := calendar.NewCalendar(calendar.NewCalendarID(), domainuser.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now())
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelWrite).Return(mockCalendar, tt.authorizeErr).AnyTimes()
+			mockEventRepository.EXPECT().FindByID(gomock.Any()).Return(mockEvent, tt.findByIDErr).AnyTimes()
+			mockEventRepository.EXPECT().Delete(gomock.Any(), int64(1)).Return(tt.deleteErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			err := eventUsecase.DeleteEvent(ctx, tt.eventID, tt.etag)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestListDeletedEvents(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                        string
+		success                     bool
+		ctx                         context.Context
+		userID                      string
+		accessibleErr               error
+		findDeletedByCalendarIDsErr error
+	}{
+		{"success list deleted events", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", nil, nil},
+		{"failure accessible calendar ids error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", errors.New("accessible calendar ids error"), nil},
+		{"failure find deleted by calendar ids error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, errors.New("find deleted by calendar ids error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEvent := mocks.NewMockEvent(ctrl)
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			calendarIDs := []calendar.CalendarID{calendar.NewCalendarID()}
+			mockPolicy.EXPECT().AccessibleCalendarIDs(gomock.Any(), calendar.AccessLevelWrite).Return(calendarIDs, tt.accessibleErr).AnyTimes()
+			mockEventRepository.EXPECT().FindDeletedByCalendarIDs(calendarIDs).Return([]event.Event{mockEvent}, tt.findDeletedByCalendarIDsErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := eventUsecase.ListDeletedEvents(ctx)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestRestoreEvent(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name               string
 		success            bool
 		ctx                context.Context
 		userID             string
-		getUserErr         error
-		findAllByUserIDErr error
+		eventID            string
+		findDeletedByIDErr error
+		restoreErr         error
+		findByIDErr        error
+		authorizeErr       error
 	}{
-		{"success list events", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil},
-		{"failure get user error", false, context.Background(), "", errors.New("get user error"), nil},
-		{"failure empty user id", false, context.Background(), "", nil, nil},
-		{"failure find all by user id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, errors.New("find all by user id error")},
+		{"success restore event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, nil, nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, nil, nil, nil},
+		{"failure permission denied", false, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, nil, nil, calendar.ErrPermissionDenied},
+		{"failure empty event id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", nil, nil, nil, nil},
+		{"failure event not in trash", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", event.ErrEventNotFound, nil, nil, nil},
+		{"failure restore error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, errors.New("restore error"), nil, nil},
+		{"failure find by id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, nil, errors.New("find by id error"), nil},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -197,15 +702,24 @@ func TestListEvents(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockUserService := mocksuser.NewMockUserService(ctrl)
-			mockUserService.EXPECT().GetUser(tt.ctx).Return(tt.userID, tt.getUserErr).AnyTimes()
 			mockEvent := mocks.NewMockEvent(ctrl)
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
 			mockEventRepository := mocks.NewMockEventRepository(ctrl)
-			mockEventRepository.EXPECT().FindAllByUserID(gomock.Any()).Return([]event.Event{mockEvent}, tt.findAllByUserIDErr).AnyTimes()
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(gomock.Any(), gomock.Any(), calendar.AccessLevelWrite).Return(nil, tt.authorizeErr).AnyTimes()
+			mockEventRepository.EXPECT().FindDeletedByID(gomock.Any()).Return(mockEvent, tt.findDeletedByIDErr).AnyTimes()
+			mockEventRepository.EXPECT().Restore(gomock.Any()).Return(tt.restoreErr).AnyTimes()
+			mockEventRepository.EXPECT().FindByID(gomock.Any()).Return(mockEvent, tt.findByIDErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
 
-			eventUsecase := NewEventUsecase(mockUserService, mockEventRepository)
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
 
-			_, err := eventUsecase.ListEvents(tt.ctx)
+			_, err := eventUsecase.RestoreEvent(ctx, tt.eventID)
 			if tt.success && err != nil {
 				t.Errorf("expected no error, but got %v", err)
 			}
@@ -216,25 +730,26 @@ func TestListEvents(t *testing.T) {
 	}
 }
 
-func TestDeleteEvent(t *testing.T) {
+func TestRespondToEvent(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name        string
-		success     bool
-		ctx         context.Context
-		eventUserID string
-		userID      string
-		getUserErr  error
-		eventID     string
-		findByIDErr error
-		deleteErr   error
+		name           string
+		success        bool
+		ctx            context.Context
+		userID         string
+		eventID        string
+		responseStatus string
+		findByIDErr    error
+		respondErr     error
+		updateErr      error
 	}{
-		{"success delete event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, nil},
-		{"failure get user error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", errors.New("get user error"), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, nil},
-		{"failure permission denied", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "00000000-0000-0000-0000-000000000001", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, nil},
-		{"failure empty event id", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "", nil, nil},
-		{"failure find by id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", errors.New("find by id error"), nil},
-		{"failure delete error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "6d322c66-bf4d-427a-970c-874f3745f653", nil, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil, errors.New("delete error")},
+		{"success respond to event", true, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "accepted", nil, nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "accepted", nil, nil, nil},
+		{"failure empty event id", false, context.Background(), "00000000-0000-0000-0000-000000000001", "", "accepted", nil, nil, nil},
+		{"failure invalid response status", false, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "maybe", nil, nil, nil},
+		{"failure find by id error", false, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "accepted", errors.New("find by id error"), nil, nil},
+		{"failure not attendee", false, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "accepted", nil, event.ErrNotAttendee, nil},
+		{"failure update error", false, context.Background(), "00000000-0000-0000-0000-000000000001", "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "accepted", nil, nil, errors.New("update error")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -244,17 +759,23 @@ func TestDeleteEvent(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockUserService := mocksuser.NewMockUserService(ctrl)
-			mockUserService.EXPECT().GetUser(tt.ctx).Return(tt.userID, tt.getUserErr).AnyTimes()
 			mockEvent := mocks.NewMockEvent(ctrl)
-			mockEvent.EXPECT().UserID().Return(domainuser.UserID{UUID: uuid.MustParse(tt.eventUserID)}).AnyTimes()
+			mockEvent.EXPECT().Version().Return(int64(1)).AnyTimes()
+			mockEvent.EXPECT().Respond(gomock.Any(), event.ResponseStatus(tt.responseStatus)).Return(tt.respondErr).AnyTimes()
 			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
 			mockEventRepository.EXPECT().FindByID(gomock.Any()).Return(mockEvent, tt.findByIDErr).AnyTimes()
-			mockEventRepository.EXPECT().Delete(gomock.Any()).Return(tt.deleteErr).AnyTimes()
+			mockEventRepository.EXPECT().Update(gomock.Any(), int64(1)).Return(tt.updateErr).AnyTimes()
 
-			eventUsecase := NewEventUsecase(mockUserService, mockEventRepository)
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
 
-			err := eventUsecase.DeleteEvent(tt.ctx, tt.eventID)
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := eventUsecase.RespondToEvent(ctx, tt.eventID, tt.responseStatus)
 			if tt.success && err != nil {
 				t.Errorf("expected no error, but got %v", err)
 			}
@@ -264,3 +785,682 @@ func TestDeleteEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestExportEvents(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name               string
+		success            bool
+		ctx                context.Context
+		userID             string
+		findAllByUserIDErr error
+	}{
+		{"success export events", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil},
+		{"failure unauthenticated", false, context.Background(), "", nil},
+		{"failure find all by user id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", errors.New("find all by user id error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockEventRepository.EXPECT().FindAllByUserID(gomock.Any()).Return([]event.Event{}, tt.findAllByUserIDErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			data, err := eventUsecase.ExportEvents(ctx)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && !bytes.HasPrefix(data, []byte("BEGIN:VCALENDAR")) {
+				t.Errorf("ExportEvents() = %q, want a VCALENDAR object", data)
+			}
+		})
+	}
+}
+
+func TestImportEvents(t *testing.T) {
+	t.Parallel()
+	validData := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\nSUMMARY:title\r\nDESCRIPTION:description\r\nDTSTART:20250106T090000Z\r\nDTEND:20250106T100000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	invalidItemData := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\nSUMMARY:title\r\nDESCRIPTION:description\r\nDTSTART:20250106T100000Z\r\nDTEND:20250106T090000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	noDescriptionData := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\nSUMMARY:title\r\nDTSTART:20250106T090000Z\r\nDTEND:20250106T100000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		userID           string
+		data             string
+		existing         bool
+		findByICalUIDErr error
+		createErr        error
+		updateErr        error
+		expectedCreated  bool
+		expectedItemErr  bool
+	}{
+		{"success create imported event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, false, event.ErrEventNotFound, nil, nil, true, false},
+		{"success update imported event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, true, nil, nil, nil, false, false},
+		{"success create imported event without description", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", noDescriptionData, false, event.ErrEventNotFound, nil, nil, true, false},
+		{"success update imported event without description", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", noDescriptionData, true, nil, nil, nil, false, false},
+		{"success invalid item is reported", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", invalidItemData, false, event.ErrEventNotFound, nil, nil, false, true},
+		{"success version conflict is reported", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, true, nil, nil, event.ErrVersionConflict, false, true},
+		{"failure unauthenticated", false, context.Background(), "", validData, false, event.ErrEventNotFound, nil, nil, false, false},
+		{"failure invalid data", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "not a calendar", false, event.ErrEventNotFound, nil, nil, false, false},
+		{"failure find by ical uid error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, false, errors.New("find by ical uid error"), nil, nil, false, false},
+		{"failure create error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, false, event.ErrEventNotFound, errors.New("create error"), nil, false, false},
+		{"failure update error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, true, nil, nil, errors.New("update error"), false, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var foundEvent event.Event
+			if tt.existing {
+				mockEvent := mocks.NewMockEvent(ctrl)
+				mockEvent.EXPECT().Version().Return(int64(1)).AnyTimes()
+				mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+				mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+				mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
+				mockEvent.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				foundEvent = mockEvent
+			}
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockEventRepository.EXPECT().FindByICalUID(gomock.Any(), "a@example.com").Return(foundEvent, tt.findByICalUIDErr).AnyTimes()
+			mockEventRepository.EXPECT().Create(gomock.Any()).Return(tt.createErr).AnyTimes()
+			mockEventRepository.EXPECT().Update(gomock.Any(), int64(1)).Return(tt.updateErr).AnyTimes()
+			mockCalendarRepository.EXPECT().FindDefaultByUserID(gomock.Any()).Return(calendar.NewCalendar(calendar.NewCalendarID(), domainuser.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now()), nil).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			results, err := eventUsecase.ImportEvents(ctx, tt.data)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if !tt.success {
+				return
+			}
+			if len(results) != 1 {
+				t.Fatalf("len(ImportEvents()) = %v, want 1", len(results))
+			}
+			if results[0].Created != tt.expectedCreated {
+				t.Errorf("Created = %v, want %v", results[0].Created, tt.expectedCreated)
+			}
+			if (results[0].Err != nil) != tt.expectedItemErr {
+				t.Errorf("Err = %v, want error %v", results[0].Err, tt.expectedItemErr)
+			}
+			if tt.expectedCreated && results[0].Event.ICalUID() != "a@example.com" {
+				t.Errorf("ICalUID() = %v, want %v", results[0].Event.ICalUID(), "a@example.com")
+			}
+		})
+	}
+}
+
+func TestListICalendarEvents(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	ownCalendar := calendar.NewCalendar(calendar.NewCalendarID(), domainuser.UserID{UUID: uuid.New()}, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now())
+	daily, err := event.NewRecurrence([]string{"RRULE:FREQ=DAILY"})
+	if err != nil {
+		t.Fatalf("expected no error, but got %v", err)
+	}
+	single, err := event.NewRecurrence([]string{"RRULE:FREQ=DAILY;COUNT=1"})
+	if err != nil {
+		t.Fatalf("expected no error, but got %v", err)
+	}
+	timedEvent := event.NewEvent(event.NewEventID(), ownCalendar.UserID(), ownCalendar.ID(), event.Title("title"), event.Description("description"), from, from.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "a@example.com", 1, from, from, time.Time{})
+	recurringEvent := event.NewEvent(event.NewEventID(), ownCalendar.UserID(), ownCalendar.ID(), event.Title("title"), event.Description("description"), from.AddDate(0, -1, 0), from.AddDate(0, -1, 0).Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), daily, nil, nil, "b@example.com", 1, from, from, time.Time{})
+	endedEvent := event.NewEvent(event.NewEventID(), ownCalendar.UserID(), ownCalendar.ID(), event.Title("title"), event.Description("description"), from.AddDate(0, -1, 0), from.AddDate(0, -1, 0).Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), single, nil, nil, "c@example.com", 1, from, from, time.Time{})
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		userID           string
+		startTime        *timestamppb.Timestamp
+		endTime          *timestamppb.Timestamp
+		findAllErr       error
+		findCalendarsErr error
+		findInRangeErr   error
+		findRecurringErr error
+		expectedCount    int
+	}{
+		{"success list every event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, nil, nil, nil, nil, 1},
+		{"success list events in window", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", timestamppb.New(from), timestamppb.New(to), nil, nil, nil, nil, 2},
+		{"failure unauthenticated", false, context.Background(), "", nil, nil, nil, nil, nil, nil, 0},
+		{"failure start time without end time", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", timestamppb.New(from), nil, nil, nil, nil, nil, 0},
+		{"failure empty window", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", timestamppb.New(to), timestamppb.New(from), nil, nil, nil, nil, 0},
+		{"failure find all by user id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil, nil, errors.New("find all by user id error"), nil, nil, nil, 0},
+		{"failure find calendars error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", timestamppb.New(from), timestamppb.New(to), nil, errors.New("find calendars error"), nil, nil, 0},
+		{"failure find in range error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", timestamppb.New(from), timestamppb.New(to), nil, nil, errors.New("find in range error"), nil, 0},
+		{"failure find recurring error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", timestamppb.New(from), timestamppb.New(to), nil, nil, nil, errors.New("find recurring error"), 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ownCalendarIDs := []calendar.CalendarID{ownCalendar.ID()}
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockEventRepository.EXPECT().FindAllByUserID(gomock.Any()).Return([]event.Event{timedEvent}, tt.findAllErr).AnyTimes()
+			mockCalendarRepository.EXPECT().FindAllByUserID(gomock.Any()).Return([]calendar.Calendar{ownCalendar}, tt.findCalendarsErr).AnyTimes()
+			mockEventRepository.EXPECT().FindByCalendarIDsInRange(ownCalendarIDs, domainuser.UserID{}, from, to, nil, -1).Return([]event.Event{timedEvent}, tt.findInRangeErr).AnyTimes()
+			mockEventRepository.EXPECT().FindRecurringByCalendarIDs(ownCalendarIDs, domainuser.UserID{}, to).Return([]event.Event{recurringEvent, endedEvent}, tt.findRecurringErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			events, err := eventUsecase.ListICalendarEvents(ctx, tt.startTime, tt.endTime)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(events) != tt.expectedCount {
+				t.Errorf("len(events) = %v, want %v", len(events), tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestGetEventByICalUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		userID           string
+		findByICalUIDErr error
+	}{
+		{"success get event by ical uid", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil},
+		{"failure unauthenticated", false, context.Background(), "", nil},
+		{"failure invalid user id", false, context.Background(), "invalid", nil},
+		{"failure event not found", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", event.ErrEventNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEvent := mocks.NewMockEvent(ctrl)
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockEventRepository.EXPECT().FindByICalUID(gomock.Any(), "a@example.com").Return(mockEvent, tt.findByICalUIDErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := eventUsecase.GetEventByICalUID(ctx, "a@example.com")
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestSaveICalendarEvent(t *testing.T) {
+	t.Parallel()
+	validData := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\nSUMMARY:title\r\nDESCRIPTION:description\r\nDTSTART:20250106T090000Z\r\nDTEND:20250106T100000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	invalidItemData := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\nSUMMARY:title\r\nDESCRIPTION:description\r\nDTSTART:20250106T100000Z\r\nDTEND:20250106T090000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	noDescriptionData := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\nSUMMARY:title\r\nDTSTART:20250106T090000Z\r\nDTEND:20250106T100000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	otherUIDData := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:b@example.com\r\nSUMMARY:title\r\nDESCRIPTION:description\r\nDTSTART:20250106T090000Z\r\nDTEND:20250106T100000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		userID           string
+		data             string
+		etag             string
+		existing         bool
+		findByICalUIDErr error
+		createErr        error
+		updateErr        error
+		expectedCreated  bool
+	}{
+		{"success create event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, "", false, event.ErrEventNotFound, nil, nil, true},
+		{"success update event", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, "", true, nil, nil, nil, false},
+		{"success create event without description", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", noDescriptionData, "", false, event.ErrEventNotFound, nil, nil, true},
+		{"success update event with etag", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, `"1"`, true, nil, nil, nil, false},
+		{"failure unauthenticated", false, context.Background(), "", validData, "", false, event.ErrEventNotFound, nil, nil, false},
+		{"failure invalid data", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "not a calendar", "", false, event.ErrEventNotFound, nil, nil, false},
+		{"failure uid mismatch", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", otherUIDData, "", false, event.ErrEventNotFound, nil, nil, false},
+		{"failure invalid item", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", invalidItemData, "", false, event.ErrEventNotFound, nil, nil, false},
+		{"failure etag for missing event", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, `"1"`, false, event.ErrEventNotFound, nil, nil, false},
+		{"failure etag mismatch", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, `"2"`, true, nil, nil, nil, false},
+		{"failure create error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, "", false, event.ErrEventNotFound, errors.New("create error"), nil, false},
+		{"failure version conflict", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", validData, "", true, nil, nil, event.ErrVersionConflict, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var foundEvent event.Event
+			if tt.existing {
+				mockEvent := mocks.NewMockEvent(ctrl)
+				mockEvent.EXPECT().Version().Return(int64(1)).AnyTimes()
+				mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+				mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+				mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
+				mockEvent.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				foundEvent = mockEvent
+			}
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockEventRepository.EXPECT().FindByICalUID(gomock.Any(), "a@example.com").Return(foundEvent, tt.findByICalUIDErr).AnyTimes()
+			mockEventRepository.EXPECT().Create(gomock.Any()).Return(tt.createErr).AnyTimes()
+			mockEventRepository.EXPECT().Update(gomock.Any(), int64(1)).Return(tt.updateErr).AnyTimes()
+			mockCalendarRepository.EXPECT().FindDefaultByUserID(gomock.Any()).Return(calendar.NewCalendar(calendar.NewCalendarID(), domainuser.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now()), nil).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, created, err := eventUsecase.SaveICalendarEvent(ctx, "a@example.com", tt.data, tt.etag)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && created != tt.expectedCreated {
+				t.Errorf("created = %v, want %v", created, tt.expectedCreated)
+			}
+		})
+	}
+}
+
+func TestQueryFreeBusy(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC)
+	colleagueID := "00000000-0000-0000-0000-000000000001"
+	tooManyUserIDs := make([]string, maxFreeBusyUsers+1)
+	for i := range tooManyUserIDs {
+		tooManyUserIDs[i] = colleagueID
+	}
+	tests := []struct {
+		name              string
+		success           bool
+		ctx               context.Context
+		userID            string
+		userIDs           []string
+		startTime         *timestamppb.Timestamp
+		endTime           *timestamppb.Timestamp
+		visibleIDs        []calendar.CalendarID
+		visibleErr        error
+		findInRangeErr    error
+		findRecurringErr  error
+		expectedBusy      int
+		expectedResultErr bool
+	}{
+		{"success query own free busy", true, context.Background(), colleagueID, []string{colleagueID}, timestamppb.New(from), timestamppb.New(to), nil, nil, nil, nil, 2, false},
+		{"success query colleague free busy", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", []string{colleagueID}, timestamppb.New(from), timestamppb.New(to), []calendar.CalendarID{calendar.NewCalendarID()}, nil, nil, nil, 2, false},
+		{"success colleague without access is reported", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", []string{colleagueID}, timestamppb.New(from), timestamppb.New(to), nil, nil, nil, nil, 0, true},
+		{"failure unauthenticated", false, context.Background(), "", []string{colleagueID}, timestamppb.New(from), timestamppb.New(to), nil, nil, nil, nil, 0, false},
+		{"failure no user ids", false, context.Background(), colleagueID, nil, timestamppb.New(from), timestamppb.New(to), nil, nil, nil, nil, 0, false},
+		{"failure too many user ids", false, context.Background(), colleagueID, tooManyUserIDs, timestamppb.New(from), timestamppb.New(to), nil, nil, nil, nil, 0, false},
+		{"failure invalid user id", false, context.Background(), colleagueID, []string{"invalid"}, timestamppb.New(from), timestamppb.New(to), nil, nil, nil, nil, 0, false},
+		{"failure missing window", false, context.Background(), colleagueID, []string{colleagueID}, nil, nil, nil, nil, nil, nil, 0, false},
+		{"failure inverted window", false, context.Background(), colleagueID, []string{colleagueID}, timestamppb.New(to), timestamppb.New(from), nil, nil, nil, nil, 0, false},
+		{"failure window too long", false, context.Background(), colleagueID, []string{colleagueID}, timestamppb.New(from), timestamppb.New(from.Add(maxFreeBusyWindow + time.Hour)), nil, nil, nil, nil, 0, false},
+		{"failure visible calendar ids error", false, context.Background(), colleagueID, []string{colleagueID}, timestamppb.New(from), timestamppb.New(to), nil, errors.New("visible calendar ids error"), nil, nil, 0, false},
+		{"failure find by calendar ids in range error", false, context.Background(), colleagueID, []string{colleagueID}, timestamppb.New(from), timestamppb.New(to), nil, nil, errors.New("find by calendar ids in range error"), nil, 0, false},
+		{"failure find recurring by calendar ids error", false, context.Background(), colleagueID, []string{colleagueID}, timestamppb.New(from), timestamppb.New(to), nil, nil, nil, errors.New("find recurring by calendar ids error"), 0, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ownerID := domainuser.UserID{UUID: uuid.MustParse(colleagueID)}
+			recurrence, err := event.NewRecurrence([]string{"RRULE:FREQ=DAILY"})
+			if err != nil {
+				t.Fatalf("failed to new recurrence: %v", err)
+			}
+			events := []event.Event{
+				event.NewEvent(event.NewEventID(), ownerID, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), from.Add(time.Hour), from.Add(2*time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{}),
+				event.NewEvent(event.NewEventID(), ownerID, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), from.Add(90*time.Minute), from.Add(3*time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{}),
+			}
+			recurringEvents := []event.Event{
+				event.NewEvent(event.NewEventID(), ownerID, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), from.Add(-24*time.Hour+6*time.Hour), from.Add(-24*time.Hour+7*time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), recurrence, nil, nil, "", 1, time.Now(), time.Now(), time.Time{}),
+			}
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().VisibleCalendarIDs(gomock.Any(), ownerID, calendar.AccessLevelFreeBusy).Return(tt.visibleIDs, tt.visibleErr).AnyTimes()
+			mockEventRepository.EXPECT().FindByCalendarIDsInRange(gomock.Any(), ownerID, from, to, nil, -1).Return(events, tt.findInRangeErr).AnyTimes()
+			mockEventRepository.EXPECT().FindRecurringByCalendarIDs(gomock.Any(), ownerID, to).Return(recurringEvents, tt.findRecurringErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			results, err := eventUsecase.QueryFreeBusy(ctx, tt.userIDs, tt.startTime, tt.endTime)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if !tt.success {
+				return
+			}
+			if len(results) != len(tt.userIDs) {
+				t.Fatalf("len(QueryFreeBusy()) = %v, want %v", len(results), len(tt.userIDs))
+			}
+			if len(results[0].Busy) != tt.expectedBusy {
+				t.Errorf("len(Busy) = %v, want %v", len(results[0].Busy), tt.expectedBusy)
+			}
+			if (results[0].Err != nil) != tt.expectedResultErr {
+				t.Errorf("Err = %v, want error %v", results[0].Err, tt.expectedResultErr)
+			}
+		})
+	}
+}
+
+func TestSuggestMeetingTimes(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)
+	userID := "6d322c66-bf4d-427a-970c-874f3745f653"
+	colleagueID := "00000000-0000-0000-0000-000000000001"
+	tooManyUserIDs := make([]string, maxFreeBusyUsers+1)
+	for i := range tooManyUserIDs {
+		tooManyUserIDs[i] = colleagueID
+	}
+	tests := []struct {
+		name            string
+		success         bool
+		ctx             context.Context
+		userID          string
+		userIDs         []string
+		durationMinutes int32
+		startTime       *timestamppb.Timestamp
+		endTime         *timestamppb.Timestamp
+		constraints     SlotConstraints
+		maxResults      int32
+		visibleIDs      []calendar.CalendarID
+		findInRangeErr  error
+		expectedSlots   int
+	}{
+		{"success suggest meeting times", true, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 2},
+		{"success with buffer", true, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{BufferMinutes: 15}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"success with working hours", true, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{WorkingHoursStart: "10:00", WorkingHoursEnd: "18:00", TimeZone: "UTC"}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 1},
+		{"success with earliest start time", true, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{EarliestStartTime: timestamppb.New(from.Add(time.Hour))}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 1},
+		{"success with latest end time", true, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{LatestEndTime: timestamppb.New(from.Add(time.Hour))}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 1},
+		{"success with max results", true, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, 1, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 1},
+		{"failure unauthenticated", false, context.Background(), "", []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure no user ids", false, context.Background(), userID, nil, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure too many user ids", false, context.Background(), userID, tooManyUserIDs, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure invalid user id", false, context.Background(), userID, []string{"invalid"}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure invalid duration", false, context.Background(), userID, []string{colleagueID}, 0, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure missing window", false, context.Background(), userID, []string{colleagueID}, 60, nil, nil, SlotConstraints{}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure window too long", false, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(from.Add(maxFreeBusyWindow + time.Hour)), SlotConstraints{}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure earliest start time after window", false, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{EarliestStartTime: timestamppb.New(to)}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure invalid working hours", false, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{WorkingHoursStart: "18:00", WorkingHoursEnd: "09:00"}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure invalid time zone", false, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{TimeZone: "Mars/Olympus_Mons"}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure invalid buffer", false, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{BufferMinutes: -1}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure invalid max results", false, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, maxSlotCount + 1, []calendar.CalendarID{calendar.NewCalendarID()}, nil, 0},
+		{"failure colleague without access", false, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, 0, nil, nil, 0},
+		{"failure find by calendar ids in range error", false, context.Background(), userID, []string{colleagueID}, 60, timestamppb.New(from), timestamppb.New(to), SlotConstraints{}, 0, []calendar.CalendarID{calendar.NewCalendarID()}, errors.New("find by calendar ids in range error"), 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			colleague := domainuser.UserID{UUID: uuid.MustParse(colleagueID)}
+			events := []event.Event{
+				event.NewEvent(event.NewEventID(), colleague, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), from.Add(time.Hour), from.Add(2*time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{}),
+			}
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().VisibleCalendarIDs(gomock.Any(), gomock.Any(), calendar.AccessLevelFreeBusy).Return(tt.visibleIDs, nil).AnyTimes()
+			mockEventRepository.EXPECT().FindByCalendarIDsInRange(gomock.Any(), colleague, gomock.Any(), gomock.Any(), nil, -1).Return(events, tt.findInRangeErr).AnyTimes()
+			mockEventRepository.EXPECT().FindByCalendarIDsInRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), nil, -1).Return(nil, tt.findInRangeErr).AnyTimes()
+			mockEventRepository.EXPECT().FindRecurringByCalendarIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			slots, err := eventUsecase.SuggestMeetingTimes(ctx, tt.userIDs, tt.durationMinutes, tt.startTime, tt.endTime, tt.constraints, tt.maxResults)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(slots) != tt.expectedSlots {
+				t.Errorf("len(SuggestMeetingTimes()) = %v, want %v", len(slots), tt.expectedSlots)
+			}
+		})
+	}
+}
+
+func TestCheckConflicts(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC)
+	userID := "6d322c66-bf4d-427a-970c-874f3745f653"
+	eventID := "fe8c2263-bbac-4bb9-a41d-b04f5afc4425"
+	tests := []struct {
+		name              string
+		success           bool
+		ctx               context.Context
+		userID            string
+		startTime         *timestamppb.Timestamp
+		endTime           *timestamppb.Timestamp
+		excludeEventID    string
+		findInRangeErr    error
+		expectedConflicts int
+	}{
+		{"success check conflicts", true, context.Background(), userID, timestamppb.New(from), timestamppb.New(to), "", nil, 2},
+		{"success check conflicts excluding event", true, context.Background(), userID, timestamppb.New(from), timestamppb.New(to), eventID, nil, 1},
+		{"failure unauthenticated", false, context.Background(), "", timestamppb.New(from), timestamppb.New(to), "", nil, 0},
+		{"failure missing window", false, context.Background(), userID, nil, nil, "", nil, 0},
+		{"failure inverted window", false, context.Background(), userID, timestamppb.New(to), timestamppb.New(from), "", nil, 0},
+		{"failure window too long", false, context.Background(), userID, timestamppb.New(from), timestamppb.New(from.Add(maxFreeBusyWindow + time.Hour)), "", nil, 0},
+		{"failure invalid exclude event id", false, context.Background(), userID, timestamppb.New(from), timestamppb.New(to), "invalid", nil, 0},
+		{"failure find by user id in range error", false, context.Background(), userID, timestamppb.New(from), timestamppb.New(to), "", errors.New("find by user id in range error"), 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ownerID := domainuser.UserID{UUID: uuid.MustParse(userID)}
+			id, err := event.NewEventIDFromString(eventID)
+			if err != nil {
+				t.Fatalf("failed to new event id: %v", err)
+			}
+			events := []event.Event{
+				event.NewEvent(id, ownerID, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), from.Add(time.Hour), from.Add(2*time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{}),
+				event.NewEvent(event.NewEventID(), ownerID, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), from.Add(3*time.Hour), from.Add(4*time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{}),
+			}
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockEventRepository.EXPECT().FindByUserIDInRange(ownerID, from, to).Return(events, tt.findInRangeErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			conflicts, err := eventUsecase.CheckConflicts(ctx, tt.startTime, tt.endTime, tt.excludeEventID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(conflicts) != tt.expectedConflicts {
+				t.Errorf("len(CheckConflicts()) = %d, want %d", len(conflicts), tt.expectedConflicts)
+			}
+		})
+	}
+}
+
+func TestSyncEvents(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	calendarIDs := []calendar.CalendarID{calendar.NewCalendarID()}
+	liveEvent := event.NewEvent(event.NewEventID(), domainuser.UserID{}, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, now, now, time.Time{})
+	deletedEvent := event.NewEvent(event.NewEventID(), domainuser.UserID{}, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, now, now, now)
+	syncToken := event.NewSyncToken(now.Add(-time.Hour), calendarIDs).Token()
+	pageToken := event.NewSyncPageToken(event.NewSyncToken(now.Add(-time.Hour), calendarIDs), liveEvent.ID()).Token()
+	tests := []struct {
+		name               string
+		success            bool
+		ctx                context.Context
+		userID             string
+		syncToken          string
+		pageSize           int32
+		pageToken          string
+		accessibleErr      error
+		findPageErr        error
+		findChangedErr     error
+		findDepartedErr    error
+		expectedErr        error
+		expectedChanged    int
+		expectedDeletedIDs int
+		expectedPageToken  bool
+	}{
+		{"success full sync", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", 0, "", nil, nil, nil, nil, nil, 2, 0, false},
+		{"success full sync first page", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", 1, "", nil, nil, nil, nil, nil, 1, 0, true},
+		{"success full sync last page", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", 2, pageToken, nil, nil, nil, nil, nil, 2, 0, false},
+		{"success incremental sync", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", syncToken, 0, "", nil, nil, nil, nil, nil, 1, 2, false},
+		{"failure unauthenticated", false, context.Background(), "", "", 0, "", nil, nil, nil, nil, nil, 0, 0, false},
+		{"failure invalid sync token", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "!!!", 0, "", nil, nil, nil, nil, event.ErrInvalidSyncToken, 0, 0, false},
+		{"failure expired sync token", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", event.NewSyncToken(now.Add(-721*time.Hour), calendarIDs).Token(), 0, "", nil, nil, nil, nil, event.ErrSyncTokenExpired, 0, 0, false},
+		{"failure calendars changed", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", event.NewSyncToken(now.Add(-time.Hour), []calendar.CalendarID{calendar.NewCalendarID()}).Token(), 0, "", nil, nil, nil, nil, event.ErrSyncTokenExpired, 0, 0, false},
+		{"failure page token with sync token", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", syncToken, 0, pageToken, nil, nil, nil, nil, event.ErrInvalidPageToken, 0, 0, false},
+		{"failure invalid page token", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", 0, "!!!", nil, nil, nil, nil, event.ErrInvalidPageToken, 0, 0, false},
+		{"failure negative page size", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", -1, "", nil, nil, nil, nil, event.ErrInvalidPageSize, 0, 0, false},
+		{"failure accessible calendar ids error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", 0, "", errors.New("accessible calendar ids error"), nil, nil, nil, nil, 0, 0, false},
+		{"failure find by calendar ids after id error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", "", 0, "", nil, errors.New("find by calendar ids after id error"), nil, nil, nil, 0, 0, false},
+		{"failure find changed by calendar ids error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", syncToken, 0, "", nil, nil, errors.New("find changed by calendar ids error"), nil, nil, 0, 0, false},
+		{"failure find departed by calendar ids error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", syncToken, 0, "", nil, nil, nil, errors.New("find departed by calendar ids error"), nil, 0, 0, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().AccessibleCalendarIDs(gomock.Any(), calendar.AccessLevelRead).Return(calendarIDs, tt.accessibleErr).AnyTimes()
+			mockEventRepository.EXPECT().FindByCalendarIDsAfterID(calendarIDs, gomock.Any(), gomock.Any(), gomock.Any()).Return([]event.Event{liveEvent, liveEvent}, tt.findPageErr).AnyTimes()
+			mockEventRepository.EXPECT().FindChangedByCalendarIDs(calendarIDs, gomock.Any(), gomock.Any()).Return([]event.Event{liveEvent, deletedEvent}, tt.findChangedErr).AnyTimes()
+			mockEventRepository.EXPECT().FindDepartedByCalendarIDs(calendarIDs, gomock.Any(), gomock.Any()).Return([]event.EventID{liveEvent.ID(), event.NewEventID()}, tt.findDepartedErr).AnyTimes()
+
+			eventUsecase := NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			changed, deletedIDs, nextPageToken, nextSyncToken, err := eventUsecase.SyncEvents(ctx, tt.syncToken, tt.pageSize, tt.pageToken)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, but got %v", tt.expectedErr, err)
+			}
+			if len(changed) != tt.expectedChanged {
+				t.Errorf("len(changed) = %v, want %v", len(changed), tt.expectedChanged)
+			}
+			if len(deletedIDs) != tt.expectedDeletedIDs {
+				t.Errorf("len(deletedIDs) = %v, want %v", len(deletedIDs), tt.expectedDeletedIDs)
+			}
+			if tt.success && (nextPageToken != "") != tt.expectedPageToken {
+				t.Errorf("nextPageToken = %q, expected page token %v", nextPageToken, tt.expectedPageToken)
+			}
+			if tt.success && (nextSyncToken != "") == tt.expectedPageToken {
+				t.Errorf("nextSyncToken = %q, expected sync token %v", nextSyncToken, !tt.expectedPageToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,362 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	appcalendar "github.com/qkitzero/event-service/internal/application/calendar"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+)
+
+// subscriptionBuffer is how many changes a watcher may fall behind the feed
+// before it is dropped and has to catch up from the outbox.
+const subscriptionBuffer = 64
+
+// Notification tells a watcher that an event it can see was created,
+// updated or deleted. An event that enters the watcher's view, for example
+// when the watcher is invited, is reported as created, and one that leaves
+// it as deleted. Event is the event as it was when the change was read, and
+// ResumeToken resumes watching after the change.
+type Notification struct {
+	Type        event.ChangeType
+	Event       event.Event
+	OccurredAt  time.Time
+	ResumeToken string
+}
+
+type EventWatcher interface {
+	WatchEvents(ctx context.Context, resumeToken string, send func(Notification) error) error
+}
+
+type changedEvent struct {
+	change event.Change
+	event  event.Event
+}
+
+// ChangeFeed tails the outbox and fans the changes out to the watchers in
+// this process. Every replica tails the outbox itself, so watchers see the
+// changes made through any replica.
+type ChangeFeed struct {
+	eventRepo event.EventRepository
+	interval  time.Duration
+	batchSize int
+
+	started  bool
+	position event.ChangePosition
+
+	mu          sync.Mutex
+	subscribers map[chan changedEvent]struct{}
+}
+
+func NewChangeFeed(
+	eventRepo event.EventRepository,
+	interval time.Duration,
+	batchSize int,
+) *ChangeFeed {
+	return &ChangeFeed{
+		eventRepo:   eventRepo,
+		interval:    interval,
+		batchSize:   batchSize,
+		subscribers: make(map[chan changedEvent]struct{}),
+	}
+}
+
+// Run polls the outbox every interval until ctx is done.
+func (f *ChangeFeed) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.Poll(); err != nil {
+				log.Printf("failed to poll changes: %v", err)
+			}
+		}
+	}
+}
+
+// Poll fans out the changes recorded since the last poll. The first poll
+// only finds where the outbox ends, since watchers catch up on earlier
+// changes by themselves.
+func (f *ChangeFeed) Poll() error {
+	if !f.started {
+		position, err := f.eventRepo.LatestChangePosition()
+		if err != nil {
+			return err
+		}
+		f.position = position
+		f.started = true
+		return nil
+	}
+
+	for {
+		changes, err := f.eventRepo.FindChangesAfter(f.position, f.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			// Watchers that subscribe later catch up from the outbox, so
+			// the event is only loaded when someone is listening.
+			if f.subscriberCount() == 0 {
+				f.position = change.Position()
+				continue
+			}
+
+			changed, err := findChangedEvent(f.eventRepo, change)
+			if errors.Is(err, event.ErrEventNotFound) {
+				f.position = change.Position()
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			f.broadcast(changed)
+			f.position = change.Position()
+		}
+
+		if len(changes) < f.batchSize {
+			return nil
+		}
+	}
+}
+
+func (f *ChangeFeed) subscriberCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.subscribers)
+}
+
+// broadcast hands changed to every subscriber, dropping those whose buffer
+// is full by closing their channel.
+func (f *ChangeFeed) broadcast(changed changedEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for ch := range f.subscribers {
+		select {
+		case ch <- changed:
+		default:
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (f *ChangeFeed) subscribe() (<-chan changedEvent, func()) {
+	ch := make(chan changedEvent, subscriptionBuffer)
+
+	f.mu.Lock()
+	f.subscribers[ch] = struct{}{}
+	f.mu.Unlock()
+
+	return ch, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if _, ok := f.subscribers[ch]; ok {
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+type eventWatcher struct {
+	eventRepo       event.EventRepository
+	policy          appcalendar.Policy
+	feed            *ChangeFeed
+	changeRetention time.Duration
+}
+
+func NewEventWatcher(
+	eventRepo event.EventRepository,
+	policy appcalendar.Policy,
+	feed *ChangeFeed,
+	changeRetention time.Duration,
+) EventWatcher {
+	return &eventWatcher{
+		eventRepo:       eventRepo,
+		policy:          policy,
+		feed:            feed,
+		changeRetention: changeRetention,
+	}
+}
+
+// WatchEvents sends the changes to the events the caller can see, starting
+// after resumeToken or, without one, from now, until ctx is done or send
+// fails. Resume tokens older than the change retention are rejected, since
+// the changes after them may have been purged. The calendars shared with
+// the caller are read once, so calendars shared later are only watched
+// after reconnecting.
+func (w *eventWatcher) WatchEvents(ctx context.Context, resumeToken string, send func(Notification) error) error {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return err
+	}
+
+	var position event.ChangePosition
+	if resumeToken != "" {
+		token, err := event.NewResumeTokenFromToken(resumeToken)
+		if err != nil {
+			return err
+		}
+		if token.OccurredAt().Before(time.Now().Add(-w.changeRetention)) {
+			return event.ErrResumeTokenExpired
+		}
+		position = token.Position()
+	} else {
+		position, err = w.eventRepo.LatestChangePosition()
+		if err != nil {
+			return err
+		}
+	}
+
+	calendarIDs, err := w.policy.AccessibleCalendarIDs(uid, calendar.AccessLevelRead)
+	if err != nil {
+		return err
+	}
+
+	s := &watchState{
+		uid:         uid,
+		calendarIDs: make(map[calendar.CalendarID]struct{}, len(calendarIDs)),
+		position:    position,
+		send:        send,
+	}
+	for _, id := range calendarIDs {
+		s.calendarIDs[id] = struct{}{}
+	}
+
+	for {
+		changes, cancel := w.feed.subscribe()
+		err := w.watch(ctx, s, changes)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// watch catches up on the changes after the watch's position from the
+// outbox and then follows the feed. It returns nil when the feed drops the
+// subscription, so that the caller can subscribe and catch up again.
+func (w *eventWatcher) watch(ctx context.Context, s *watchState, changes <-chan changedEvent) error {
+	for {
+		found, err := w.eventRepo.FindChangesAfter(s.position, w.feed.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range found {
+			if _, ok := s.changeType(change); !ok {
+				s.position = change.Position()
+				continue
+			}
+
+			changed, err := findChangedEvent(w.eventRepo, change)
+			if errors.Is(err, event.ErrEventNotFound) {
+				s.position = change.Position()
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := s.notify(changed); err != nil {
+				return err
+			}
+		}
+
+		if len(found) < w.feed.batchSize {
+			break
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case changed, ok := <-changes:
+			if !ok {
+				return nil
+			}
+			if !changed.change.Position().After(s.position) {
+				continue
+			}
+			if err := s.notify(changed); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchState is the state of a single WatchEvents call.
+type watchState struct {
+	uid         domainuser.UserID
+	calendarIDs map[calendar.CalendarID]struct{}
+	position    event.ChangePosition
+	send        func(Notification) error
+}
+
+func (s *watchState) notify(changed changedEvent) error {
+	if changeType, ok := s.changeType(changed.change); ok {
+		if err := s.send(Notification{
+			Type:        changeType,
+			Event:       changed.event,
+			OccurredAt:  changed.change.OccurredAt,
+			ResumeToken: event.NewResumeToken(changed.change.Position(), changed.change.OccurredAt).Token(),
+		}); err != nil {
+			return err
+		}
+	}
+	s.position = changed.change.Position()
+
+	return nil
+}
+
+// changeType reports how change looks to the watcher, judged by who could
+// see the event before and after it, and whether the watcher is told about
+// it at all.
+func (s *watchState) changeType(change event.Change) (event.ChangeType, bool) {
+	before := change.BeforeAudience != nil && change.BeforeAudience.Includes(s.uid, s.calendarIDs)
+	after := change.AfterAudience != nil && change.AfterAudience.Includes(s.uid, s.calendarIDs)
+	switch {
+	case before && after:
+		return change.Type, true
+	case before:
+		return event.ChangeTypeDeleted, true
+	case after:
+		return event.ChangeTypeCreated, true
+	default:
+		return "", false
+	}
+}
+
+// findChangedEvent loads the event a change was made to, including events
+// that have since been deleted.
+func findChangedEvent(eventRepo event.EventRepository, change event.Change) (changedEvent, error) {
+	foundEvent, err := eventRepo.FindByID(change.EventID)
+	if errors.Is(err, event.ErrEventNotFound) {
+		foundEvent, err = eventRepo.FindDeletedByID(change.EventID)
+	}
+	if err != nil {
+		return changedEvent{}, err
+	}
+
+	return changedEvent{change: change, event: foundEvent}, nil
+}
@@ -0,0 +1,288 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	mocksappcalendar "github.com/qkitzero/event-service/mocks/application/calendar"
+	mocks "github.com/qkitzero/event-service/mocks/domain/event"
+)
+
+func TestPoll(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	polledEvent := event.NewEvent(event.NewEventID(), domainuser.UserID{}, calendar.CalendarID{}, event.Title("Standup"), event.Description(""), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "uid", 1, now, now, time.Time{})
+	changes := []event.Change{
+		{ID: 8, TxID: 100, Type: event.ChangeTypeCreated, EventID: polledEvent.ID(), OccurredAt: now},
+		{ID: 9, TxID: 100, Type: event.ChangeTypeUpdated, EventID: polledEvent.ID(), OccurredAt: now},
+	}
+	start := event.ChangePosition{TxID: 99, ChangeID: 7}
+	last := event.ChangePosition{TxID: 100, ChangeID: 9}
+	tests := []struct {
+		name                    string
+		success                 bool
+		subscribed              bool
+		latestChangePositionErr error
+		findChangesErr          error
+		findByIDErr             error
+		findDeletedByIDErr      error
+		expectedBroadcasts      int
+		expectedPosition        event.ChangePosition
+	}{
+		{"success broadcast changes", true, true, nil, nil, nil, nil, 2, last},
+		{"success deleted event", true, true, nil, nil, event.ErrEventNotFound, nil, 2, last},
+		{"success purged event", true, true, nil, nil, event.ErrEventNotFound, event.ErrEventNotFound, 0, last},
+		{"success no subscribers", true, false, nil, nil, nil, nil, 0, last},
+		{"failure latest change position error", false, true, errors.New("latest change position error"), nil, nil, nil, 0, event.ChangePosition{}},
+		{"failure find changes error", false, true, nil, errors.New("find changes error"), nil, nil, 0, start},
+		{"failure find by id error", false, true, nil, nil, errors.New("find by id error"), nil, 0, start},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockEventRepository.EXPECT().LatestChangePosition().Return(start, tt.latestChangePositionErr).Times(1)
+			mockEventRepository.EXPECT().FindChangesAfter(start, 10).Return(changes, tt.findChangesErr).AnyTimes()
+			if tt.subscribed {
+				mockEventRepository.EXPECT().FindByID(polledEvent.ID()).Return(polledEvent, tt.findByIDErr).AnyTimes()
+				mockEventRepository.EXPECT().FindDeletedByID(polledEvent.ID()).Return(polledEvent, tt.findDeletedByIDErr).AnyTimes()
+			}
+
+			feed := NewChangeFeed(mockEventRepository, time.Second, 10)
+			var ch <-chan changedEvent
+			if tt.subscribed {
+				var cancel func()
+				ch, cancel = feed.subscribe()
+				defer cancel()
+			}
+
+			var err error
+			if err = feed.Poll(); err == nil {
+				err = feed.Poll()
+			}
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(ch) != tt.expectedBroadcasts {
+				t.Errorf("len(ch) = %v, want %v", len(ch), tt.expectedBroadcasts)
+			}
+			if feed.position != tt.expectedPosition {
+				t.Errorf("position = %v, want %v", feed.position, tt.expectedPosition)
+			}
+		})
+	}
+}
+
+func TestBroadcastDropsSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	feed := NewChangeFeed(nil, time.Second, 10)
+	ch, cancel := feed.subscribe()
+	defer cancel()
+
+	for i := 0; i <= subscriptionBuffer; i++ {
+		feed.broadcast(changedEvent{change: event.Change{ID: int64(i + 1)}})
+	}
+
+	received := 0
+	for range ch {
+		received++
+	}
+	if received != subscriptionBuffer {
+		t.Errorf("received = %v, want %v", received, subscriptionBuffer)
+	}
+}
+
+func TestWatchEvents(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	userID := "fe8c2263-bbac-4bb9-a41d-b04f5afc4425"
+	uid, err := domainuser.NewUserIDFromString(userID)
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	otherID, err := domainuser.NewUserIDFromString("5a7c1d9e-3b2f-4e6a-8c0d-1e2f3a4b5c6d")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	sharedCalendarID := calendar.NewCalendarID()
+	otherCalendarID := calendar.NewCalendarID()
+	newEvent := func(userID domainuser.UserID, calendarID calendar.CalendarID) event.Event {
+		return event.NewEvent(event.NewEventID(), userID, calendarID, event.Title("Standup"), event.Description(""), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "uid", 1, now, now, time.Time{})
+	}
+	ownEvent := newEvent(uid, calendar.NewCalendarID())
+	otherEvent := newEvent(otherID, otherCalendarID)
+	sharedEvent := newEvent(otherID, sharedCalendarID)
+	uninvitedEvent := newEvent(otherID, otherCalendarID)
+	movedEvent := newEvent(otherID, otherCalendarID)
+	events := map[event.EventID]event.Event{
+		ownEvent.ID():       ownEvent,
+		otherEvent.ID():     otherEvent,
+		sharedEvent.ID():    sharedEvent,
+		uninvitedEvent.ID(): uninvitedEvent,
+		movedEvent.ID():     movedEvent,
+	}
+	changes := []event.Change{
+		{ID: 3, TxID: 100, Type: event.ChangeTypeCreated, EventID: ownEvent.ID(), AfterAudience: &event.Audience{OwnerID: uid}, OccurredAt: now},
+		{ID: 4, TxID: 100, Type: event.ChangeTypeCreated, EventID: otherEvent.ID(), AfterAudience: &event.Audience{OwnerID: otherID, CalendarID: otherCalendarID}, OccurredAt: now},
+		{ID: 5, TxID: 100, Type: event.ChangeTypeUpdated, EventID: sharedEvent.ID(), BeforeAudience: &event.Audience{OwnerID: otherID, CalendarID: sharedCalendarID}, AfterAudience: &event.Audience{OwnerID: otherID, CalendarID: sharedCalendarID}, OccurredAt: now},
+		{ID: 6, TxID: 100, Type: event.ChangeTypeDeleted, EventID: event.NewEventID(), BeforeAudience: &event.Audience{OwnerID: uid}, OccurredAt: now},
+		{ID: 7, TxID: 100, Type: event.ChangeTypeUpdated, EventID: uninvitedEvent.ID(), BeforeAudience: &event.Audience{OwnerID: otherID, CalendarID: otherCalendarID, AttendeeIDs: []domainuser.UserID{uid}}, AfterAudience: &event.Audience{OwnerID: otherID, CalendarID: otherCalendarID}, OccurredAt: now},
+		{ID: 8, TxID: 101, Type: event.ChangeTypeUpdated, EventID: movedEvent.ID(), BeforeAudience: &event.Audience{OwnerID: otherID, CalendarID: sharedCalendarID}, AfterAudience: &event.Audience{OwnerID: otherID, CalendarID: otherCalendarID}, OccurredAt: now},
+	}
+	start := event.ChangePosition{TxID: 99, ChangeID: 2}
+	tests := []struct {
+		name                    string
+		success                 bool
+		ctx                     context.Context
+		resumeToken             string
+		latestChangePositionErr error
+		accessibleErr           error
+		findChangesErr          error
+		sendErr                 error
+		expectedTypes           []event.ChangeType
+	}{
+		{"success resume after token", true, auth.NewContext(context.Background(), auth.Principal{UserID: userID}), event.NewResumeToken(start, now).Token(), nil, nil, nil, nil, []event.ChangeType{event.ChangeTypeCreated, event.ChangeTypeUpdated, event.ChangeTypeDeleted, event.ChangeTypeDeleted}},
+		{"success watch from now", true, auth.NewContext(context.Background(), auth.Principal{UserID: userID}), "", nil, nil, nil, nil, []event.ChangeType{event.ChangeTypeCreated, event.ChangeTypeUpdated, event.ChangeTypeDeleted, event.ChangeTypeDeleted}},
+		{"failure unauthenticated", false, context.Background(), "", nil, nil, nil, nil, nil},
+		{"failure invalid resume token", false, auth.NewContext(context.Background(), auth.Principal{UserID: userID}), "!!!", nil, nil, nil, nil, nil},
+		{"failure expired resume token", false, auth.NewContext(context.Background(), auth.Principal{UserID: userID}), event.NewResumeToken(start, now.Add(-25*time.Hour)).Token(), nil, nil, nil, nil, nil},
+		{"failure latest change position error", false, auth.NewContext(context.Background(), auth.Principal{UserID: userID}), "", errors.New("latest change position error"), nil, nil, nil, nil},
+		{"failure accessible calendar ids error", false, auth.NewContext(context.Background(), auth.Principal{UserID: userID}), "", nil, errors.New("accessible calendar ids error"), nil, nil, nil},
+		{"failure find changes error", false, auth.NewContext(context.Background(), auth.Principal{UserID: userID}), "", nil, nil, errors.New("find changes error"), nil, nil},
+		{"failure send error", false, auth.NewContext(context.Background(), auth.Principal{UserID: userID}), "", nil, nil, nil, errors.New("send error"), []event.ChangeType{event.ChangeTypeCreated}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventRepository := mocks.NewMockEventRepository(ctrl)
+			mockEventRepository.EXPECT().LatestChangePosition().Return(start, tt.latestChangePositionErr).AnyTimes()
+			mockEventRepository.EXPECT().FindChangesAfter(start, 10).Return(changes, tt.findChangesErr).AnyTimes()
+			mockEventRepository.EXPECT().FindByID(gomock.Any()).DoAndReturn(func(id event.EventID) (event.Event, error) {
+				if e, ok := events[id]; ok {
+					return e, nil
+				}
+				return nil, event.ErrEventNotFound
+			}).AnyTimes()
+			mockEventRepository.EXPECT().FindDeletedByID(gomock.Any()).Return(nil, event.ErrEventNotFound).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().AccessibleCalendarIDs(uid, calendar.AccessLevelRead).Return([]calendar.CalendarID{sharedCalendarID}, tt.accessibleErr).AnyTimes()
+
+			watcher := NewEventWatcher(mockEventRepository, mockPolicy, NewChangeFeed(mockEventRepository, time.Second, 10), 24*time.Hour)
+
+			// The context is canceled up front, so a successful watch ends
+			// once it has caught up on the outbox.
+			ctx, cancel := context.WithCancel(tt.ctx)
+			cancel()
+
+			var notifications []Notification
+			err := watcher.WatchEvents(ctx, tt.resumeToken, func(n Notification) error {
+				notifications = append(notifications, n)
+				return tt.sendErr
+			})
+			if tt.success && !errors.Is(err, context.Canceled) {
+				t.Errorf("expected context canceled, but got %v", err)
+			}
+			if !tt.success && (err == nil || errors.Is(err, context.Canceled)) {
+				t.Errorf("expected error, but got %v", err)
+			}
+			if len(notifications) != len(tt.expectedTypes) {
+				t.Fatalf("len(notifications) = %v, want %v", len(notifications), len(tt.expectedTypes))
+			}
+			for i, n := range notifications {
+				if n.Type != tt.expectedTypes[i] {
+					t.Errorf("notifications[%d].Type = %v, want %v", i, n.Type, tt.expectedTypes[i])
+				}
+			}
+			last := event.NewResumeToken(event.ChangePosition{TxID: 101, ChangeID: 8}, now).Token()
+			if tt.success && notifications[len(notifications)-1].ResumeToken != last {
+				t.Errorf("ResumeToken = %v, want %v", notifications[len(notifications)-1].ResumeToken, last)
+			}
+		})
+	}
+}
+
+func TestWatchEventsFollowsFeed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	userID := "fe8c2263-bbac-4bb9-a41d-b04f5afc4425"
+	uid, err := domainuser.NewUserIDFromString(userID)
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	ownEvent := event.NewEvent(event.NewEventID(), uid, calendar.NewCalendarID(), event.Title("Standup"), event.Description(""), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "uid", 1, now, now, time.Time{})
+	position := event.ChangePosition{TxID: 100, ChangeID: 7}
+	stale := event.Change{ID: 6, TxID: 100, Type: event.ChangeTypeCreated, EventID: ownEvent.ID(), AfterAudience: &event.Audience{OwnerID: uid}, OccurredAt: now}
+	change := event.Change{ID: 5, TxID: 101, Type: event.ChangeTypeCreated, EventID: ownEvent.ID(), AfterAudience: &event.Audience{OwnerID: uid}, OccurredAt: now}
+
+	mockEventRepository := mocks.NewMockEventRepository(ctrl)
+	mockEventRepository.EXPECT().FindChangesAfter(position, 10).Return(nil, nil).AnyTimes()
+	mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+	mockPolicy.EXPECT().AccessibleCalendarIDs(uid, calendar.AccessLevelRead).Return(nil, nil).AnyTimes()
+
+	feed := NewChangeFeed(mockEventRepository, time.Second, 10)
+	watcher := NewEventWatcher(mockEventRepository, mockPolicy, feed, 24*time.Hour)
+
+	ctx, cancel := context.WithCancel(auth.NewContext(context.Background(), auth.Principal{UserID: userID}))
+	defer cancel()
+
+	notifications := make(chan Notification, 2)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- watcher.WatchEvents(ctx, event.NewResumeToken(position, now).Token(), func(n Notification) error {
+			notifications <- n
+			return nil
+		})
+	}()
+
+	for subscribed := false; !subscribed; time.Sleep(time.Millisecond) {
+		feed.mu.Lock()
+		subscribed = len(feed.subscribers) > 0
+		feed.mu.Unlock()
+	}
+	// The change with the lower ID was made by a later transaction, so it
+	// comes after the resume token, while the other one does not.
+	feed.broadcast(changedEvent{change: stale, event: ownEvent})
+	feed.broadcast(changedEvent{change: change, event: ownEvent})
+
+	n := <-notifications
+	if n.Type != event.ChangeTypeCreated {
+		t.Errorf("Type = %v, want %v", n.Type, event.ChangeTypeCreated)
+	}
+	if n.ResumeToken != event.NewResumeToken(change.Position(), now).Token() {
+		t.Errorf("ResumeToken = %v, want %v", n.ResumeToken, event.NewResumeToken(change.Position(), now).Token())
+	}
+
+	cancel()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled, but got %v", err)
+	}
+	if len(notifications) != 0 {
+		t.Errorf("len(notifications) = %v, want 0", len(notifications))
+	}
+}
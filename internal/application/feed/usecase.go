@@ -0,0 +1,118 @@
+package feed
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/feed"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type FeedUsecase interface {
+	RotateFeedToken(ctx context.Context) (feed.Token, error)
+	GetFeed(ctx context.Context, token string) (Content, error)
+}
+
+// Content is a rendered feed together with the validators polling clients
+// use for conditional requests.
+type Content struct {
+	Data         []byte
+	ETag         string
+	LastModified time.Time
+}
+
+type feedUsecase struct {
+	feedRepo  feed.FeedRepository
+	eventRepo event.EventRepository
+}
+
+func NewFeedUsecase(
+	feedRepo feed.FeedRepository,
+	eventRepo event.EventRepository,
+) FeedUsecase {
+	return &feedUsecase{
+		feedRepo:  feedRepo,
+		eventRepo: eventRepo,
+	}
+}
+
+func (s *feedUsecase) RotateFeedToken(ctx context.Context) (feed.Token, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return feed.Token(""), err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return feed.Token(""), err
+	}
+
+	token, err := feed.NewToken()
+	if err != nil {
+		return feed.Token(""), err
+	}
+
+	if err := s.feedRepo.Save(feed.NewFeed(uid, token.Hash(), time.Now())); err != nil {
+		return feed.Token(""), err
+	}
+
+	return token, nil
+}
+
+// GetFeed renders the events of the user owning token. It does not require an
+// authenticated caller: the token itself grants access.
+func (s *feedUsecase) GetFeed(ctx context.Context, token string) (Content, error) {
+	newToken, err := feed.NewTokenFromString(token)
+	if err != nil {
+		// Malformed and unknown tokens are indistinguishable to the caller.
+		return Content{}, feed.ErrFeedNotFound
+	}
+
+	foundFeed, err := s.feedRepo.FindByTokenHash(newToken.Hash())
+	if err != nil {
+		return Content{}, err
+	}
+
+	events, err := s.eventRepo.FindAllByUserID(foundFeed.UserID())
+	if err != nil {
+		return Content{}, err
+	}
+
+	// Deleted events leave no trace among the events, so the feed was last
+	// modified when the latest of them was deleted if that came later.
+	deletedAt, err := s.eventRepo.LatestDeletionByUserID(foundFeed.UserID())
+	if err != nil {
+		return Content{}, err
+	}
+
+	etag, lastModified := validators(events, deletedAt)
+
+	return Content{
+		Data:         event.MarshalICalendar(events),
+		ETag:         etag,
+		LastModified: lastModified,
+	}, nil
+}
+
+// validators derives an ETag and Last-Modified time from the events' IDs and
+// UpdatedAt, so that any created, updated or removed event changes the ETag.
+// deletedAt, the time the latest event was removed, moves Last-Modified on
+// when an event is deleted.
+func validators(events []event.Event, deletedAt time.Time) (string, time.Time) {
+	h := sha256.New()
+	lastModified := deletedAt
+	for _, e := range events {
+		h.Write([]byte(e.ID().String()))
+		h.Write([]byte(strconv.FormatInt(e.UpdatedAt().UnixNano(), 10)))
+		if e.UpdatedAt().After(lastModified) {
+			lastModified = e.UpdatedAt()
+		}
+	}
+
+	return strconv.Quote(hex.EncodeToString(h.Sum(nil))[:32]), lastModified.UTC().Truncate(time.Second)
+}
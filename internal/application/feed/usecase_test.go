@@ -0,0 +1,168 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/feed"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	mocksevent "github.com/qkitzero/event-service/mocks/domain/event"
+	mocksfeed "github.com/qkitzero/event-service/mocks/domain/feed"
+)
+
+func TestRotateFeedToken(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		ctx     context.Context
+		userID  string
+		saveErr error
+	}{
+		{"success rotate feed token", true, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", nil},
+		{"failure unauthenticated", false, context.Background(), "", nil},
+		{"failure save error", false, context.Background(), "6d322c66-bf4d-427a-970c-874f3745f653", errors.New("save error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var savedFeed feed.Feed
+			mockFeedRepository := mocksfeed.NewMockFeedRepository(ctrl)
+			mockFeedRepository.EXPECT().Save(gomock.Any()).DoAndReturn(func(f feed.Feed) error {
+				savedFeed = f
+				return tt.saveErr
+			}).AnyTimes()
+			mockEventRepository := mocksevent.NewMockEventRepository(ctrl)
+
+			feedUsecase := NewFeedUsecase(mockFeedRepository, mockEventRepository)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			token, err := feedUsecase.RotateFeedToken(ctx)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && savedFeed.TokenHash() != token.Hash() {
+				t.Errorf("TokenHash() = %v, want %v", savedFeed.TokenHash(), token.Hash())
+			}
+			if tt.success && savedFeed.UserID().String() != tt.userID {
+				t.Errorf("UserID() = %v, want %v", savedFeed.UserID(), tt.userID)
+			}
+		})
+	}
+}
+
+func TestGetFeed(t *testing.T) {
+	t.Parallel()
+	token, err := feed.NewToken()
+	if err != nil {
+		t.Errorf("failed to new token: %v", err)
+	}
+	userID := domainuser.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}
+	updatedAt := time.Date(2025, 1, 2, 3, 4, 5, 600, time.UTC)
+	tests := []struct {
+		name                 string
+		success              bool
+		token                string
+		findByTokenHashErr   error
+		findAllByUserIDErr   error
+		deletedAt            time.Time
+		latestDeletionErr    error
+		expectedLastModified time.Time
+	}{
+		{"success get feed", true, token.String(), nil, nil, time.Time{}, nil, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"success event deleted since the last update", true, token.String(), nil, nil, updatedAt.Add(time.Hour), nil, time.Date(2025, 1, 2, 4, 4, 5, 0, time.UTC)},
+		{"success event deleted before the last update", true, token.String(), nil, nil, updatedAt.Add(-time.Hour), nil, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"failure invalid token", false, "invalid", nil, nil, time.Time{}, nil, time.Time{}},
+		{"failure feed not found", false, token.String(), feed.ErrFeedNotFound, nil, time.Time{}, nil, time.Time{}},
+		{"failure find all by user id error", false, token.String(), nil, errors.New("find all by user id error"), time.Time{}, nil, time.Time{}},
+		{"failure latest deletion by user id error", false, token.String(), nil, nil, time.Time{}, errors.New("latest deletion by user id error"), time.Time{}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFeed := mocksfeed.NewMockFeed(ctrl)
+			mockFeed.EXPECT().UserID().Return(userID).AnyTimes()
+			mockFeedRepository := mocksfeed.NewMockFeedRepository(ctrl)
+			mockFeedRepository.EXPECT().FindByTokenHash(token.Hash()).Return(mockFeed, tt.findByTokenHashErr).AnyTimes()
+			e := event.NewEvent(event.NewEventID(), userID, calendar.CalendarID{}, event.Title("title"), event.Description("description"), updatedAt, updatedAt.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, updatedAt, updatedAt, time.Time{})
+			mockEventRepository := mocksevent.NewMockEventRepository(ctrl)
+			mockEventRepository.EXPECT().FindAllByUserID(userID).Return([]event.Event{e}, tt.findAllByUserIDErr).AnyTimes()
+			mockEventRepository.EXPECT().LatestDeletionByUserID(userID).Return(tt.deletedAt, tt.latestDeletionErr).AnyTimes()
+
+			feedUsecase := NewFeedUsecase(mockFeedRepository, mockEventRepository)
+
+			content, err := feedUsecase.GetFeed(context.Background(), tt.token)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if !tt.success {
+				return
+			}
+			if !bytes.HasPrefix(content.Data, []byte("BEGIN:VCALENDAR")) {
+				t.Errorf("Data = %q, want a VCALENDAR object", content.Data)
+			}
+			if content.ETag == "" {
+				t.Errorf("ETag is empty")
+			}
+			if !content.LastModified.Equal(tt.expectedLastModified) {
+				t.Errorf("LastModified = %v, want %v", content.LastModified, tt.expectedLastModified)
+			}
+		})
+	}
+}
+
+func TestValidators(t *testing.T) {
+	t.Parallel()
+	userID := domainuser.UserID{UUID: uuid.New()}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newEvent := func(updatedAt time.Time) event.Event {
+		return event.NewEvent(event.NewEventID(), userID, calendar.CalendarID{}, event.Title("title"), event.Description("description"), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, now, updatedAt, time.Time{})
+	}
+	a, b := newEvent(now), newEvent(now.Add(time.Hour))
+
+	etag, lastModified := validators([]event.Event{a, b}, time.Time{})
+	if !lastModified.Equal(now.Add(time.Hour)) {
+		t.Errorf("lastModified = %v, want %v", lastModified, now.Add(time.Hour))
+	}
+	if other, _ := validators([]event.Event{a, b}, time.Time{}); other != etag {
+		t.Errorf("validators() is not deterministic: %v != %v", other, etag)
+	}
+	if removed, _ := validators([]event.Event{b}, time.Time{}); removed == etag {
+		t.Errorf("validators() ETag did not change when an event was removed")
+	}
+	if _, lastModified := validators([]event.Event{a}, now.Add(2*time.Hour)); !lastModified.Equal(now.Add(2 * time.Hour)) {
+		t.Errorf("lastModified = %v, want the deletion time %v", lastModified, now.Add(2*time.Hour))
+	}
+	if empty, lastModified := validators(nil, time.Time{}); empty == "" || !lastModified.IsZero() {
+		t.Errorf("validators(nil) = %q, %v, want non-empty ETag and zero time", empty, lastModified)
+	}
+}
@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+// Dispatcher turns the changes relayed from the outbox into deliveries for
+// the webhooks of the event's organizer. Payloads carry the event as it is
+// when the change is relayed, so a change relayed late may show later edits.
+type Dispatcher struct {
+	eventRepo    event.EventRepository
+	webhookRepo  webhook.WebhookRepository
+	deliveryRepo webhook.DeliveryRepository
+}
+
+func NewDispatcher(
+	eventRepo event.EventRepository,
+	webhookRepo webhook.WebhookRepository,
+	deliveryRepo webhook.DeliveryRepository,
+) *Dispatcher {
+	return &Dispatcher{
+		eventRepo:    eventRepo,
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// Publish records a delivery for every enabled webhook subscribed to the
+// change. Changes of events that have since been purged are dropped.
+func (d *Dispatcher) Publish(ctx context.Context, change event.Change) error {
+	eventType, ok := webhook.EventTypeFromChange(change.Type)
+	if !ok {
+		return nil
+	}
+
+	foundEvent, err := d.findEvent(change.EventID)
+	if errors.Is(err, event.ErrEventNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := d.webhookRepo.FindAllByUserID(foundEvent.UserID())
+	if err != nil {
+		return err
+	}
+
+	var deliveries []webhook.Delivery
+	for _, w := range webhooks {
+		if !w.Enabled() || !w.Subscribes(eventType) {
+			continue
+		}
+
+		id := webhook.NewDeliveryID()
+		payload, err := webhook.NewPayload(id, eventType, foundEvent, change.OccurredAt)
+		if err != nil {
+			return err
+		}
+
+		deliveries = append(deliveries, webhook.NewDelivery(id, w.ID(), change.ID, eventType, payload, webhook.DeliveryStatusPending, nil, time.Now(), time.Now()))
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return d.deliveryRepo.CreateAll(deliveries)
+}
+
+func (d *Dispatcher) findEvent(id event.EventID) (event.Event, error) {
+	foundEvent, err := d.eventRepo.FindByID(id)
+	if errors.Is(err, event.ErrEventNotFound) {
+		return d.eventRepo.FindDeletedByID(id)
+	}
+
+	return foundEvent, err
+}
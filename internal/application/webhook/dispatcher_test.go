@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+	mocksevent "github.com/qkitzero/event-service/mocks/domain/event"
+	mocks "github.com/qkitzero/event-service/mocks/domain/webhook"
+)
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	newEvent := event.NewEvent(event.NewEventID(), domainuser.UserID{}, calendar.CalendarID{}, event.Title("Standup"), event.Description(""), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "uid", 1, now, now, time.Time{})
+	newWebhook := func(eventTypes []webhook.EventType, enabled bool) webhook.Webhook {
+		return webhook.NewWebhook(webhook.NewWebhookID(), domainuser.UserID{}, webhook.URL("https://example.com/hooks"), eventTypes, webhook.Secret("secret"), enabled, 0, now, now)
+	}
+	webhooks := []webhook.Webhook{
+		newWebhook(nil, true),
+		newWebhook([]webhook.EventType{webhook.EventTypeDeleted}, true),
+		newWebhook(nil, false),
+	}
+	tests := []struct {
+		name               string
+		success            bool
+		changeType         event.ChangeType
+		findByIDErr        error
+		findDeletedByIDErr error
+		findAllByUserIDErr error
+		createAllErr       error
+		expectedDeliveries int
+	}{
+		{"success create deliveries", true, event.ChangeTypeCreated, nil, nil, nil, nil, 1},
+		{"success deleted event", true, event.ChangeTypeDeleted, event.ErrEventNotFound, nil, nil, nil, 2},
+		{"success purged event", true, event.ChangeTypeDeleted, event.ErrEventNotFound, event.ErrEventNotFound, nil, nil, 0},
+		{"success unknown change type", true, event.ChangeType("event.moved"), nil, nil, nil, nil, 0},
+		{"failure find by id error", false, event.ChangeTypeCreated, errors.New("find by id error"), nil, nil, nil, 0},
+		{"failure find all by user id error", false, event.ChangeTypeCreated, nil, nil, errors.New("find all by user id error"), nil, 0},
+		{"failure create all error", false, event.ChangeTypeCreated, nil, nil, nil, errors.New("create all error"), 1},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventRepository := mocksevent.NewMockEventRepository(ctrl)
+			mockEventRepository.EXPECT().FindByID(gomock.Any()).Return(newEvent, tt.findByIDErr).AnyTimes()
+			mockEventRepository.EXPECT().FindDeletedByID(gomock.Any()).Return(newEvent, tt.findDeletedByIDErr).AnyTimes()
+			mockWebhookRepository := mocks.NewMockWebhookRepository(ctrl)
+			mockWebhookRepository.EXPECT().FindAllByUserID(gomock.Any()).Return(webhooks, tt.findAllByUserIDErr).AnyTimes()
+			mockDeliveryRepository := mocks.NewMockDeliveryRepository(ctrl)
+			var created []webhook.Delivery
+			mockDeliveryRepository.EXPECT().CreateAll(gomock.Any()).DoAndReturn(func(deliveries []webhook.Delivery) error {
+				created = deliveries
+				return tt.createAllErr
+			}).AnyTimes()
+
+			dispatcher := NewDispatcher(mockEventRepository, mockWebhookRepository, mockDeliveryRepository)
+
+			change := event.Change{ID: 1, Type: tt.changeType, EventID: newEvent.ID(), OccurredAt: now}
+			err := dispatcher.Publish(context.Background(), change)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(created) != tt.expectedDeliveries {
+				t.Errorf("len(deliveries) = %v, want %v", len(created), tt.expectedDeliveries)
+			}
+			for _, delivery := range created {
+				if delivery.ChangeID() != change.ID {
+					t.Errorf("ChangeID() = %v, want %v", delivery.ChangeID(), change.ID)
+				}
+				if delivery.Status() != webhook.DeliveryStatusPending {
+					t.Errorf("Status() = %v, want %v", delivery.Status(), webhook.DeliveryStatusPending)
+				}
+			}
+		})
+	}
+}
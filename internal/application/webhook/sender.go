@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+// Request is a signed delivery ready to be sent to a webhook.
+type Request struct {
+	URL        webhook.URL
+	DeliveryID webhook.DeliveryID
+	EventType  webhook.EventType
+	Timestamp  time.Time
+	Signature  string
+	Body       []byte
+}
+
+// Sender sends a request and returns the status code of the response, or
+// an error if no response was received.
+type Sender interface {
+	Send(ctx context.Context, req Request) (int, error)
+}
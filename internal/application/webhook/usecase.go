@@ -0,0 +1,252 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+var updatableFields = []string{"url", "event_types", "enabled"}
+
+type WebhookUsecase interface {
+	CreateWebhook(ctx context.Context, url string, eventTypes []string) (webhook.Webhook, error)
+	GetWebhook(ctx context.Context, webhookID string) (webhook.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]webhook.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhookID, url string, eventTypes []string, enabled bool, updateMask []string) (webhook.Webhook, error)
+	DeleteWebhook(ctx context.Context, webhookID string) error
+	ListDeliveries(ctx context.Context, webhookID string, pageSize int32) ([]webhook.Delivery, error)
+}
+
+type webhookUsecase struct {
+	webhookRepo  webhook.WebhookRepository
+	deliveryRepo webhook.DeliveryRepository
+	allowHTTP    bool
+}
+
+// NewWebhookUsecase returns a usecase that only accepts https endpoints
+// unless allowHTTP, which is meant for development.
+func NewWebhookUsecase(
+	webhookRepo webhook.WebhookRepository,
+	deliveryRepo webhook.DeliveryRepository,
+	allowHTTP bool,
+) WebhookUsecase {
+	return &webhookUsecase{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		allowHTTP:    allowHTTP,
+	}
+}
+
+// CreateWebhook registers an endpoint for the caller's events. The returned
+// webhook carries the secret deliveries are signed with.
+func (s *webhookUsecase) CreateWebhook(ctx context.Context, url string, eventTypes []string) (webhook.Webhook, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	newURL, err := s.newURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	newEventTypes, err := webhook.NewEventTypes(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	newWebhook := webhook.NewWebhook(webhook.NewWebhookID(), uid, newURL, newEventTypes, secret, true, 0, time.Now(), time.Now())
+
+	if err := s.webhookRepo.Create(newWebhook); err != nil {
+		return nil, err
+	}
+
+	return newWebhook, nil
+}
+
+func (s *webhookUsecase) GetWebhook(ctx context.Context, webhookID string) (webhook.Webhook, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authorize(principal, webhookID)
+}
+
+func (s *webhookUsecase) ListWebhooks(ctx context.Context) ([]webhook.Webhook, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.webhookRepo.FindAllByUserID(uid)
+}
+
+func (s *webhookUsecase) UpdateWebhook(ctx context.Context, webhookID, url string, eventTypes []string, enabled bool, updateMask []string) (webhook.Webhook, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	foundWebhook, err := s.authorize(principal, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := parseUpdateMask(updateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	newURL := foundWebhook.URL()
+	if fields["url"] {
+		newURL, err = s.newURL(url)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newEventTypes := foundWebhook.EventTypes()
+	if fields["event_types"] {
+		newEventTypes, err = webhook.NewEventTypes(eventTypes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newEnabled := foundWebhook.Enabled()
+	if fields["enabled"] {
+		newEnabled = enabled
+	}
+
+	foundWebhook.Update(newURL, newEventTypes, newEnabled)
+
+	if err := s.webhookRepo.Update(foundWebhook); err != nil {
+		return nil, err
+	}
+
+	return foundWebhook, nil
+}
+
+// DeleteWebhook deletes a webhook together with its deliveries.
+func (s *webhookUsecase) DeleteWebhook(ctx context.Context, webhookID string) error {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	foundWebhook, err := s.authorize(principal, webhookID)
+	if err != nil {
+		return err
+	}
+
+	return s.webhookRepo.Delete(foundWebhook.ID())
+}
+
+// ListDeliveries lists the most recent deliveries of a webhook first.
+func (s *webhookUsecase) ListDeliveries(ctx context.Context, webhookID string, pageSize int32) ([]webhook.Delivery, error) {
+	principal, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	foundWebhook, err := s.authorize(principal, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 0 {
+		return nil, webhook.ErrInvalidPageSize
+	}
+	limit := int(pageSize)
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	return s.deliveryRepo.FindAllByWebhookID(foundWebhook.ID(), limit)
+}
+
+// authorize finds a webhook of the caller. Other users' webhooks are reported
+// as not found so that their IDs are not disclosed.
+func (s *webhookUsecase) authorize(principal auth.Principal, webhookID string) (webhook.Webhook, error) {
+	uid, err := domainuser.NewUserIDFromString(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := webhook.NewWebhookIDFromString(webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	foundWebhook, err := s.webhookRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if foundWebhook.UserID() != uid {
+		return nil, webhook.ErrWebhookNotFound
+	}
+
+	return foundWebhook, nil
+}
+
+// parseUpdateMask returns the set of fields to update. An empty mask or "*"
+// selects every updatable field.
+func parseUpdateMask(paths []string) (map[string]bool, error) {
+	fields := make(map[string]bool, len(updatableFields))
+	if len(paths) == 0 || (len(paths) == 1 && paths[0] == "*") {
+		for _, f := range updatableFields {
+			fields[f] = true
+		}
+		return fields, nil
+	}
+
+	for _, path := range paths {
+		if !slices.Contains(updatableFields, path) {
+			return nil, fmt.Errorf("%w: unknown field %q", webhook.ErrInvalidUpdateMask, path)
+		}
+		fields[path] = true
+	}
+
+	return fields, nil
+}
+
+func (s *webhookUsecase) newURL(url string) (webhook.URL, error) {
+	newURL, err := webhook.NewURL(url)
+	if err != nil {
+		return "", err
+	}
+	if !s.allowHTTP && !newURL.Secure() {
+		return "", fmt.Errorf("%w: https is required", webhook.ErrInvalidURL)
+	}
+
+	return newURL, nil
+}
@@ -0,0 +1,354 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+	mocks "github.com/qkitzero/event-service/mocks/domain/webhook"
+)
+
+const (
+	ownerID   = "6d322c66-bf4d-427a-970c-874f3745f653"
+	otherID   = "0f2a0a1e-6c8e-4f52-9d4c-3b1f2a4e5d6c"
+	webhookID = "fe8c2263-bbac-4bb9-a41d-b04f5afc4425"
+)
+
+func newMockWebhook(t *testing.T, ctrl *gomock.Controller) *mocks.MockWebhook {
+	t.Helper()
+	uid, err := domainuser.NewUserIDFromString(ownerID)
+	if err != nil {
+		t.Fatalf("failed to new user id: %v", err)
+	}
+	id, err := webhook.NewWebhookIDFromString(webhookID)
+	if err != nil {
+		t.Fatalf("failed to new webhook id: %v", err)
+	}
+
+	mockWebhook := mocks.NewMockWebhook(ctrl)
+	mockWebhook.EXPECT().ID().Return(id).AnyTimes()
+	mockWebhook.EXPECT().UserID().Return(uid).AnyTimes()
+	mockWebhook.EXPECT().URL().Return(webhook.URL("https://example.com/hooks")).AnyTimes()
+	mockWebhook.EXPECT().EventTypes().Return(nil).AnyTimes()
+	mockWebhook.EXPECT().Enabled().Return(true).AnyTimes()
+	return mockWebhook
+}
+
+func TestCreateWebhook(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		success    bool
+		ctx        context.Context
+		userID     string
+		url        string
+		eventTypes []string
+		createErr  error
+	}{
+		{"success create webhook", true, context.Background(), ownerID, "https://example.com/hooks", []string{"event.created"}, nil},
+		{"success create webhook for every event type", true, context.Background(), ownerID, "https://example.com/hooks", nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", "https://example.com/hooks", nil, nil},
+		{"failure invalid user id", false, context.Background(), "invalid", "https://example.com/hooks", nil, nil},
+		{"failure invalid url", false, context.Background(), ownerID, "example.com/hooks", nil, nil},
+		{"failure http url", false, context.Background(), ownerID, "http://example.com/hooks", nil, nil},
+		{"failure invalid event type", false, context.Background(), ownerID, "https://example.com/hooks", []string{"event.moved"}, nil},
+		{"failure create error", false, context.Background(), ownerID, "https://example.com/hooks", nil, errors.New("create error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookRepository := mocks.NewMockWebhookRepository(ctrl)
+			mockWebhookRepository.EXPECT().Create(gomock.Any()).Return(tt.createErr).AnyTimes()
+			mockDeliveryRepository := mocks.NewMockDeliveryRepository(ctrl)
+
+			webhookUsecase := NewWebhookUsecase(mockWebhookRepository, mockDeliveryRepository, false)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			createdWebhook, err := webhookUsecase.CreateWebhook(ctx, tt.url, tt.eventTypes)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && !createdWebhook.Enabled() {
+				t.Errorf("Enabled() = %v, want %v", createdWebhook.Enabled(), true)
+			}
+			if tt.success && createdWebhook.Secret() == "" {
+				t.Errorf("expected a secret, but got none")
+			}
+		})
+	}
+}
+
+func TestGetWebhook(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		success     bool
+		ctx         context.Context
+		userID      string
+		webhookID   string
+		findByIDErr error
+	}{
+		{"success get webhook", true, context.Background(), ownerID, webhookID, nil},
+		{"failure unauthenticated", false, context.Background(), "", webhookID, nil},
+		{"failure invalid user id", false, context.Background(), "invalid", webhookID, nil},
+		{"failure invalid webhook id", false, context.Background(), ownerID, "invalid", nil},
+		{"failure webhook not found", false, context.Background(), ownerID, webhookID, webhook.ErrWebhookNotFound},
+		{"failure other user's webhook", false, context.Background(), otherID, webhookID, nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhook := newMockWebhook(t, ctrl)
+			mockWebhookRepository := mocks.NewMockWebhookRepository(ctrl)
+			mockWebhookRepository.EXPECT().FindByID(gomock.Any()).Return(mockWebhook, tt.findByIDErr).AnyTimes()
+			mockDeliveryRepository := mocks.NewMockDeliveryRepository(ctrl)
+
+			webhookUsecase := NewWebhookUsecase(mockWebhookRepository, mockDeliveryRepository, false)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := webhookUsecase.GetWebhook(ctx, tt.webhookID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name               string
+		success            bool
+		ctx                context.Context
+		userID             string
+		findAllByUserIDErr error
+	}{
+		{"success list webhooks", true, context.Background(), ownerID, nil},
+		{"failure unauthenticated", false, context.Background(), "", nil},
+		{"failure invalid user id", false, context.Background(), "invalid", nil},
+		{"failure find all by user id error", false, context.Background(), ownerID, errors.New("find all by user id error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhook := newMockWebhook(t, ctrl)
+			mockWebhookRepository := mocks.NewMockWebhookRepository(ctrl)
+			mockWebhookRepository.EXPECT().FindAllByUserID(gomock.Any()).Return([]webhook.Webhook{mockWebhook}, tt.findAllByUserIDErr).AnyTimes()
+			mockDeliveryRepository := mocks.NewMockDeliveryRepository(ctrl)
+
+			webhookUsecase := NewWebhookUsecase(mockWebhookRepository, mockDeliveryRepository, false)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := webhookUsecase.ListWebhooks(ctx)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		success     bool
+		ctx         context.Context
+		userID      string
+		webhookID   string
+		url         string
+		eventTypes  []string
+		enabled     bool
+		updateMask  []string
+		findByIDErr error
+		updateErr   error
+	}{
+		{"success update webhook", true, context.Background(), ownerID, webhookID, "https://example.com/other", []string{"event.deleted"}, true, nil, nil, nil},
+		{"success disable webhook", true, context.Background(), ownerID, webhookID, "", nil, false, []string{"enabled"}, nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", webhookID, "https://example.com/other", nil, true, nil, nil, nil},
+		{"failure invalid webhook id", false, context.Background(), ownerID, "invalid", "https://example.com/other", nil, true, nil, nil, nil},
+		{"failure webhook not found", false, context.Background(), ownerID, webhookID, "https://example.com/other", nil, true, nil, webhook.ErrWebhookNotFound, nil},
+		{"failure other user's webhook", false, context.Background(), otherID, webhookID, "https://example.com/other", nil, true, nil, nil, nil},
+		{"failure unknown update mask path", false, context.Background(), ownerID, webhookID, "", nil, true, []string{"secret"}, nil, nil},
+		{"failure invalid url", false, context.Background(), ownerID, webhookID, "ftp://example.com", nil, true, []string{"url"}, nil, nil},
+		{"failure http url", false, context.Background(), ownerID, webhookID, "http://example.com", nil, true, []string{"url"}, nil, nil},
+		{"failure invalid event type", false, context.Background(), ownerID, webhookID, "", []string{"event.moved"}, true, []string{"event_types"}, nil, nil},
+		{"failure update error", false, context.Background(), ownerID, webhookID, "https://example.com/other", nil, true, nil, nil, errors.New("update error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhook := newMockWebhook(t, ctrl)
+			mockWebhook.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			mockWebhookRepository := mocks.NewMockWebhookRepository(ctrl)
+			mockWebhookRepository.EXPECT().FindByID(gomock.Any()).Return(mockWebhook, tt.findByIDErr).AnyTimes()
+			mockWebhookRepository.EXPECT().Update(gomock.Any()).Return(tt.updateErr).AnyTimes()
+			mockDeliveryRepository := mocks.NewMockDeliveryRepository(ctrl)
+
+			webhookUsecase := NewWebhookUsecase(mockWebhookRepository, mockDeliveryRepository, false)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := webhookUsecase.UpdateWebhook(ctx, tt.webhookID, tt.url, tt.eventTypes, tt.enabled, tt.updateMask)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		success     bool
+		ctx         context.Context
+		userID      string
+		webhookID   string
+		findByIDErr error
+		deleteErr   error
+	}{
+		{"success delete webhook", true, context.Background(), ownerID, webhookID, nil, nil},
+		{"failure unauthenticated", false, context.Background(), "", webhookID, nil, nil},
+		{"failure invalid webhook id", false, context.Background(), ownerID, "invalid", nil, nil},
+		{"failure webhook not found", false, context.Background(), ownerID, webhookID, webhook.ErrWebhookNotFound, nil},
+		{"failure other user's webhook", false, context.Background(), otherID, webhookID, nil, nil},
+		{"failure delete error", false, context.Background(), ownerID, webhookID, nil, errors.New("delete error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhook := newMockWebhook(t, ctrl)
+			mockWebhookRepository := mocks.NewMockWebhookRepository(ctrl)
+			mockWebhookRepository.EXPECT().FindByID(gomock.Any()).Return(mockWebhook, tt.findByIDErr).AnyTimes()
+			mockWebhookRepository.EXPECT().Delete(gomock.Any()).Return(tt.deleteErr).AnyTimes()
+			mockDeliveryRepository := mocks.NewMockDeliveryRepository(ctrl)
+
+			webhookUsecase := NewWebhookUsecase(mockWebhookRepository, mockDeliveryRepository, false)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			err := webhookUsecase.DeleteWebhook(ctx, tt.webhookID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestListDeliveries(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		success       bool
+		ctx           context.Context
+		userID        string
+		webhookID     string
+		pageSize      int32
+		findByIDErr   error
+		findAllErr    error
+		expectedLimit int
+	}{
+		{"success list deliveries", true, context.Background(), ownerID, webhookID, 10, nil, nil, 10},
+		{"success default page size", true, context.Background(), ownerID, webhookID, 0, nil, nil, defaultPageSize},
+		{"success page size capped", true, context.Background(), ownerID, webhookID, maxPageSize + 1, nil, nil, maxPageSize},
+		{"failure unauthenticated", false, context.Background(), "", webhookID, 0, nil, nil, 0},
+		{"failure other user's webhook", false, context.Background(), otherID, webhookID, 0, nil, nil, 0},
+		{"failure webhook not found", false, context.Background(), ownerID, webhookID, 0, webhook.ErrWebhookNotFound, nil, 0},
+		{"failure negative page size", false, context.Background(), ownerID, webhookID, -1, nil, nil, 0},
+		{"failure find all by webhook id error", false, context.Background(), ownerID, webhookID, 0, nil, errors.New("find all by webhook id error"), defaultPageSize},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhook := newMockWebhook(t, ctrl)
+			mockDelivery := mocks.NewMockDelivery(ctrl)
+			mockWebhookRepository := mocks.NewMockWebhookRepository(ctrl)
+			mockWebhookRepository.EXPECT().FindByID(gomock.Any()).Return(mockWebhook, tt.findByIDErr).AnyTimes()
+			mockDeliveryRepository := mocks.NewMockDeliveryRepository(ctrl)
+			mockDeliveryRepository.EXPECT().FindAllByWebhookID(gomock.Any(), tt.expectedLimit).Return([]webhook.Delivery{mockDelivery}, tt.findAllErr).AnyTimes()
+
+			webhookUsecase := NewWebhookUsecase(mockWebhookRepository, mockDeliveryRepository, false)
+
+			ctx := tt.ctx
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, auth.Principal{UserID: tt.userID})
+			}
+
+			_, err := webhookUsecase.ListDeliveries(ctx, tt.webhookID, tt.pageSize)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+// deliveryLease is how long a claimed delivery is held before another worker
+// may claim it. It must be longer than the sender's timeout.
+const deliveryLease = 5 * time.Minute
+
+// DeliveryWorker sends due deliveries, records each attempt and keeps count
+// of each webhook's consecutive failures.
+type DeliveryWorker struct {
+	webhookRepo  webhook.WebhookRepository
+	deliveryRepo webhook.DeliveryRepository
+	sender       Sender
+	interval     time.Duration
+	batchSize    int
+}
+
+func NewDeliveryWorker(
+	webhookRepo webhook.WebhookRepository,
+	deliveryRepo webhook.DeliveryRepository,
+	sender Sender,
+	interval time.Duration,
+	batchSize int,
+) *DeliveryWorker {
+	return &DeliveryWorker{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		sender:       sender,
+		interval:     interval,
+		batchSize:    batchSize,
+	}
+}
+
+// Run sends due deliveries every interval until ctx is done.
+func (w *DeliveryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := w.Deliver(ctx, now); err != nil {
+				log.Printf("failed to deliver webhooks: %v", err)
+			}
+		}
+	}
+}
+
+// Deliver claims batches of deliveries due by now until none are left and
+// returns how many succeeded.
+func (w *DeliveryWorker) Deliver(ctx context.Context, now time.Time) (int, error) {
+	succeeded := 0
+	webhooks := make(map[webhook.WebhookID]webhook.Webhook)
+	for {
+		deliveries, err := w.deliveryRepo.ClaimDue(now, deliveryLease, w.batchSize)
+		if err != nil {
+			return succeeded, err
+		}
+
+		for _, delivery := range deliveries {
+			foundWebhook, ok := webhooks[delivery.WebhookID()]
+			if !ok {
+				foundWebhook, err = w.webhookRepo.FindByID(delivery.WebhookID())
+				if errors.Is(err, webhook.ErrWebhookNotFound) {
+					continue
+				}
+				if err != nil {
+					return succeeded, err
+				}
+				webhooks[delivery.WebhookID()] = foundWebhook
+			}
+
+			// The webhook may have been disabled since the batch was
+			// claimed, which cancelled its deliveries.
+			if !foundWebhook.Enabled() {
+				continue
+			}
+
+			ok, err = w.send(ctx, foundWebhook, delivery, now)
+			if err != nil {
+				return succeeded, err
+			}
+			if ok {
+				succeeded++
+			}
+		}
+
+		if len(deliveries) < w.batchSize {
+			return succeeded, nil
+		}
+	}
+}
+
+func (w *DeliveryWorker) send(ctx context.Context, foundWebhook webhook.Webhook, delivery webhook.Delivery, now time.Time) (bool, error) {
+	statusCode, err := w.sender.Send(ctx, Request{
+		URL:        foundWebhook.URL(),
+		DeliveryID: delivery.ID(),
+		EventType:  delivery.EventType(),
+		Timestamp:  now,
+		Signature:  foundWebhook.Secret().Sign(now, delivery.Payload()),
+		Body:       delivery.Payload(),
+	})
+	errMessage := ""
+	if err != nil {
+		errMessage = err.Error()
+	}
+
+	attempt := webhook.NewAttempt(statusCode, errMessage, now)
+	delivery.RecordAttempt(attempt)
+	if err := w.deliveryRepo.Update(delivery); err != nil {
+		return false, err
+	}
+
+	failures := foundWebhook.ConsecutiveFailures()
+	if attempt.Succeeded() {
+		foundWebhook.RecordSuccess()
+	} else {
+		foundWebhook.RecordFailure()
+		log.Printf("failed to deliver %s to webhook %s: status %d %s", delivery.ID(), foundWebhook.ID(), statusCode, errMessage)
+	}
+	if foundWebhook.ConsecutiveFailures() != failures {
+		if err := w.webhookRepo.Update(foundWebhook); err != nil {
+			return false, err
+		}
+	}
+
+	return attempt.Succeeded(), nil
+}
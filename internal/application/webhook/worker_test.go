@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+	mocks "github.com/qkitzero/event-service/mocks/domain/webhook"
+)
+
+type senderFunc func(ctx context.Context, req Request) (int, error)
+
+func (f senderFunc) Send(ctx context.Context, req Request) (int, error) {
+	return f(ctx, req)
+}
+
+func TestDeliver(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	tests := []struct {
+		name                string
+		success             bool
+		deliveries          int
+		consecutiveFailures int
+		statusCode          int
+		sendErr             error
+		claimDueErr         error
+		findByIDErr         error
+		updateErr           error
+		expectedSucceeded   int
+		expectedSent        int
+		expectedEnabled     bool
+	}{
+		{"success deliver", true, 2, 0, 200, nil, nil, nil, nil, 2, 2, true},
+		{"success several batches", true, 3, 0, 204, nil, nil, nil, nil, 3, 3, true},
+		{"success no deliveries", true, 0, 0, 200, nil, nil, nil, nil, 0, 0, true},
+		{"success record failure", true, 1, 0, 500, nil, nil, nil, nil, 0, 1, true},
+		{"success record send error", true, 1, 0, 0, errors.New("connection refused"), nil, nil, nil, 0, 1, true},
+		{"success disable webhook after repeated failures", true, 2, webhook.MaxConsecutiveFailures - 1, 500, nil, nil, nil, nil, 0, 1, false},
+		{"success webhook deleted", true, 1, 0, 200, nil, nil, webhook.ErrWebhookNotFound, nil, 0, 0, true},
+		{"failure claim due error", false, 0, 0, 200, nil, errors.New("claim due error"), nil, nil, 0, 0, true},
+		{"failure find by id error", false, 1, 0, 200, nil, nil, errors.New("find by id error"), nil, 0, 0, true},
+		{"failure update error", false, 1, 0, 200, nil, nil, nil, errors.New("update error"), 0, 1, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			foundWebhook := webhook.NewWebhook(webhook.NewWebhookID(), domainuser.UserID{}, webhook.URL("https://example.com/hooks"), nil, webhook.Secret("secret"), true, tt.consecutiveFailures, now, now)
+			var deliveries []webhook.Delivery
+			for range tt.deliveries {
+				deliveries = append(deliveries, webhook.NewDelivery(webhook.NewDeliveryID(), foundWebhook.ID(), 1, webhook.EventTypeCreated, []byte(`{}`), webhook.DeliveryStatusPending, nil, now, now))
+			}
+
+			mockWebhookRepository := mocks.NewMockWebhookRepository(ctrl)
+			mockWebhookRepository.EXPECT().FindByID(foundWebhook.ID()).Return(foundWebhook, tt.findByIDErr).AnyTimes()
+			mockWebhookRepository.EXPECT().Update(foundWebhook).Return(nil).AnyTimes()
+			mockDeliveryRepository := mocks.NewMockDeliveryRepository(ctrl)
+			mockDeliveryRepository.EXPECT().ClaimDue(now, deliveryLease, 2).DoAndReturn(func(now time.Time, lease time.Duration, limit int) ([]webhook.Delivery, error) {
+				n := min(limit, len(deliveries))
+				batch := deliveries[:n]
+				deliveries = deliveries[n:]
+				return batch, tt.claimDueErr
+			}).AnyTimes()
+			mockDeliveryRepository.EXPECT().Update(gomock.Any()).Return(tt.updateErr).AnyTimes()
+
+			sent := 0
+			sender := senderFunc(func(ctx context.Context, req Request) (int, error) {
+				sent++
+				if req.Signature != foundWebhook.Secret().Sign(req.Timestamp, req.Body) {
+					t.Errorf("Signature = %v, want %v", req.Signature, foundWebhook.Secret().Sign(req.Timestamp, req.Body))
+				}
+				return tt.statusCode, tt.sendErr
+			})
+
+			worker := NewDeliveryWorker(mockWebhookRepository, mockDeliveryRepository, sender, time.Second, 2)
+
+			succeeded, err := worker.Deliver(context.Background(), now)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if succeeded != tt.expectedSucceeded {
+				t.Errorf("Deliver() = %v, want %v", succeeded, tt.expectedSucceeded)
+			}
+			if sent != tt.expectedSent {
+				t.Errorf("sent = %v, want %v", sent, tt.expectedSent)
+			}
+			if foundWebhook.Enabled() != tt.expectedEnabled {
+				t.Errorf("Enabled() = %v, want %v", foundWebhook.Enabled(), tt.expectedEnabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package calendar
+
+type AccessLevel string
+
+const (
+	AccessLevelFreeBusy AccessLevel = "free_busy"
+	AccessLevelRead     AccessLevel = "read"
+	AccessLevelWrite    AccessLevel = "write"
+	AccessLevelManage   AccessLevel = "manage"
+	// AccessLevelOwner is held by the calendar's owner and cannot be granted.
+	AccessLevelOwner AccessLevel = "owner"
+)
+
+// accessLevelRanks orders the levels; each level includes those below it.
+var accessLevelRanks = map[AccessLevel]int{
+	AccessLevelFreeBusy: 1,
+	AccessLevelRead:     2,
+	AccessLevelWrite:    3,
+	AccessLevelManage:   4,
+	AccessLevelOwner:    5,
+}
+
+func (l AccessLevel) String() string {
+	return string(l)
+}
+
+// Allows reports whether l grants at least the required level.
+func (l AccessLevel) Allows(required AccessLevel) bool {
+	return accessLevelRanks[l] >= accessLevelRanks[required] && accessLevelRanks[l] > 0
+}
+
+// NewAccessLevel parses a level that can be granted to another user.
+func NewAccessLevel(s string) (AccessLevel, error) {
+	l := AccessLevel(s)
+	if _, ok := accessLevelRanks[l]; !ok || l == AccessLevelOwner {
+		return AccessLevel(""), ErrInvalidAccessLevel
+	}
+
+	return l, nil
+}
@@ -0,0 +1,67 @@
+package calendar
+
+import "testing"
+
+func TestNewAccessLevel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		success     bool
+		accessLevel string
+	}{
+		{"success free busy", true, "free_busy"},
+		{"success read", true, "read"},
+		{"success write", true, "write"},
+		{"success manage", true, "manage"},
+		{"failure owner", false, "owner"},
+		{"failure empty", false, ""},
+		{"failure unknown", false, "admin"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			accessLevel, err := NewAccessLevel(tt.accessLevel)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if tt.success && accessLevel.String() != tt.accessLevel {
+				t.Errorf("String() = %v, want %v", accessLevel.String(), tt.accessLevel)
+			}
+		})
+	}
+}
+
+func TestAccessLevelAllows(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		accessLevel AccessLevel
+		required    AccessLevel
+		expected    bool
+	}{
+		{"free busy allows free busy", AccessLevelFreeBusy, AccessLevelFreeBusy, true},
+		{"free busy denies read", AccessLevelFreeBusy, AccessLevelRead, false},
+		{"read allows free busy", AccessLevelRead, AccessLevelFreeBusy, true},
+		{"write allows read", AccessLevelWrite, AccessLevelRead, true},
+		{"write denies manage", AccessLevelWrite, AccessLevelManage, false},
+		{"manage denies owner", AccessLevelManage, AccessLevelOwner, false},
+		{"owner allows manage", AccessLevelOwner, AccessLevelManage, true},
+		{"empty denies free busy", AccessLevel(""), AccessLevelFreeBusy, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.accessLevel.Allows(tt.required); got != tt.expected {
+				t.Errorf("Allows(%v) = %v, want %v", tt.required, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type Calendar interface {
+	ID() CalendarID
+	UserID() user.UserID
+	Name() Name
+	Color() Color
+	TimeZone() TimeZone
+	IsDefault() bool
+	CreatedAt() time.Time
+	UpdatedAt() time.Time
+	Update(name Name, color Color, timeZone TimeZone)
+}
+
+type calendar struct {
+	id        CalendarID
+	userID    user.UserID
+	name      Name
+	color     Color
+	timeZone  TimeZone
+	isDefault bool
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func (c calendar) ID() CalendarID {
+	return c.id
+}
+
+func (c calendar) UserID() user.UserID {
+	return c.userID
+}
+
+func (c calendar) Name() Name {
+	return c.name
+}
+
+func (c calendar) Color() Color {
+	return c.color
+}
+
+func (c calendar) TimeZone() TimeZone {
+	return c.timeZone
+}
+
+// IsDefault reports whether this is the calendar events are created in when
+// no calendar is given. Every user has exactly one.
+func (c calendar) IsDefault() bool {
+	return c.isDefault
+}
+
+func (c calendar) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c calendar) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *calendar) Update(name Name, color Color, timeZone TimeZone) {
+	c.name = name
+	c.color = color
+	c.timeZone = timeZone
+	c.updatedAt = time.Now()
+}
+
+func NewCalendar(
+	id CalendarID,
+	userID user.UserID,
+	name Name,
+	color Color,
+	timeZone TimeZone,
+	isDefault bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Calendar {
+	return &calendar{
+		id:        id,
+		userID:    userID,
+		name:      name,
+		color:     color,
+		timeZone:  timeZone,
+		isDefault: isDefault,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
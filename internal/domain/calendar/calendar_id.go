@@ -0,0 +1,24 @@
+package calendar
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type CalendarID struct {
+	uuid.UUID
+}
+
+func NewCalendarID() CalendarID {
+	id := uuid.New()
+	return CalendarID{id}
+}
+
+func NewCalendarIDFromString(s string) (CalendarID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return CalendarID{}, fmt.Errorf("%w: %v", ErrInvalidCalendarID, err)
+	}
+	return CalendarID{id}, nil
+}
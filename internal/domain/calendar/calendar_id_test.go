@@ -0,0 +1,55 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewCalendarID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+	}{
+		{"success new calendar id", true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id := NewCalendarID()
+			if tt.success && id.UUID == uuid.Nil {
+				t.Errorf("expected valid calendar id, but got a nil UUID")
+			}
+		})
+	}
+}
+
+func TestNewCalendarIDFromString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		id      string
+	}{
+		{"success new calendar id from string", true, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425"},
+		{"failure empty calendar id", false, ""},
+		{"failure invalid calendar id", false, "0123456789"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewCalendarIDFromString(tt.id)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
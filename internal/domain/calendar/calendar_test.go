@@ -0,0 +1,102 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestNewCalendar(t *testing.T) {
+	t.Parallel()
+	id, err := NewCalendarIDFromString("fe8c2263-bbac-4bb9-a41d-b04f5afc4425")
+	if err != nil {
+		t.Errorf("failed to new calendar id: %v", err)
+	}
+	userID, err := user.NewUserIDFromString("6d322c66-bf4d-427a-970c-874f3745f653")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	tests := []struct {
+		name      string
+		success   bool
+		id        CalendarID
+		userID    user.UserID
+		calName   Name
+		color     Color
+		timeZone  TimeZone
+		isDefault bool
+		createdAt time.Time
+		updatedAt time.Time
+	}{
+		{"success new calendar", true, id, userID, Name("Work"), Color("#FF0000"), TimeZone("Asia/Tokyo"), false, time.Now(), time.Now()},
+		{"success new default calendar", true, id, userID, Name(DefaultName), Color("#FFFFFF"), TimeZone("UTC"), true, time.Now(), time.Now()},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendar := NewCalendar(tt.id, tt.userID, tt.calName, tt.color, tt.timeZone, tt.isDefault, tt.createdAt, tt.updatedAt)
+			if tt.success && calendar.ID() != tt.id {
+				t.Errorf("ID() = %v, want %v", calendar.ID(), tt.id)
+			}
+			if tt.success && calendar.UserID() != tt.userID {
+				t.Errorf("UserID() = %v, want %v", calendar.UserID(), tt.userID)
+			}
+			if tt.success && calendar.Name() != tt.calName {
+				t.Errorf("Name() = %v, want %v", calendar.Name(), tt.calName)
+			}
+			if tt.success && calendar.Color() != tt.color {
+				t.Errorf("Color() = %v, want %v", calendar.Color(), tt.color)
+			}
+			if tt.success && calendar.TimeZone() != tt.timeZone {
+				t.Errorf("TimeZone() = %v, want %v", calendar.TimeZone(), tt.timeZone)
+			}
+			if tt.success && calendar.IsDefault() != tt.isDefault {
+				t.Errorf("IsDefault() = %v, want %v", calendar.IsDefault(), tt.isDefault)
+			}
+			if tt.success && !calendar.CreatedAt().Equal(tt.createdAt) {
+				t.Errorf("CreatedAt() = %v, want %v", calendar.CreatedAt(), tt.createdAt)
+			}
+			if tt.success && !calendar.UpdatedAt().Equal(tt.updatedAt) {
+				t.Errorf("UpdatedAt() = %v, want %v", calendar.UpdatedAt(), tt.updatedAt)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		success  bool
+		calName  Name
+		color    Color
+		timeZone TimeZone
+	}{
+		{"success update calendar", true, Name("Personal"), Color("#00FF00"), TimeZone("Europe/London")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			updatedAt := time.Now().Add(-time.Hour)
+			calendar := NewCalendar(NewCalendarID(), user.UserID{}, Name("Work"), Color("#FF0000"), TimeZone("UTC"), false, updatedAt, updatedAt)
+			calendar.Update(tt.calName, tt.color, tt.timeZone)
+			if tt.success && calendar.Name() != tt.calName {
+				t.Errorf("Name() = %v, want %v", calendar.Name(), tt.calName)
+			}
+			if tt.success && calendar.Color() != tt.color {
+				t.Errorf("Color() = %v, want %v", calendar.Color(), tt.color)
+			}
+			if tt.success && calendar.TimeZone() != tt.timeZone {
+				t.Errorf("TimeZone() = %v, want %v", calendar.TimeZone(), tt.timeZone)
+			}
+			if tt.success && !calendar.UpdatedAt().After(updatedAt) {
+				t.Errorf("UpdatedAt() = %v, want after %v", calendar.UpdatedAt(), updatedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package calendar
+
+import "errors"
+
+var (
+	ErrCalendarNotFound      = errors.New("calendar not found")
+	ErrPermissionDenied      = errors.New("permission denied")
+	ErrInvalidCalendarID     = errors.New("invalid calendar id")
+	ErrInvalidName           = errors.New("invalid name")
+	ErrInvalidColor          = errors.New("invalid color")
+	ErrInvalidTimeZone       = errors.New("invalid time zone")
+	ErrInvalidUpdateMask     = errors.New("invalid update mask")
+	ErrDefaultCalendarDelete = errors.New("the default calendar cannot be deleted")
+	ErrShareNotFound         = errors.New("share not found")
+	ErrInvalidAccessLevel    = errors.New("invalid access level")
+	ErrInvalidShareUser      = errors.New("invalid share user")
+)
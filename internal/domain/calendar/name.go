@@ -0,0 +1,25 @@
+package calendar
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const DefaultName = "Default"
+
+// maxNameLength is the length of the name column, in characters.
+const maxNameLength = 255
+
+type Name string
+
+func (n Name) String() string {
+	return string(n)
+}
+
+func NewName(s string) (Name, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || utf8.RuneCountInString(s) > maxNameLength {
+		return Name(""), ErrInvalidName
+	}
+	return Name(s), nil
+}
@@ -0,0 +1,41 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		success      bool
+		calendarName string
+		expectedName string
+	}{
+		{"success new name", true, "Work", "Work"},
+		{"success trimmed name", true, "  Work ", "Work"},
+		{"failure empty name", false, "", ""},
+		{"failure blank name", false, "   ", ""},
+		{"success longest name", true, strings.Repeat("あ", maxNameLength), strings.Repeat("あ", maxNameLength)},
+		{"failure name too long", false, strings.Repeat("a", maxNameLength+1), ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			name, err := NewName(tt.calendarName)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if tt.success && name.String() != tt.expectedName {
+				t.Errorf("String() = %v, want %v", name.String(), tt.expectedName)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package calendar
+
+import "github.com/qkitzero/event-service/internal/domain/user"
+
+type CalendarRepository interface {
+	Create(calendar Calendar) error
+	Update(calendar Calendar) error
+	Delete(id CalendarID) error
+	FindByID(id CalendarID) (Calendar, error)
+	FindAllByUserID(userID user.UserID) ([]Calendar, error)
+	FindDefaultByUserID(userID user.UserID) (Calendar, error)
+}
+
+type ShareRepository interface {
+	Save(share Share) error
+	Delete(calendarID CalendarID, userID user.UserID) error
+	FindByCalendarIDAndUserID(calendarID CalendarID, userID user.UserID) (Share, error)
+	FindAllByCalendarID(calendarID CalendarID) ([]Share, error)
+	FindAllByUserID(userID user.UserID) ([]Share, error)
+}
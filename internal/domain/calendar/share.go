@@ -0,0 +1,60 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+// Share grants a user other than the owner access to a calendar.
+type Share interface {
+	CalendarID() CalendarID
+	UserID() user.UserID
+	AccessLevel() AccessLevel
+	CreatedAt() time.Time
+	UpdatedAt() time.Time
+}
+
+type share struct {
+	calendarID  CalendarID
+	userID      user.UserID
+	accessLevel AccessLevel
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+func (s share) CalendarID() CalendarID {
+	return s.calendarID
+}
+
+func (s share) UserID() user.UserID {
+	return s.userID
+}
+
+func (s share) AccessLevel() AccessLevel {
+	return s.accessLevel
+}
+
+func (s share) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s share) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+func NewShare(
+	calendarID CalendarID,
+	userID user.UserID,
+	accessLevel AccessLevel,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Share {
+	return &share{
+		calendarID:  calendarID,
+		userID:      userID,
+		accessLevel: accessLevel,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
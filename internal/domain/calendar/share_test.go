@@ -0,0 +1,54 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestNewShare(t *testing.T) {
+	t.Parallel()
+	calendarID, err := NewCalendarIDFromString("fe8c2263-bbac-4bb9-a41d-b04f5afc4425")
+	if err != nil {
+		t.Errorf("failed to new calendar id: %v", err)
+	}
+	userID, err := user.NewUserIDFromString("6d322c66-bf4d-427a-970c-874f3745f653")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	tests := []struct {
+		name        string
+		success     bool
+		calendarID  CalendarID
+		userID      user.UserID
+		accessLevel AccessLevel
+		createdAt   time.Time
+		updatedAt   time.Time
+	}{
+		{"success new share", true, calendarID, userID, AccessLevelRead, time.Now(), time.Now()},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			share := NewShare(tt.calendarID, tt.userID, tt.accessLevel, tt.createdAt, tt.updatedAt)
+			if tt.success && share.CalendarID() != tt.calendarID {
+				t.Errorf("CalendarID() = %v, want %v", share.CalendarID(), tt.calendarID)
+			}
+			if tt.success && share.UserID() != tt.userID {
+				t.Errorf("UserID() = %v, want %v", share.UserID(), tt.userID)
+			}
+			if tt.success && share.AccessLevel() != tt.accessLevel {
+				t.Errorf("AccessLevel() = %v, want %v", share.AccessLevel(), tt.accessLevel)
+			}
+			if tt.success && !share.CreatedAt().Equal(tt.createdAt) {
+				t.Errorf("CreatedAt() = %v, want %v", share.CreatedAt(), tt.createdAt)
+			}
+			if tt.success && !share.UpdatedAt().Equal(tt.updatedAt) {
+				t.Errorf("UpdatedAt() = %v, want %v", share.UpdatedAt(), tt.updatedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package calendar
+
+import "time"
+
+// TimeZone is the IANA time zone new events of a calendar default to.
+type TimeZone string
+
+func (z TimeZone) String() string {
+	return string(z)
+}
+
+// NewTimeZone validates s against the IANA time zone database. An empty string
+// defaults to UTC.
+func NewTimeZone(s string) (TimeZone, error) {
+	z, _, err := LoadTimeZone(s)
+	return z, err
+}
+
+// LoadTimeZone validates s like NewTimeZone and also returns its location.
+func LoadTimeZone(s string) (TimeZone, *time.Location, error) {
+	if s == "" {
+		s = "UTC"
+	}
+
+	if s == "Local" {
+		return TimeZone(""), nil, ErrInvalidTimeZone
+	}
+
+	location, err := time.LoadLocation(s)
+	if err != nil {
+		return TimeZone(""), nil, ErrInvalidTimeZone
+	}
+
+	return TimeZone(s), location, nil
+}
@@ -0,0 +1,36 @@
+package calendar
+
+import "testing"
+
+func TestNewTimeZone(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		success          bool
+		timeZone         string
+		expectedTimeZone string
+	}{
+		{"success new time zone", true, "Asia/Tokyo", "Asia/Tokyo"},
+		{"success default time zone", true, "", "UTC"},
+		{"failure unknown time zone", false, "Mars/Olympus_Mons", ""},
+		{"failure local time zone", false, "Local", ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			timeZone, err := NewTimeZone(tt.timeZone)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if tt.success && timeZone.String() != tt.expectedTimeZone {
+				t.Errorf("String() = %v, want %v", timeZone.String(), tt.expectedTimeZone)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package event
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type AttendeeRole string
+
+const (
+	AttendeeRoleRequired AttendeeRole = "required"
+	AttendeeRoleOptional AttendeeRole = "optional"
+)
+
+func (r AttendeeRole) String() string {
+	return string(r)
+}
+
+// NewAttendeeRole defaults to AttendeeRoleRequired when s is empty.
+func NewAttendeeRole(s string) (AttendeeRole, error) {
+	switch r := AttendeeRole(s); r {
+	case "":
+		return AttendeeRoleRequired, nil
+	case AttendeeRoleRequired, AttendeeRoleOptional:
+		return r, nil
+	}
+
+	return AttendeeRole(""), ErrInvalidAttendeeRole
+}
+
+type ResponseStatus string
+
+const (
+	ResponseStatusNeedsAction ResponseStatus = "needs_action"
+	ResponseStatusAccepted    ResponseStatus = "accepted"
+	ResponseStatusDeclined    ResponseStatus = "declined"
+	ResponseStatusTentative   ResponseStatus = "tentative"
+)
+
+func (s ResponseStatus) String() string {
+	return string(s)
+}
+
+func NewResponseStatus(s string) (ResponseStatus, error) {
+	switch r := ResponseStatus(s); r {
+	case ResponseStatusNeedsAction, ResponseStatusAccepted, ResponseStatusDeclined, ResponseStatusTentative:
+		return r, nil
+	}
+
+	return ResponseStatus(""), ErrInvalidResponseStatus
+}
+
+// Attendee is a user invited to an event, identified either by their user ID
+// or, for people without an account, by their email address.
+type Attendee struct {
+	userID         user.UserID
+	email          string
+	role           AttendeeRole
+	responseStatus ResponseStatus
+}
+
+func (a Attendee) UserID() user.UserID {
+	return a.userID
+}
+
+func (a Attendee) Email() string {
+	return a.email
+}
+
+func (a Attendee) Role() AttendeeRole {
+	return a.role
+}
+
+func (a Attendee) ResponseStatus() ResponseStatus {
+	return a.responseStatus
+}
+
+// IsUser reports whether the attendee is the user with the given ID.
+func (a Attendee) IsUser(userID user.UserID) bool {
+	return a.userID != user.UserID{} && a.userID == userID
+}
+
+// SameAs reports whether a and other identify the same person.
+func (a Attendee) SameAs(other Attendee) bool {
+	if a.userID != (user.UserID{}) {
+		return a.userID == other.userID
+	}
+	return a.email == other.email
+}
+
+// NewAttendee requires exactly one of userID and email. Emails are stored in
+// lower case.
+func NewAttendee(userID user.UserID, email string, role AttendeeRole, responseStatus ResponseStatus) (Attendee, error) {
+	hasUserID := userID != user.UserID{}
+	if hasUserID == (email != "") {
+		return Attendee{}, ErrInvalidAttendee
+	}
+
+	if email != "" {
+		address, err := mail.ParseAddress(email)
+		if err != nil || address.Address != email {
+			return Attendee{}, ErrInvalidAttendee
+		}
+		email = strings.ToLower(email)
+	}
+
+	return Attendee{userID: userID, email: email, role: role, responseStatus: responseStatus}, nil
+}
+
+// ValidateAttendees rejects lists that invite the same person twice.
+func ValidateAttendees(attendees []Attendee) error {
+	for i, a := range attendees {
+		for _, b := range attendees[:i] {
+			if a.SameAs(b) {
+				return ErrDuplicateAttendee
+			}
+		}
+	}
+
+	return nil
+}
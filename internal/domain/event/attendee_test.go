@@ -0,0 +1,146 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestNewAttendeeRole(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		success      bool
+		role         string
+		expectedRole AttendeeRole
+	}{
+		{"success required", true, "required", AttendeeRoleRequired},
+		{"success optional", true, "optional", AttendeeRoleOptional},
+		{"success default role", true, "", AttendeeRoleRequired},
+		{"failure invalid role", false, "chair", ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			role, err := NewAttendeeRole(tt.role)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && role != tt.expectedRole {
+				t.Errorf("NewAttendeeRole() = %v, want %v", role, tt.expectedRole)
+			}
+		})
+	}
+}
+
+func TestNewResponseStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		success        bool
+		responseStatus string
+	}{
+		{"success needs action", true, "needs_action"},
+		{"success accepted", true, "accepted"},
+		{"success declined", true, "declined"},
+		{"success tentative", true, "tentative"},
+		{"failure empty response status", false, ""},
+		{"failure invalid response status", false, "maybe"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			responseStatus, err := NewResponseStatus(tt.responseStatus)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && responseStatus.String() != tt.responseStatus {
+				t.Errorf("String() = %v, want %v", responseStatus.String(), tt.responseStatus)
+			}
+		})
+	}
+}
+
+func TestNewAttendee(t *testing.T) {
+	t.Parallel()
+	userID := user.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}
+	tests := []struct {
+		name          string
+		success       bool
+		userID        user.UserID
+		email         string
+		expectedEmail string
+	}{
+		{"success user attendee", true, userID, "", ""},
+		{"success email attendee", true, user.UserID{}, "Guest@Example.com", "guest@example.com"},
+		{"failure neither user id nor email", false, user.UserID{}, "", ""},
+		{"failure both user id and email", false, userID, "guest@example.com", ""},
+		{"failure invalid email", false, user.UserID{}, "guest", ""},
+		{"failure email with display name", false, user.UserID{}, "Guest <guest@example.com>", ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			attendee, err := NewAttendee(tt.userID, tt.email, AttendeeRoleRequired, ResponseStatusNeedsAction)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && attendee.Email() != tt.expectedEmail {
+				t.Errorf("Email() = %v, want %v", attendee.Email(), tt.expectedEmail)
+			}
+			if tt.success && attendee.UserID() != tt.userID {
+				t.Errorf("UserID() = %v, want %v", attendee.UserID(), tt.userID)
+			}
+		})
+	}
+}
+
+func TestValidateAttendees(t *testing.T) {
+	t.Parallel()
+	userID := user.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}
+	userAttendee, _ := NewAttendee(userID, "", AttendeeRoleRequired, ResponseStatusNeedsAction)
+	optionalUserAttendee, _ := NewAttendee(userID, "", AttendeeRoleOptional, ResponseStatusNeedsAction)
+	emailAttendee, _ := NewAttendee(user.UserID{}, "guest@example.com", AttendeeRoleRequired, ResponseStatusNeedsAction)
+	upperEmailAttendee, _ := NewAttendee(user.UserID{}, "GUEST@example.com", AttendeeRoleRequired, ResponseStatusNeedsAction)
+	tests := []struct {
+		name      string
+		success   bool
+		attendees []Attendee
+	}{
+		{"success no attendees", true, nil},
+		{"success distinct attendees", true, []Attendee{userAttendee, emailAttendee}},
+		{"failure duplicate user", false, []Attendee{userAttendee, optionalUserAttendee}},
+		{"failure duplicate email", false, []Attendee{emailAttendee, upperEmailAttendee}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateAttendees(tt.attendees)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
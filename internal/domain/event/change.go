@@ -0,0 +1,78 @@
+package event
+
+import (
+	"slices"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeCreated ChangeType = "EventCreated"
+	ChangeTypeUpdated ChangeType = "EventUpdated"
+	ChangeTypeDeleted ChangeType = "EventDeleted"
+)
+
+func (t ChangeType) String() string {
+	return string(t)
+}
+
+// Change records a change to an event for downstream services. Before and
+// After are JSON snapshots of the event and are nil when it did not exist
+// before or no longer exists after the change. BeforeAudience and
+// AfterAudience are who could see the event on either side of the change,
+// and are nil when it did not exist or was in the trash. TxID is the
+// transaction that made the change.
+type Change struct {
+	ID             int64
+	TxID           int64
+	Type           ChangeType
+	EventID        EventID
+	Before         []byte
+	After          []byte
+	BeforeAudience *Audience
+	AfterAudience  *Audience
+	OccurredAt     time.Time
+}
+
+func (c Change) Position() ChangePosition {
+	return ChangePosition{TxID: c.TxID, ChangeID: c.ID}
+}
+
+// ChangePosition is a place in the sequence of changes. Changes are ordered
+// by the transaction that made them and then by ID, since a transaction may
+// commit after one that started later. Once every transaction before a
+// position has finished, no change can appear before it.
+type ChangePosition struct {
+	TxID     int64
+	ChangeID int64
+}
+
+// After reports whether p comes after other.
+func (p ChangePosition) After(other ChangePosition) bool {
+	if p.TxID != other.TxID {
+		return p.TxID > other.TxID
+	}
+	return p.ChangeID > other.ChangeID
+}
+
+// Audience is who can see an event: its owner, its attendees and the users
+// its calendar is shared with.
+type Audience struct {
+	OwnerID     user.UserID
+	CalendarID  calendar.CalendarID
+	AttendeeIDs []user.UserID
+}
+
+// Includes reports whether userID can see the event, given the calendars
+// that userID can read.
+func (a Audience) Includes(userID user.UserID, calendarIDs map[calendar.CalendarID]struct{}) bool {
+	if a.OwnerID == userID || slices.Contains(a.AttendeeIDs, userID) {
+		return true
+	}
+	_, ok := calendarIDs[a.CalendarID]
+	return ok
+}
@@ -0,0 +1,72 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestChangePositionAfter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		position ChangePosition
+		other    ChangePosition
+		expected bool
+	}{
+		{"later transaction", ChangePosition{TxID: 8, ChangeID: 1}, ChangePosition{TxID: 7, ChangeID: 5}, true},
+		{"earlier transaction", ChangePosition{TxID: 7, ChangeID: 5}, ChangePosition{TxID: 8, ChangeID: 1}, false},
+		{"later change in the same transaction", ChangePosition{TxID: 7, ChangeID: 6}, ChangePosition{TxID: 7, ChangeID: 5}, true},
+		{"same position", ChangePosition{TxID: 7, ChangeID: 5}, ChangePosition{TxID: 7, ChangeID: 5}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.position.After(tt.other); got != tt.expected {
+				t.Errorf("After() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAudienceIncludes(t *testing.T) {
+	t.Parallel()
+	ownerID, err := user.NewUserIDFromString("fe8c2263-bbac-4bb9-a41d-b04f5afc4425")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	attendeeID, err := user.NewUserIDFromString("0b1c6f8e-8a0f-4d3e-9b9e-2f1f3c4d5e6f")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	otherID, err := user.NewUserIDFromString("5a7c1d9e-3b2f-4e6a-8c0d-1e2f3a4b5c6d")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	calendarID := calendar.NewCalendarID()
+	audience := Audience{OwnerID: ownerID, CalendarID: calendarID, AttendeeIDs: []user.UserID{attendeeID}}
+	tests := []struct {
+		name        string
+		userID      user.UserID
+		calendarIDs map[calendar.CalendarID]struct{}
+		expected    bool
+	}{
+		{"owner", ownerID, nil, true},
+		{"attendee", attendeeID, nil, true},
+		{"reader of the calendar", otherID, map[calendar.CalendarID]struct{}{calendarID: {}}, true},
+		{"other user", otherID, map[calendar.CalendarID]struct{}{calendar.NewCalendarID(): {}}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := audience.Includes(tt.userID, tt.calendarIDs); got != tt.expected {
+				t.Errorf("Includes() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -1,26 +1,11 @@
 package event
 
-import (
-	"fmt"
-	"regexp"
-)
+import "github.com/qkitzero/event-service/internal/domain/calendar"
 
-type Color string
-
-var colorRegexp = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
-
-func (c Color) String() string {
-	return string(c)
-}
+// Color is the calendar color type, so that events and calendars accept the
+// same colors.
+type Color = calendar.Color
 
 func NewColor(s string) (Color, error) {
-	if s == "" {
-		return Color("#FFFFFF"), nil
-	}
-
-	if !colorRegexp.MatchString(s) {
-		return Color(""), fmt.Errorf("invalid color")
-	}
-
-	return Color(s), nil
+	return calendar.NewColor(s)
 }
@@ -0,0 +1,66 @@
+package event
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+// conflictHorizon bounds how far past its start a recurring event is checked
+// for conflicts.
+const conflictHorizon = 90 * 24 * time.Hour
+
+// ConflictSpans returns when e takes place for the purpose of conflict
+// detection: the event itself or, for a recurring event, its occurrences
+// within conflictHorizon of its start. Events that leave their organizer free
+// have no spans.
+func ConflictSpans(e Event) []Interval {
+	if !e.IsBusyFor(e.UserID()) {
+		return nil
+	}
+
+	return spans(e, e.StartTime(), e.StartTime().Add(conflictHorizon))
+}
+
+// Conflicts returns the events among others, except the one with excludeID,
+// that keep userID busy during any of the spans, which must be sorted.
+// Recurring events are reported once, however many occurrences overlap.
+func Conflicts(spansToCheck []Interval, others []Event, userID user.UserID, excludeID EventID) []Event {
+	if len(spansToCheck) == 0 {
+		return nil
+	}
+	from, to := spansToCheck[0].Start, spansToCheck[len(spansToCheck)-1].End
+
+	var conflicts []Event
+	for _, other := range others {
+		if other.ID() == excludeID || !other.IsBusyFor(userID) {
+			continue
+		}
+		if overlapsAny(spans(other, from, to), spansToCheck) {
+			conflicts = append(conflicts, other)
+		}
+	}
+
+	return conflicts
+}
+
+// spans returns when e takes place within [from, to).
+func spans(e Event, from, to time.Time) []Interval {
+	var intervals []Interval
+	for _, occurrence := range e.Occurrences(from, to) {
+		intervals = append(intervals, busySpan(occurrence))
+	}
+
+	return intervals
+}
+
+func overlapsAny(a, b []Interval) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x.Start.Before(y.End) && y.Start.Before(x.End) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestConflictSpans(t *testing.T) {
+	t.Parallel()
+	userID := user.UserID{UUID: uuid.New()}
+	startTime := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	daily, err := NewRecurrence([]string{"RRULE:FREQ=DAILY"})
+	if err != nil {
+		t.Fatalf("failed to new recurrence: %v", err)
+	}
+	tests := []struct {
+		name          string
+		endTime       time.Time
+		allDay        bool
+		recurrence    Recurrence
+		expectedSpans int
+	}{
+		{"single event", startTime.Add(time.Hour), false, Recurrence{}, 1},
+		{"recurring event within horizon", startTime.Add(time.Hour), false, daily, 90},
+		{"all-day event", startTime.Add(24 * time.Hour), true, Recurrence{}, 1},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := NewEvent(NewEventID(), userID, calendar.CalendarID{}, Title("title"), Description("description"), startTime, tt.endTime, tt.allDay, TimeZone{}, Color("#FFFFFF"), tt.recurrence, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+
+			if got := ConflictSpans(e); len(got) != tt.expectedSpans {
+				t.Errorf("len(ConflictSpans()) = %d, want %d", len(got), tt.expectedSpans)
+			}
+		})
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	t.Parallel()
+	userID := user.UserID{UUID: uuid.New()}
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, 1, day, hour, 0, 0, 0, time.UTC)
+	}
+	weekly, err := NewRecurrence([]string{"RRULE:FREQ=WEEKLY"})
+	if err != nil {
+		t.Fatalf("failed to new recurrence: %v", err)
+	}
+	declined, err := NewAttendee(userID, "", AttendeeRoleRequired, ResponseStatusDeclined)
+	if err != nil {
+		t.Fatalf("failed to new attendee: %v", err)
+	}
+	newEvent := func(id EventID, ownerID user.UserID, start, end time.Time, recurrence Recurrence, attendees []Attendee) Event {
+		return NewEvent(id, ownerID, calendar.CalendarID{}, Title("title"), Description("description"), start, end, false, TimeZone{}, Color("#FFFFFF"), recurrence, attendees, nil, "", 1, time.Now(), time.Now(), time.Time{})
+	}
+	kiritimati, err := NewTimeZone("Pacific/Kiritimati")
+	if err != nil {
+		t.Fatalf("failed to new time zone: %v", err)
+	}
+	newAllDayEvent := func(day int, timeZone TimeZone) Event {
+		return NewEvent(NewEventID(), userID, calendar.CalendarID{}, Title("title"), Description("description"), at(day, 0), at(day+1, 0), true, timeZone, Color("#FFFFFF"), Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+	}
+	excludeID := NewEventID()
+	spansToCheck := []Interval{{at(6, 10), at(6, 11)}, {at(13, 10), at(13, 11)}}
+	tests := []struct {
+		name     string
+		other    Event
+		expected bool
+	}{
+		{"overlapping event", newEvent(NewEventID(), userID, at(6, 9), at(6, 11), Recurrence{}, nil), true},
+		{"overlap with a later span", newEvent(NewEventID(), userID, at(13, 10), at(13, 12), Recurrence{}, nil), true},
+		{"touching event", newEvent(NewEventID(), userID, at(6, 11), at(6, 12), Recurrence{}, nil), false},
+		{"occurrence of a recurring event", newEvent(NewEventID(), userID, time.Date(2024, 12, 30, 10, 0, 0, 0, time.UTC), time.Date(2024, 12, 30, 11, 0, 0, 0, time.UTC), weekly, nil), true},
+		{"recurring event between spans", newEvent(NewEventID(), userID, at(1, 10), at(1, 11), weekly, nil), false},
+		{"excluded event", newEvent(excludeID, userID, at(6, 10), at(6, 11), Recurrence{}, nil), false},
+		{"all-day event on the same date", newAllDayEvent(6, TimeZone{}), true},
+		{"all-day event that ends earlier in its time zone", newAllDayEvent(6, kiritimati), false},
+		{"declined invitation", newEvent(NewEventID(), user.UserID{UUID: uuid.New()}, at(6, 10), at(6, 11), Recurrence{}, []Attendee{declined}), false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conflicts := Conflicts(spansToCheck, []Event{tt.other}, userID, excludeID)
+			if got := len(conflicts) == 1; got != tt.expected {
+				t.Errorf("Conflicts() = %v, want conflict %v", conflicts, tt.expected)
+			}
+		})
+	}
+}
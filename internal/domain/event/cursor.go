@@ -0,0 +1,65 @@
+package event
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cursor identifies a position in a list of events ordered by start time and
+// ID, and is exchanged with clients as an opaque page token.
+type Cursor struct {
+	startTime time.Time
+	id        EventID
+}
+
+func (c Cursor) StartTime() time.Time {
+	return c.startTime
+}
+
+func (c Cursor) ID() EventID {
+	return c.id
+}
+
+func (c Cursor) Token() string {
+	s := strconv.FormatInt(c.startTime.UnixNano(), 10) + "|" + c.id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+// After reports whether an event starting at startTime with the given ID is
+// positioned after the cursor.
+func (c Cursor) After(startTime time.Time, id EventID) bool {
+	if !startTime.Equal(c.startTime) {
+		return startTime.After(c.startTime)
+	}
+	return id.String() > c.id.String()
+}
+
+func NewCursor(startTime time.Time, id EventID) Cursor {
+	return Cursor{startTime: startTime, id: id}
+}
+
+func NewCursorFromToken(token string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, ErrInvalidPageToken
+	}
+
+	nanos, id, ok := strings.Cut(string(b), "|")
+	if !ok {
+		return Cursor{}, ErrInvalidPageToken
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Cursor{}, ErrInvalidPageToken
+	}
+
+	eventID, err := NewEventIDFromString(id)
+	if err != nil {
+		return Cursor{}, ErrInvalidPageToken
+	}
+
+	return Cursor{startTime: time.Unix(0, n).UTC(), id: eventID}, nil
+}
@@ -0,0 +1,80 @@
+package event
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewCursorFromToken(t *testing.T) {
+	t.Parallel()
+	id, err := NewEventIDFromString("fe8c2263-bbac-4bb9-a41d-b04f5afc4425")
+	if err != nil {
+		t.Errorf("failed to new event id: %v", err)
+	}
+	startTime := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		success bool
+		token   string
+	}{
+		{"success new cursor from token", true, NewCursor(startTime, id).Token()},
+		{"failure empty token", false, ""},
+		{"failure invalid base64", false, "!!!"},
+		{"failure invalid event id", false, "MTIzfGFiYw"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cursor, err := NewCursorFromToken(tt.token)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && !cursor.StartTime().Equal(startTime) {
+				t.Errorf("StartTime() = %v, want %v", cursor.StartTime(), startTime)
+			}
+			if tt.success && cursor.ID() != id {
+				t.Errorf("ID() = %v, want %v", cursor.ID(), id)
+			}
+		})
+	}
+}
+
+func TestCursorAfter(t *testing.T) {
+	t.Parallel()
+	id, err := NewEventIDFromString("5e8c2263-bbac-4bb9-a41d-b04f5afc4425")
+	if err != nil {
+		t.Errorf("failed to new event id: %v", err)
+	}
+	laterID, err := NewEventIDFromString("fe8c2263-bbac-4bb9-a41d-b04f5afc4425")
+	if err != nil {
+		t.Errorf("failed to new event id: %v", err)
+	}
+	startTime := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	cursor := NewCursor(startTime, id)
+	tests := []struct {
+		name      string
+		startTime time.Time
+		id        EventID
+		expected  bool
+	}{
+		{"later start time", startTime.Add(time.Minute), id, true},
+		{"earlier start time", startTime.Add(-time.Minute), laterID, false},
+		{"same start time with greater id", startTime, laterID, true},
+		{"same position", startTime, id, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := cursor.After(tt.startTime, tt.id); got != tt.expected {
+				t.Errorf("After() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -1,9 +1,6 @@
 package event
 
-import (
-	"fmt"
-	"strings"
-)
+import "strings"
 
 type Description string
 
@@ -14,7 +11,7 @@ func (d Description) String() string {
 func NewDescription(s string) (Description, error) {
 	s = strings.TrimSpace(s)
 	if s == "" {
-		return Description(""), fmt.Errorf("invalid description")
+		return Description(""), ErrInvalidDescription
 	}
 	return Description(s), nil
 }
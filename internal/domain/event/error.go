@@ -1,10 +1,55 @@
 package event
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+)
 
 var (
-	ErrEventNotFound     = errors.New("event not found")
-	ErrPermissionDenied  = errors.New("permission denied")
-	ErrStartTimeRequired = errors.New("start time is required")
-	ErrEndTimeRequired   = errors.New("end time is required")
+	ErrEventNotFound         = errors.New("event not found")
+	ErrPermissionDenied      = errors.New("permission denied")
+	ErrStartTimeRequired     = errors.New("start time is required")
+	ErrEndTimeRequired       = errors.New("end time is required")
+	ErrInvalidTimeRange      = errors.New("end time must be after start time")
+	ErrDurationTooLong       = errors.New("event duration exceeds the maximum")
+	ErrStartDateRequired     = errors.New("start date is required for all-day events")
+	ErrEndDateRequired       = errors.New("end date is required for all-day events")
+	ErrInvalidDate           = errors.New("invalid date")
+	ErrInvalidTimeZone       = calendar.ErrInvalidTimeZone
+	ErrInvalidAllDayRange    = errors.New("all-day events must start and end on date boundaries")
+	ErrInvalidTimeWindow     = errors.New("invalid time window")
+	ErrInvalidPageSize       = errors.New("invalid page size")
+	ErrInvalidPageToken      = errors.New("invalid page token")
+	ErrInvalidResumeToken    = errors.New("invalid resume token")
+	ErrResumeTokenExpired    = errors.New("resume token is too old, watch again without one")
+	ErrInvalidSyncToken      = errors.New("invalid sync token")
+	ErrSyncTokenExpired      = errors.New("sync token is too old, a full sync is required")
+	ErrInvalidUpdateMask     = errors.New("invalid update mask")
+	ErrInvalidEventID        = errors.New("invalid event id")
+	ErrInvalidTitle          = errors.New("invalid title")
+	ErrInvalidDescription    = errors.New("invalid description")
+	ErrInvalidColor          = calendar.ErrInvalidColor
+	ErrInvalidRecurrence     = errors.New("invalid recurrence")
+	ErrInvalidETag           = errors.New("invalid etag")
+	ErrETagMismatch          = errors.New("etag does not match the current version of the event")
+	ErrVersionConflict       = errors.New("event was modified concurrently")
+	ErrInvalidICalendar      = errors.New("invalid iCalendar data")
+	ErrInvalidAttendee       = errors.New("attendee must have either a user id or a valid email")
+	ErrDuplicateAttendee     = errors.New("attendee is invited more than once")
+	ErrInvalidAttendeeRole   = errors.New("invalid attendee role")
+	ErrInvalidResponseStatus = errors.New("invalid response status")
+	ErrNotOrganizer          = errors.New("only the organizer can edit the event")
+	ErrNotAttendee           = errors.New("user is not invited to the event")
+	ErrInvalidUserIDs        = errors.New("invalid user ids")
+	ErrInvalidDuration       = errors.New("invalid duration")
+	ErrInvalidWorkingHours   = errors.New("invalid working hours")
+	ErrInvalidBuffer         = errors.New("invalid buffer")
+	ErrInvalidMaxResults     = errors.New("invalid max results")
+	ErrConflictingEvents     = errors.New("event conflicts with other events")
+	ErrInvalidReminder       = errors.New("reminder offset must be a whole number of minutes of at most four weeks")
+	ErrInvalidChannel        = errors.New("invalid reminder channel")
+	ErrDuplicateReminder     = errors.New("reminder is set more than once")
+	ErrTooManyReminders      = errors.New("too many reminders")
+	ErrICalUIDConflict       = errors.New("another event already has the same iCalendar UID")
 )
@@ -0,0 +1,26 @@
+package event
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NewETag returns the entity tag for the given event version, formatted as a
+// quoted string so it can be used verbatim in HTTP ETag headers.
+func NewETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+func ParseETag(etag string) (int64, error) {
+	s := strings.TrimPrefix(etag, "W/")
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return 0, ErrInvalidETag
+	}
+
+	version, err := strconv.ParseInt(s[1:len(s)-1], 10, 64)
+	if err != nil || version < 1 {
+		return 0, ErrInvalidETag
+	}
+
+	return version, nil
+}
@@ -0,0 +1,37 @@
+package event
+
+import "testing"
+
+func TestParseETag(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		success         bool
+		etag            string
+		expectedVersion int64
+	}{
+		{"success parse etag", true, NewETag(3), 3},
+		{"success weak etag", true, `W/"3"`, 3},
+		{"failure empty etag", false, "", 0},
+		{"failure unquoted etag", false, "3", 0},
+		{"failure non numeric etag", false, `"abc"`, 0},
+		{"failure zero version", false, `"0"`, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			version, err := ParseETag(tt.etag)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && version != tt.expectedVersion {
+				t.Errorf("ParseETag() = %v, want %v", version, tt.expectedVersion)
+			}
+		})
+	}
+}
@@ -1,34 +1,64 @@
 package event
 
 import (
+	"slices"
 	"time"
 
+	"github.com/qkitzero/event-service/internal/domain/calendar"
 	"github.com/qkitzero/event-service/internal/domain/user"
 )
 
+// Event is owned by the owner of its calendar, who is also its organizer.
+// Only the organizer may edit the event; attendees may only respond to it.
 type Event interface {
 	ID() EventID
 	UserID() user.UserID
+	CalendarID() calendar.CalendarID
 	Title() Title
 	Description() Description
 	StartTime() time.Time
 	EndTime() time.Time
+	AllDay() bool
+	TimeZone() TimeZone
 	Color() Color
+	Recurrence() Recurrence
+	Attendees() []Attendee
+	Reminders() []Reminder
+	NextReminderTime(r Reminder, after time.Time) (time.Time, bool)
+	IsAttendee(userID user.UserID) bool
+	IsBusyFor(userID user.UserID) bool
+	ICalUID() string
+	Version() int64
+	ETag() string
 	CreatedAt() time.Time
 	UpdatedAt() time.Time
-	Update(title Title, description Description, startTime, endTime time.Time, color Color)
+	DeletedAt() time.Time
+	IsDeleted() bool
+	AuthorizeEdit(editor user.UserID) error
+	Update(editor user.UserID, calendarID calendar.CalendarID, title Title, description Description, timeRange TimeRange, timeZone TimeZone, color Color, recurrence Recurrence, attendees []Attendee, reminders []Reminder) error
+	Respond(userID user.UserID, responseStatus ResponseStatus) error
+	Occurrences(from, to time.Time) []Event
 }
 
 type event struct {
 	id          EventID
 	userID      user.UserID
+	calendarID  calendar.CalendarID
 	title       Title
 	description Description
 	startTime   time.Time
 	endTime     time.Time
+	allDay      bool
+	timeZone    TimeZone
 	color       Color
+	recurrence  Recurrence
+	attendees   []Attendee
+	reminders   []Reminder
+	icalUID     string
+	version     int64
 	createdAt   time.Time
 	updatedAt   time.Time
+	deletedAt   time.Time
 }
 
 func (e event) ID() EventID {
@@ -39,6 +69,10 @@ func (e event) UserID() user.UserID {
 	return e.userID
 }
 
+func (e event) CalendarID() calendar.CalendarID {
+	return e.calendarID
+}
+
 func (e event) Title() Title {
 	return e.title
 }
@@ -55,10 +89,84 @@ func (e event) EndTime() time.Time {
 	return e.endTime
 }
 
+func (e event) AllDay() bool {
+	return e.allDay
+}
+
+func (e event) TimeZone() TimeZone {
+	return e.timeZone
+}
+
 func (e event) Color() Color {
 	return e.color
 }
 
+func (e event) Recurrence() Recurrence {
+	return e.recurrence
+}
+
+func (e event) Attendees() []Attendee {
+	return e.attendees
+}
+
+func (e event) Reminders() []Reminder {
+	return e.reminders
+}
+
+// NextReminderTime returns when r fires for the first occurrence whose
+// reminder time is after the given time. ok is false when there is none, or
+// when the next occurrence is more than a year away.
+func (e event) NextReminderTime(r Reminder, after time.Time) (time.Time, bool) {
+	from := after.Add(r.offset)
+	for _, occurrence := range e.Occurrences(from, from.Add(reminderHorizon)) {
+		if occurrence.StartTime().After(from) {
+			return occurrence.StartTime().Add(-r.offset), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (e event) IsAttendee(userID user.UserID) bool {
+	for _, a := range e.attendees {
+		if a.IsUser(userID) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusyFor reports whether the event takes up userID's time: always for the
+// organizer, and for attendees unless they declined.
+func (e event) IsBusyFor(userID user.UserID) bool {
+	if e.userID == userID {
+		return true
+	}
+	for _, a := range e.attendees {
+		if a.IsUser(userID) {
+			return a.responseStatus != ResponseStatusDeclined
+		}
+	}
+	return false
+}
+
+// ICalUID returns the iCalendar UID of the event. Imported events keep the UID
+// of their source; other events use one derived from their ID.
+func (e event) ICalUID() string {
+	if e.icalUID == "" {
+		return NewUID(e.id)
+	}
+	return e.icalUID
+}
+
+func (e event) Version() int64 {
+	return e.version
+}
+
+func (e event) ETag() string {
+	return NewETag(e.version)
+}
+
 func (e event) CreatedAt() time.Time {
 	return e.createdAt
 }
@@ -67,35 +175,146 @@ func (e event) UpdatedAt() time.Time {
 	return e.updatedAt
 }
 
-func (e *event) Update(title Title, description Description, startTime, endTime time.Time, color Color) {
+func (e event) DeletedAt() time.Time {
+	return e.deletedAt
+}
+
+func (e event) IsDeleted() bool {
+	return !e.deletedAt.IsZero()
+}
+
+// AuthorizeEdit returns ErrNotOrganizer unless editor is the organizer.
+func (e event) AuthorizeEdit(editor user.UserID) error {
+	if editor != e.userID {
+		return ErrNotOrganizer
+	}
+	return nil
+}
+
+// Update replaces the event's details. Attendees that were already invited
+// keep their response status.
+func (e *event) Update(editor user.UserID, calendarID calendar.CalendarID, title Title, description Description, timeRange TimeRange, timeZone TimeZone, color Color, recurrence Recurrence, attendees []Attendee, reminders []Reminder) error {
+	if err := e.AuthorizeEdit(editor); err != nil {
+		return err
+	}
+
+	if err := ValidateAttendees(attendees); err != nil {
+		return err
+	}
+
+	if err := ValidateReminders(reminders); err != nil {
+		return err
+	}
+
+	newAttendees := make([]Attendee, 0, len(attendees))
+	for _, a := range attendees {
+		for _, existing := range e.attendees {
+			if existing.SameAs(a) {
+				a.responseStatus = existing.responseStatus
+				break
+			}
+		}
+		newAttendees = append(newAttendees, a)
+	}
+
+	e.calendarID = calendarID
 	e.title = title
 	e.description = description
-	e.startTime = startTime
-	e.endTime = endTime
+	e.startTime = timeRange.Start()
+	e.endTime = timeRange.End()
+	e.allDay = timeRange.AllDay()
+	e.timeZone = timeZone
 	e.color = color
+	e.recurrence = recurrence
+	e.attendees = newAttendees
+	e.reminders = reminders
+	e.version++
 	e.updatedAt = time.Now()
+
+	return nil
+}
+
+// Respond records the response of an attendee who is a user of the service.
+func (e *event) Respond(userID user.UserID, responseStatus ResponseStatus) error {
+	for i, a := range e.attendees {
+		if !a.IsUser(userID) {
+			continue
+		}
+
+		attendees := slices.Clone(e.attendees)
+		attendees[i].responseStatus = responseStatus
+		e.attendees = attendees
+		e.version++
+		e.updatedAt = time.Now()
+
+		return nil
+	}
+
+	return ErrNotAttendee
+}
+
+func (e event) Occurrences(from, to time.Time) []Event {
+	duration := e.endTime.Sub(e.startTime)
+	if e.allDay {
+		from, to = AllDayWindow(from, to)
+	}
+
+	// Recurrences are expanded in the event's time zone so that occurrences
+	// keep their wall-clock time across DST transitions.
+	start := e.startTime
+	if !e.allDay {
+		start = start.In(e.timeZone.Location())
+	}
+
+	var occurrences []Event
+	for _, startTime := range e.recurrence.Occurrences(start, duration, from, to) {
+		occurrence := e
+		occurrence.startTime = startTime
+		occurrence.endTime = startTime.Add(duration)
+		occurrences = append(occurrences, &occurrence)
+	}
+
+	return occurrences
 }
 
 func NewEvent(
 	id EventID,
 	userID user.UserID,
+	calendarID calendar.CalendarID,
 	title Title,
 	description Description,
 	startTime time.Time,
 	endTime time.Time,
+	allDay bool,
+	timeZone TimeZone,
 	color Color,
+	recurrence Recurrence,
+	attendees []Attendee,
+	reminders []Reminder,
+	icalUID string,
+	version int64,
 	createdAt time.Time,
 	updatedAt time.Time,
+	deletedAt time.Time,
 ) Event {
 	return &event{
 		id:          id,
 		userID:      userID,
+		calendarID:  calendarID,
 		title:       title,
 		description: description,
 		startTime:   startTime,
 		endTime:     endTime,
+		allDay:      allDay,
+		timeZone:    timeZone,
 		color:       color,
+		recurrence:  recurrence,
+		attendees:   attendees,
+		reminders:   reminders,
+		icalUID:     icalUID,
+		version:     version,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
+		deletedAt:   deletedAt,
 	}
 }
@@ -18,7 +18,7 @@ func NewEventID() EventID {
 func NewEventIDFromString(s string) (EventID, error) {
 	id, err := uuid.Parse(s)
 	if err != nil {
-		return EventID{}, fmt.Errorf("invalid UUID format: %w", err)
+		return EventID{}, fmt.Errorf("%w: %v", ErrInvalidEventID, err)
 	}
 	return EventID{id}, nil
 }
@@ -4,6 +4,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
 	"github.com/qkitzero/event-service/internal/domain/user"
 )
 
@@ -29,6 +32,14 @@ func TestNewEvent(t *testing.T) {
 	if err != nil {
 		t.Errorf("failed to new color: %v", err)
 	}
+	recurrence, err := NewRecurrence([]string{"RRULE:FREQ=WEEKLY;BYDAY=MO"})
+	if err != nil {
+		t.Errorf("failed to new recurrence: %v", err)
+	}
+	timeZone, err := NewTimeZone("Asia/Tokyo")
+	if err != nil {
+		t.Errorf("failed to new time zone: %v", err)
+	}
 	tests := []struct {
 		name        string
 		success     bool
@@ -38,18 +49,26 @@ func TestNewEvent(t *testing.T) {
 		description Description
 		startTime   time.Time
 		endTime     time.Time
+		allDay      bool
+		timeZone    TimeZone
 		color       Color
+		recurrence  Recurrence
+		icalUID     string
+		version     int64
 		createdAt   time.Time
 		updatedAt   time.Time
+		deletedAt   time.Time
 	}{
-		{"success new event", true, id, userID, title, description, time.Now(), time.Now(), color, time.Now(), time.Now()},
+		{"success new event", true, id, userID, title, description, time.Now(), time.Now(), false, timeZone, color, recurrence, "", 1, time.Now(), time.Now(), time.Time{}},
+		{"success new imported event", true, id, userID, title, description, time.Now(), time.Now(), false, timeZone, color, recurrence, "source-uid@example.com", 1, time.Now(), time.Now(), time.Time{}},
+		{"success new deleted event", true, id, userID, title, description, time.Now(), time.Now(), false, timeZone, color, recurrence, "", 1, time.Now(), time.Now(), time.Now()},
 	}
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			event := NewEvent(tt.id, tt.userID, tt.title, tt.description, tt.startTime, tt.endTime, tt.color, tt.createdAt, tt.updatedAt)
+			event := NewEvent(tt.id, tt.userID, calendar.CalendarID{}, tt.title, tt.description, tt.startTime, tt.endTime, tt.allDay, tt.timeZone, tt.color, tt.recurrence, nil, nil, tt.icalUID, tt.version, tt.createdAt, tt.updatedAt, tt.deletedAt)
 			if tt.success && event.ID() != tt.id {
 				t.Errorf("ID() = %v, want %v", event.ID(), tt.id)
 			}
@@ -68,15 +87,43 @@ func TestNewEvent(t *testing.T) {
 			if tt.success && !event.EndTime().Equal(tt.endTime) {
 				t.Errorf("EndTime() = %v, want %v", event.EndTime(), tt.endTime)
 			}
+			if tt.success && event.AllDay() != tt.allDay {
+				t.Errorf("AllDay() = %v, want %v", event.AllDay(), tt.allDay)
+			}
+			if tt.success && event.TimeZone() != tt.timeZone {
+				t.Errorf("TimeZone() = %v, want %v", event.TimeZone(), tt.timeZone)
+			}
 			if tt.success && event.Color() != tt.color {
 				t.Errorf("Color() = %v, want %v", event.Color(), tt.color)
 			}
+			if tt.success && event.Recurrence().String() != tt.recurrence.String() {
+				t.Errorf("Recurrence() = %v, want %v", event.Recurrence(), tt.recurrence)
+			}
+			expectedICalUID := tt.icalUID
+			if expectedICalUID == "" {
+				expectedICalUID = NewUID(tt.id)
+			}
+			if tt.success && event.ICalUID() != expectedICalUID {
+				t.Errorf("ICalUID() = %v, want %v", event.ICalUID(), expectedICalUID)
+			}
+			if tt.success && event.Version() != tt.version {
+				t.Errorf("Version() = %v, want %v", event.Version(), tt.version)
+			}
+			if tt.success && event.ETag() != NewETag(tt.version) {
+				t.Errorf("ETag() = %v, want %v", event.ETag(), NewETag(tt.version))
+			}
 			if tt.success && !event.CreatedAt().Equal(tt.createdAt) {
 				t.Errorf("CreatedAt() = %v, want %v", event.CreatedAt(), tt.createdAt)
 			}
 			if tt.success && !event.UpdatedAt().Equal(tt.updatedAt) {
 				t.Errorf("UpdateAt() = %v, want %v", event.UpdatedAt(), tt.updatedAt)
 			}
+			if tt.success && !event.DeletedAt().Equal(tt.deletedAt) {
+				t.Errorf("DeletedAt() = %v, want %v", event.DeletedAt(), tt.deletedAt)
+			}
+			if tt.success && event.IsDeleted() != !tt.deletedAt.IsZero() {
+				t.Errorf("IsDeleted() = %v, want %v", event.IsDeleted(), !tt.deletedAt.IsZero())
+			}
 		})
 	}
 }
@@ -115,42 +162,311 @@ func TestUpdate(t *testing.T) {
 	if err != nil {
 		t.Errorf("failed to new updated color: %v", err)
 	}
-	event := NewEvent(id, userID, title, description, time.Now(), time.Now(), color, time.Now(), time.Now())
+	updatedRecurrence, err := NewRecurrence([]string{"RRULE:FREQ=DAILY;COUNT=5"})
+	if err != nil {
+		t.Errorf("failed to new updated recurrence: %v", err)
+	}
+	updatedTimeZone, err := NewTimeZone("Asia/Tokyo")
+	if err != nil {
+		t.Errorf("failed to new updated time zone: %v", err)
+	}
+	updatedTimeRange, err := NewTimeRange(time.Now().Add(1*time.Hour), time.Now().Add(2*time.Hour), DefaultMaxDuration)
+	if err != nil {
+		t.Errorf("failed to new updated time range: %v", err)
+	}
+	updatedCalendarID := calendar.NewCalendarID()
+	otherUserID := user.UserID{UUID: uuid.New()}
+	invitedAttendee, err := NewAttendee(otherUserID, "", AttendeeRoleRequired, ResponseStatusAccepted)
+	if err != nil {
+		t.Errorf("failed to new attendee: %v", err)
+	}
+	reinvitedAttendee, err := NewAttendee(otherUserID, "", AttendeeRoleOptional, ResponseStatusNeedsAction)
+	if err != nil {
+		t.Errorf("failed to new attendee: %v", err)
+	}
+	emailAttendee, err := NewAttendee(user.UserID{}, "guest@example.com", AttendeeRoleRequired, ResponseStatusNeedsAction)
+	if err != nil {
+		t.Errorf("failed to new attendee: %v", err)
+	}
+	reminder, err := NewReminder(10*time.Minute, ReminderChannelPopup)
+	if err != nil {
+		t.Errorf("failed to new reminder: %v", err)
+	}
+	tests := []struct {
+		name                   string
+		success                bool
+		editor                 user.UserID
+		updatedAttendees       []Attendee
+		updatedReminders       []Reminder
+		expectedResponseStatus ResponseStatus
+	}{
+		{"success update event", true, userID, []Attendee{reinvitedAttendee, emailAttendee}, []Reminder{reminder}, ResponseStatusAccepted},
+		{"failure not organizer", false, otherUserID, nil, nil, ""},
+		{"failure duplicate attendee", false, userID, []Attendee{invitedAttendee, reinvitedAttendee}, nil, ""},
+		{"failure duplicate reminder", false, userID, []Attendee{reinvitedAttendee}, []Reminder{reminder, reminder}, ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := NewEvent(id, userID, calendar.CalendarID{}, title, description, time.Now(), time.Now(), false, TimeZone{}, color, Recurrence{}, []Attendee{invitedAttendee}, nil, "", 1, time.Now(), time.Now(), time.Time{})
+
+			err := event.Update(tt.editor, updatedCalendarID, updatedTitle, updatedDescription, updatedTimeRange, updatedTimeZone, updatedColor, updatedRecurrence, tt.updatedAttendees, tt.updatedReminders)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if !tt.success && event.Version() != 1 {
+				t.Errorf("Version() = %v, want %v", event.Version(), 1)
+			}
+			if !tt.success {
+				return
+			}
+			if event.CalendarID() != updatedCalendarID {
+				t.Errorf("CalendarID() = %v, want %v", event.CalendarID(), updatedCalendarID)
+			}
+			if event.Title() != updatedTitle {
+				t.Errorf("Title() = %v, want %v", event.Title(), updatedTitle)
+			}
+			if event.Description() != updatedDescription {
+				t.Errorf("Description() = %v, want %v", event.Description(), updatedDescription)
+			}
+			if !event.StartTime().Equal(updatedTimeRange.Start()) {
+				t.Errorf("StartTime() = %v, want %v", event.StartTime(), updatedTimeRange.Start())
+			}
+			if !event.EndTime().Equal(updatedTimeRange.End()) {
+				t.Errorf("EndTime() = %v, want %v", event.EndTime(), updatedTimeRange.End())
+			}
+			if event.TimeZone() != updatedTimeZone {
+				t.Errorf("TimeZone() = %v, want %v", event.TimeZone(), updatedTimeZone)
+			}
+			if event.Color() != updatedColor {
+				t.Errorf("Color() = %v, want %v", event.Color(), updatedColor)
+			}
+			if event.Recurrence().String() != updatedRecurrence.String() {
+				t.Errorf("Recurrence() = %v, want %v", event.Recurrence(), updatedRecurrence)
+			}
+			if len(event.Attendees()) != len(tt.updatedAttendees) {
+				t.Fatalf("len(Attendees()) = %v, want %v", len(event.Attendees()), len(tt.updatedAttendees))
+			}
+			if event.Attendees()[0].Role() != AttendeeRoleOptional {
+				t.Errorf("Role() = %v, want %v", event.Attendees()[0].Role(), AttendeeRoleOptional)
+			}
+			if event.Attendees()[0].ResponseStatus() != tt.expectedResponseStatus {
+				t.Errorf("ResponseStatus() = %v, want %v", event.Attendees()[0].ResponseStatus(), tt.expectedResponseStatus)
+			}
+			if len(event.Reminders()) != len(tt.updatedReminders) {
+				t.Errorf("len(Reminders()) = %v, want %v", len(event.Reminders()), len(tt.updatedReminders))
+			}
+			if event.Version() != 2 {
+				t.Errorf("Version() = %v, want %v", event.Version(), 2)
+			}
+			if !event.CreatedAt().Before(event.UpdatedAt()) {
+				t.Errorf("CreatedAt() = %v, UpdatedAt() = %v, want CreatedAt < UpdatedAt", event.CreatedAt(), event.UpdatedAt())
+			}
+		})
+	}
+}
+
+func TestRespond(t *testing.T) {
+	t.Parallel()
+	organizerID := user.UserID{UUID: uuid.New()}
+	attendeeID := user.UserID{UUID: uuid.New()}
+	attendee, err := NewAttendee(attendeeID, "", AttendeeRoleRequired, ResponseStatusNeedsAction)
+	if err != nil {
+		t.Errorf("failed to new attendee: %v", err)
+	}
+	emailAttendee, err := NewAttendee(user.UserID{}, "guest@example.com", AttendeeRoleRequired, ResponseStatusNeedsAction)
+	if err != nil {
+		t.Errorf("failed to new attendee: %v", err)
+	}
 	tests := []struct {
-		name               string
-		success            bool
-		event              Event
-		updatedTitle       Title
-		updatedDescription Description
-		updatedStartTime   time.Time
-		updatedEndTime     time.Time
-		updatedColor       Color
+		name           string
+		success        bool
+		userID         user.UserID
+		responseStatus ResponseStatus
 	}{
-		{"success update event", true, event, updatedTitle, updatedDescription, time.Now().Add(1 * time.Hour), time.Now().Add(2 * time.Hour), updatedColor},
+		{"success accept", true, attendeeID, ResponseStatusAccepted},
+		{"success decline", true, attendeeID, ResponseStatusDeclined},
+		{"failure organizer is not an attendee", false, organizerID, ResponseStatusAccepted},
+		{"failure zero user id", false, user.UserID{}, ResponseStatusAccepted},
 	}
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			tt.event.Update(tt.updatedTitle, tt.updatedDescription, tt.updatedStartTime, tt.updatedEndTime, tt.updatedColor)
-			if tt.success && tt.event.Title() != tt.updatedTitle {
-				t.Errorf("Title() = %v, want %v", tt.event.Title(), tt.updatedTitle)
+			event := NewEvent(NewEventID(), organizerID, calendar.CalendarID{}, Title("title"), Description("description"), time.Now(), time.Now().Add(time.Hour), false, TimeZone{}, Color("#FFFFFF"), Recurrence{}, []Attendee{emailAttendee, attendee}, nil, "", 1, time.Now(), time.Now(), time.Time{})
+
+			err := event.Respond(tt.userID, tt.responseStatus)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
 			}
-			if tt.success && tt.event.Description() != tt.updatedDescription {
-				t.Errorf("Description() = %v, want %v", tt.event.Description(), tt.updatedDescription)
+			if tt.success && event.Attendees()[1].ResponseStatus() != tt.responseStatus {
+				t.Errorf("ResponseStatus() = %v, want %v", event.Attendees()[1].ResponseStatus(), tt.responseStatus)
 			}
-			if tt.success && !tt.event.StartTime().Equal(tt.updatedStartTime) {
-				t.Errorf("StartTime() = %v, want %v", tt.event.StartTime(), tt.updatedStartTime)
+			if tt.success && event.Attendees()[0].ResponseStatus() != ResponseStatusNeedsAction {
+				t.Errorf("ResponseStatus() = %v, want %v", event.Attendees()[0].ResponseStatus(), ResponseStatusNeedsAction)
 			}
-			if tt.success && !tt.event.EndTime().Equal(tt.updatedEndTime) {
-				t.Errorf("EndTime() = %v, want %v", tt.event.EndTime(), tt.updatedEndTime)
+			if tt.success && event.Version() != 2 {
+				t.Errorf("Version() = %v, want %v", event.Version(), 2)
 			}
-			if tt.success && tt.event.Color() != tt.updatedColor {
-				t.Errorf("Color() = %v, want %v", tt.event.Color(), tt.updatedColor)
+		})
+	}
+}
+
+func TestIsBusyFor(t *testing.T) {
+	t.Parallel()
+	organizerID := user.UserID{UUID: uuid.New()}
+	acceptedID := user.UserID{UUID: uuid.New()}
+	declinedID := user.UserID{UUID: uuid.New()}
+	accepted, err := NewAttendee(acceptedID, "", AttendeeRoleRequired, ResponseStatusAccepted)
+	if err != nil {
+		t.Errorf("failed to new attendee: %v", err)
+	}
+	declined, err := NewAttendee(declinedID, "", AttendeeRoleRequired, ResponseStatusDeclined)
+	if err != nil {
+		t.Errorf("failed to new attendee: %v", err)
+	}
+	tests := []struct {
+		name     string
+		userID   user.UserID
+		allDay   bool
+		expected bool
+	}{
+		{"organizer is busy", organizerID, false, true},
+		{"attendee who accepted is busy", acceptedID, false, true},
+		{"attendee who declined is free", declinedID, false, false},
+		{"stranger is free", user.UserID{UUID: uuid.New()}, false, false},
+		{"organizer of all-day event is busy", organizerID, true, true},
+		{"attendee who declined all-day event is free", declinedID, true, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			startTime := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+			event := NewEvent(NewEventID(), organizerID, calendar.CalendarID{}, Title("title"), Description("description"), startTime, startTime.Add(24*time.Hour), tt.allDay, TimeZone{}, Color("#FFFFFF"), Recurrence{}, []Attendee{accepted, declined}, nil, "", 1, time.Now(), time.Now(), time.Time{})
+
+			if got := event.IsBusyFor(tt.userID); got != tt.expected {
+				t.Errorf("IsBusyFor() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	t.Parallel()
+	id, err := NewEventIDFromString("fe8c2263-bbac-4bb9-a41d-b04f5afc4425")
+	if err != nil {
+		t.Errorf("failed to new event id: %v", err)
+	}
+	userID, err := user.NewUserIDFromString("6d322c66-bf4d-427a-970c-874f3745f653")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	weekly, err := NewRecurrence([]string{"RRULE:FREQ=WEEKLY;BYDAY=MO"})
+	if err != nil {
+		t.Errorf("failed to new recurrence: %v", err)
+	}
+	startTime := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(30 * time.Minute)
+	newYork, err := NewTimeZone("America/New_York")
+	if err != nil {
+		t.Errorf("failed to new time zone: %v", err)
+	}
+	beforeDST := time.Date(2025, 3, 3, 15, 0, 0, 0, time.UTC) // 10:00 EST
+	allDayStart := time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)
+	allDayEnd := allDayStart.AddDate(0, 0, 1)
+	tests := []struct {
+		name           string
+		success        bool
+		startTime      time.Time
+		endTime        time.Time
+		allDay         bool
+		timeZone       TimeZone
+		recurrence     Recurrence
+		from           time.Time
+		to             time.Time
+		expectedStarts []time.Time
+	}{
+		{"success single event in window", true, startTime, endTime, false, TimeZone{}, Recurrence{}, startTime.Add(-time.Hour), startTime.Add(time.Hour), []time.Time{startTime}},
+		{"success single event outside window", true, startTime, endTime, false, TimeZone{}, Recurrence{}, endTime, endTime.Add(time.Hour), nil},
+		{"success all-day event in window in a western zone", true, allDayStart, allDayEnd, true, TimeZone{}, Recurrence{}, allDayEnd.Add(5 * time.Hour), allDayEnd.Add(6 * time.Hour), []time.Time{allDayStart}},
+		{"success all-day event outside window", true, allDayStart, allDayEnd, true, TimeZone{}, Recurrence{}, allDayEnd.Add(13 * time.Hour), allDayEnd.Add(14 * time.Hour), nil},
+		{"success weekly event", true, startTime, endTime, false, TimeZone{}, weekly, startTime.AddDate(0, 0, 1), startTime.AddDate(0, 0, 15), []time.Time{startTime.AddDate(0, 0, 7), startTime.AddDate(0, 0, 14)}},
+		{"success weekly event keeps wall-clock time across dst", true, beforeDST, beforeDST.Add(30 * time.Minute), false, newYork, weekly, beforeDST, beforeDST.AddDate(0, 0, 8), []time.Time{beforeDST, beforeDST.AddDate(0, 0, 7).Add(-time.Hour)}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := NewEvent(id, userID, calendar.CalendarID{}, Title("title"), Description("description"), tt.startTime, tt.endTime, tt.allDay, tt.timeZone, Color("#FFFFFF"), tt.recurrence, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+
+			occurrences := event.Occurrences(tt.from, tt.to)
+			if tt.success && len(occurrences) != len(tt.expectedStarts) {
+				t.Fatalf("len(Occurrences()) = %v, want %v", len(occurrences), len(tt.expectedStarts))
+			}
+			for i, occurrence := range occurrences {
+				if tt.success && !occurrence.StartTime().Equal(tt.expectedStarts[i]) {
+					t.Errorf("StartTime() = %v, want %v", occurrence.StartTime(), tt.expectedStarts[i])
+				}
+				if tt.success && occurrence.EndTime().Sub(occurrence.StartTime()) != tt.endTime.Sub(tt.startTime) {
+					t.Errorf("duration = %v, want %v", occurrence.EndTime().Sub(occurrence.StartTime()), tt.endTime.Sub(tt.startTime))
+				}
+				if tt.success && occurrence.ID() != id {
+					t.Errorf("ID() = %v, want %v", occurrence.ID(), id)
+				}
+			}
+		})
+	}
+}
+
+func TestNextReminderTime(t *testing.T) {
+	t.Parallel()
+	startTime := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	daily, err := NewRecurrence([]string{"RRULE:FREQ=DAILY;COUNT=2"})
+	if err != nil {
+		t.Errorf("failed to new recurrence: %v", err)
+	}
+	reminder, err := NewReminder(15*time.Minute, ReminderChannelPopup)
+	if err != nil {
+		t.Errorf("failed to new reminder: %v", err)
+	}
+	tests := []struct {
+		name       string
+		recurrence Recurrence
+		after      time.Time
+		expected   time.Time
+		expectedOK bool
+	}{
+		{"before the reminder time", Recurrence{}, startTime.Add(-time.Hour), startTime.Add(-15 * time.Minute), true},
+		{"after the reminder time", Recurrence{}, startTime.Add(-10 * time.Minute), time.Time{}, false},
+		{"at the reminder time", Recurrence{}, startTime.Add(-15 * time.Minute), time.Time{}, false},
+		{"next occurrence of a recurring event", daily, startTime.Add(-15 * time.Minute), startTime.Add(24*time.Hour - 15*time.Minute), true},
+		{"after the last occurrence", daily, startTime.Add(24 * time.Hour), time.Time{}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := NewEvent(NewEventID(), user.UserID{UUID: uuid.New()}, calendar.CalendarID{}, Title("title"), Description("description"), startTime, startTime.Add(time.Hour), false, TimeZone{}, Color("#FFFFFF"), tt.recurrence, nil, []Reminder{reminder}, "", 1, time.Now(), time.Now(), time.Time{})
+
+			next, ok := event.NextReminderTime(reminder, tt.after)
+			if ok != tt.expectedOK {
+				t.Fatalf("NextReminderTime() ok = %v, want %v", ok, tt.expectedOK)
 			}
-			if tt.success && !tt.event.CreatedAt().Before(tt.event.UpdatedAt()) {
-				t.Errorf("CreatedAt() = %v, UpdatedAt() = %v, want CreatedAt < UpdatedAt", tt.event.CreatedAt(), tt.event.UpdatedAt())
+			if !next.Equal(tt.expected) {
+				t.Errorf("NextReminderTime() = %v, want %v", next, tt.expected)
 			}
 		})
 	}
@@ -0,0 +1,81 @@
+package event
+
+import (
+	"sort"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+// Interval is the half-open span of time [Start, End).
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// BusyIntervals returns when events keep userID busy within [from, to). The
+// intervals are sorted and merged, so none of them overlap or touch, and
+// reveal nothing else about the events.
+func BusyIntervals(events []Event, userID user.UserID, from, to time.Time) []Interval {
+	var intervals []Interval
+	for _, e := range events {
+		if !e.IsBusyFor(userID) {
+			continue
+		}
+
+		span := busySpan(e)
+		start, end := span.Start, span.End
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if !start.Before(end) {
+			continue
+		}
+		intervals = append(intervals, Interval{Start: start, End: end})
+	}
+
+	return mergeIntervals(intervals)
+}
+
+// busySpan returns when e takes up time. All-day events take up their dates
+// from midnight to midnight in the event's time zone.
+func busySpan(e Event) Interval {
+	if !e.AllDay() {
+		return Interval{Start: e.StartTime(), End: e.EndTime()}
+	}
+
+	location := e.TimeZone().Location()
+	return Interval{Start: dateIn(e.StartTime(), location), End: dateIn(e.EndTime(), location)}
+}
+
+// dateIn returns midnight in location of the date stored in d.
+func dateIn(d time.Time, location *time.Location) time.Time {
+	d = d.UTC()
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, location)
+}
+
+// mergeIntervals sorts intervals and merges the ones that overlap or touch.
+func mergeIntervals(intervals []Interval) []Interval {
+	sorted := make([]Interval, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	var merged []Interval
+	for _, interval := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && !interval.Start.After(merged[last].End) {
+			if interval.End.After(merged[last].End) {
+				merged[last].End = interval.End
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+
+	return merged
+}
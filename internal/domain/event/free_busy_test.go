@@ -0,0 +1,89 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestBusyIntervals(t *testing.T) {
+	t.Parallel()
+	userID := user.UserID{UUID: uuid.New()}
+	from := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 1, 6, hour, minute, 0, 0, time.UTC)
+	}
+	newEvent := func(ownerID user.UserID, start, end time.Time, allDay bool) Event {
+		return NewEvent(NewEventID(), ownerID, calendar.CalendarID{}, Title("title"), Description("description"), start, end, allDay, TimeZone{}, Color("#FFFFFF"), Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+	}
+	tokyo, err := NewTimeZone("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to new time zone: %v", err)
+	}
+	newAllDayEvent := func(timeZone TimeZone) Event {
+		return NewEvent(NewEventID(), userID, calendar.CalendarID{}, Title("title"), Description("description"), time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC), true, timeZone, Color("#FFFFFF"), Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+	}
+	tests := []struct {
+		name     string
+		events   []Event
+		expected []Interval
+	}{
+		{"no events", nil, nil},
+		{
+			"overlapping and touching events are merged",
+			[]Event{newEvent(userID, at(11, 0), at(12, 0), false), newEvent(userID, at(10, 0), at(11, 0), false), newEvent(userID, at(10, 30), at(10, 45), false)},
+			[]Interval{{at(10, 0), at(12, 0)}},
+		},
+		{
+			"separate events stay separate",
+			[]Event{newEvent(userID, at(13, 0), at(14, 0), false), newEvent(userID, at(10, 0), at(11, 0), false)},
+			[]Interval{{at(10, 0), at(11, 0)}, {at(13, 0), at(14, 0)}},
+		},
+		{
+			"events are clipped to the window",
+			[]Event{newEvent(userID, at(8, 0), at(10, 0), false), newEvent(userID, at(17, 0), at(19, 0), false)},
+			[]Interval{{at(9, 0), at(10, 0)}, {at(17, 0), at(18, 0)}},
+		},
+		{
+			"events outside the window are skipped",
+			[]Event{newEvent(userID, at(7, 0), at(9, 0), false)},
+			nil,
+		},
+		{
+			"events that do not keep the user busy are skipped",
+			[]Event{newEvent(user.UserID{UUID: uuid.New()}, at(10, 0), at(11, 0), false)},
+			nil,
+		},
+		{
+			"all-day events take up their whole dates",
+			[]Event{newAllDayEvent(TimeZone{})},
+			[]Interval{{at(9, 0), at(18, 0)}},
+		},
+		{
+			"all-day dates are in the event's time zone",
+			[]Event{newAllDayEvent(tokyo)},
+			[]Interval{{at(9, 0), at(15, 0)}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			intervals := BusyIntervals(tt.events, userID, from, to)
+			if len(intervals) != len(tt.expected) {
+				t.Fatalf("len(BusyIntervals()) = %d, want %d", len(intervals), len(tt.expected))
+			}
+			for i := range tt.expected {
+				if !intervals[i].Start.Equal(tt.expected[i].Start) || !intervals[i].End.Equal(tt.expected[i].End) {
+					t.Errorf("intervals[%d] = %v, want %v", i, intervals[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
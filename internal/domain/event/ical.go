@@ -0,0 +1,600 @@
+package event
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	iCalendarProductID = "-//qkitzero//event-service//EN"
+	iCalendarUIDDomain = "event-service"
+
+	iCalendarDateLayout     = "20060102"
+	iCalendarDateTimeLayout = "20060102T150405"
+	iCalendarUTCLayout      = "20060102T150405Z"
+
+	// RFC 5545 section 3.1: lines should not be longer than 75 octets.
+	iCalendarMaxLineOctets = 75
+)
+
+// windowsTimeZones maps the Windows zone names that Outlook and Exchange
+// write as TZID to IANA zones, following the CLDR default for each.
+var windowsTimeZones = map[string]string{
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"Mountain Standard Time":          "America/Denver",
+	"Central America Standard Time":   "America/Guatemala",
+	"Central Standard Time":           "America/Chicago",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Canada Central Standard Time":    "America/Regina",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"Eastern Standard Time":           "America/New_York",
+	"US Eastern Standard Time":        "America/Indiana/Indianapolis",
+	"Atlantic Standard Time":          "America/Halifax",
+	"SA Western Standard Time":        "America/La_Paz",
+	"Pacific SA Standard Time":        "America/Santiago",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"Argentina Standard Time":         "America/Argentina/Buenos_Aires",
+	"GMT Standard Time":               "Europe/London",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Romance Standard Time":           "Europe/Paris",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"W. Central Africa Standard Time": "Africa/Lagos",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"FLE Standard Time":               "Europe/Kiev",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Arab Standard Time":              "Asia/Riyadh",
+	"Russian Standard Time":           "Europe/Moscow",
+	"E. Africa Standard Time":         "Africa/Nairobi",
+	"Iran Standard Time":              "Asia/Tehran",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"India Standard Time":             "Asia/Kolkata",
+	"Nepal Standard Time":             "Asia/Kathmandu",
+	"Bangladesh Standard Time":        "Asia/Dhaka",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"China Standard Time":             "Asia/Shanghai",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"W. Australia Standard Time":      "Australia/Perth",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"Korea Standard Time":             "Asia/Seoul",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+}
+
+var iCalendarDurationRegexp = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// ICalendarEvent is a VEVENT component read from an iCalendar object. Err is
+// set when the component cannot be turned into an event; the other fields
+// are then incomplete.
+type ICalendarEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Color       string
+	StartTime   time.Time
+	EndTime     time.Time
+	AllDay      bool
+	TimeZone    string
+	Recurrence  []string
+	Err         error
+}
+
+type iCalendarProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// NewUID returns the iCalendar UID of the event with the given ID. UIDs are
+// derived from the event ID so that repeated exports are stable.
+func NewUID(id EventID) string {
+	return id.String() + "@" + iCalendarUIDDomain
+}
+
+// MarshalICalendar serializes events into an RFC 5545 VCALENDAR object.
+// Timed events in a zone other than UTC are written with a TZID parameter
+// naming their IANA time zone, which is described by a VTIMEZONE component
+// for clients that do not know it.
+func MarshalICalendar(events []Event) []byte {
+	var b strings.Builder
+	writeICalendarLine(&b, "BEGIN:VCALENDAR")
+	writeICalendarLine(&b, "VERSION:2.0")
+	writeICalendarLine(&b, "PRODID:"+iCalendarProductID)
+	writeICalendarLine(&b, "CALSCALE:GREGORIAN")
+
+	for _, tz := range usedTimeZones(events) {
+		writeICalendarTimeZone(&b, tz.timeZone, tz.from, tz.to)
+	}
+
+	for _, e := range events {
+		writeICalendarLine(&b, "BEGIN:VEVENT")
+		writeICalendarLine(&b, "UID:"+e.ICalUID())
+		writeICalendarLine(&b, "DTSTAMP:"+e.UpdatedAt().UTC().Format(iCalendarUTCLayout))
+		writeICalendarLine(&b, "DTSTART"+formatICalendarTime(e.StartTime(), e.AllDay(), e.TimeZone()))
+		writeICalendarLine(&b, "DTEND"+formatICalendarTime(e.EndTime(), e.AllDay(), e.TimeZone()))
+		writeICalendarLine(&b, "SUMMARY:"+escapeICalendarText(e.Title().String()))
+		if e.Description() != "" {
+			writeICalendarLine(&b, "DESCRIPTION:"+escapeICalendarText(e.Description().String()))
+		}
+		for _, line := range formatICalendarRecurrence(e.Recurrence(), e.AllDay(), e.TimeZone()) {
+			writeICalendarLine(&b, line)
+		}
+		writeICalendarLine(&b, "SEQUENCE:"+formatSequence(e.Version()))
+		writeICalendarLine(&b, "CREATED:"+e.CreatedAt().UTC().Format(iCalendarUTCLayout))
+		writeICalendarLine(&b, "LAST-MODIFIED:"+e.UpdatedAt().UTC().Format(iCalendarUTCLayout))
+		writeICalendarLine(&b, "END:VEVENT")
+	}
+
+	writeICalendarLine(&b, "END:VCALENDAR")
+
+	return []byte(b.String())
+}
+
+// formatICalendarTime returns the parameters and value of a DTSTART or DTEND
+// property, starting with ";" or ":".
+func formatICalendarTime(t time.Time, allDay bool, timeZone TimeZone) string {
+	if allDay {
+		return ";VALUE=DATE:" + t.UTC().Format(iCalendarDateLayout)
+	}
+
+	if timeZone.String() == "UTC" {
+		return ":" + t.UTC().Format(iCalendarUTCLayout)
+	}
+
+	return ";TZID=" + timeZone.String() + ":" + t.In(timeZone.Location()).Format(iCalendarDateTimeLayout)
+}
+
+// formatICalendarRecurrence returns the RRULE and EXDATE lines of an event.
+// Excluded dates take the value type and zone of DTSTART, as RFC 5545
+// recommends.
+func formatICalendarRecurrence(r Recurrence, allDay bool, timeZone TimeZone) []string {
+	lines := r.Lines()
+	if len(r.ExDates()) == 0 {
+		return lines
+	}
+
+	var params string
+	values := make([]string, 0, len(r.ExDates()))
+	for _, d := range r.ExDates() {
+		var value string
+		params, value, _ = strings.Cut(formatICalendarTime(d, allDay, timeZone), ":")
+		values = append(values, value)
+	}
+
+	return append(lines[:1], "EXDATE"+params+":"+strings.Join(values, ","))
+}
+
+type iCalendarTimeZone struct {
+	timeZone TimeZone
+	from, to int
+}
+
+// usedTimeZones returns the zones named by TZID parameters in events, in the
+// order they first appear, with the years their events start in.
+func usedTimeZones(events []Event) []iCalendarTimeZone {
+	var zones []iCalendarTimeZone
+	index := make(map[string]int)
+	for _, e := range events {
+		if e.AllDay() || e.TimeZone().String() == "UTC" {
+			continue
+		}
+		year := e.StartTime().In(e.TimeZone().Location()).Year()
+		i, ok := index[e.TimeZone().String()]
+		if !ok {
+			index[e.TimeZone().String()] = len(zones)
+			zones = append(zones, iCalendarTimeZone{timeZone: e.TimeZone(), from: year, to: year})
+			continue
+		}
+		zones[i].from = min(zones[i].from, year)
+		zones[i].to = max(zones[i].to, year)
+	}
+	return zones
+}
+
+// writeICalendarTimeZone writes a VTIMEZONE component listing the UTC offset
+// of timeZone at the start of year from and every change of it until the end
+// of the year after to, so that the first occurrences of recurring events
+// are covered too.
+func writeICalendarTimeZone(b *strings.Builder, timeZone TimeZone, from, to int) {
+	location := timeZone.Location()
+	t := time.Date(from, time.January, 1, 0, 0, 0, 0, location)
+	end := time.Date(to+2, time.January, 1, 0, 0, 0, 0, location)
+
+	writeICalendarLine(b, "BEGIN:VTIMEZONE")
+	writeICalendarLine(b, "TZID:"+timeZone.String())
+
+	name, offset := t.Zone()
+	writeICalendarTimeZoneRule(b, t, t.IsDST(), name, offset, offset)
+	for {
+		_, next := t.ZoneBounds()
+		if next.IsZero() || !next.Before(end) {
+			break
+		}
+		nextName, nextOffset := next.Zone()
+		// DTSTART is the local time the change happens at, before it.
+		onset := next.In(time.FixedZone(name, offset))
+		writeICalendarTimeZoneRule(b, onset, next.IsDST(), nextName, offset, nextOffset)
+		t, name, offset = next, nextName, nextOffset
+	}
+
+	writeICalendarLine(b, "END:VTIMEZONE")
+}
+
+func writeICalendarTimeZoneRule(b *strings.Builder, onset time.Time, dst bool, name string, offsetFrom, offsetTo int) {
+	component := "STANDARD"
+	if dst {
+		component = "DAYLIGHT"
+	}
+	writeICalendarLine(b, "BEGIN:"+component)
+	writeICalendarLine(b, "DTSTART:"+onset.Format(iCalendarDateTimeLayout))
+	writeICalendarLine(b, "TZOFFSETFROM:"+formatUTCOffset(offsetFrom))
+	writeICalendarLine(b, "TZOFFSETTO:"+formatUTCOffset(offsetTo))
+	writeICalendarLine(b, "TZNAME:"+escapeICalendarText(name))
+	writeICalendarLine(b, "END:"+component)
+}
+
+// formatUTCOffset formats seconds east of UTC as an RFC 5545 UTC-OFFSET.
+func formatUTCOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+	return s
+}
+
+// formatSequence maps the event version, which starts at 1, to an iCalendar
+// SEQUENCE, which starts at 0.
+func formatSequence(version int64) string {
+	if version < 1 {
+		version = 1
+	}
+	return strconv.FormatInt(version-1, 10)
+}
+
+func escapeICalendarText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeICalendarLine writes a content line terminated by CRLF, folding it so
+// that no physical line exceeds 75 octets without splitting a UTF-8 sequence.
+func writeICalendarLine(b *strings.Builder, line string) {
+	limit := iCalendarMaxLineOctets
+	for len(line) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		b.WriteString(line[:i])
+		b.WriteString("\r\n ")
+		line = line[i:]
+		// Continuation lines begin with a space, which counts towards the limit.
+		limit = iCalendarMaxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// ParseICalendar reads the VEVENT components of an RFC 5545 VCALENDAR
+// object. Problems with a single component are reported through its Err
+// field; an error is returned only when data is not an iCalendar object.
+func ParseICalendar(data []byte) ([]ICalendarEvent, error) {
+	var events []ICalendarEvent
+	var stack []string
+	var props []iCalendarProperty
+	sawCalendar := false
+
+	for _, line := range unfoldICalendarLines(string(data)) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		prop, err := parseICalendarProperty(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch prop.name {
+		case "BEGIN":
+			name := strings.ToUpper(prop.value)
+			if len(stack) == 0 {
+				if name != "VCALENDAR" {
+					return nil, fmt.Errorf("%w: expected BEGIN:VCALENDAR, got BEGIN:%s", ErrInvalidICalendar, name)
+				}
+				sawCalendar = true
+			}
+			stack = append(stack, name)
+			if len(stack) == 2 && name == "VEVENT" {
+				props = nil
+			}
+		case "END":
+			name := strings.ToUpper(prop.value)
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				return nil, fmt.Errorf("%w: unexpected END:%s", ErrInvalidICalendar, name)
+			}
+			if len(stack) == 2 && name == "VEVENT" {
+				events = append(events, newICalendarEvent(props))
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			// Properties of nested components such as VALARM are skipped.
+			if len(stack) == 2 && stack[1] == "VEVENT" {
+				props = append(props, prop)
+			}
+		}
+	}
+
+	if !sawCalendar {
+		return nil, fmt.Errorf("%w: missing VCALENDAR", ErrInvalidICalendar)
+	}
+	if len(stack) != 0 {
+		return nil, fmt.Errorf("%w: missing END:%s", ErrInvalidICalendar, stack[len(stack)-1])
+	}
+
+	return events, nil
+}
+
+func newICalendarEvent(props []iCalendarProperty) ICalendarEvent {
+	var e ICalendarEvent
+	var start, end, duration *iCalendarProperty
+	var exDateProps []iCalendarProperty
+
+	for i := range props {
+		prop := &props[i]
+		switch prop.name {
+		case "UID":
+			e.UID = prop.value
+		case "SUMMARY":
+			e.Summary = unescapeICalendarText(prop.value)
+		case "DESCRIPTION":
+			e.Description = unescapeICalendarText(prop.value)
+		case "COLOR":
+			// RFC 7986 colors are CSS color names, which events cannot store.
+			if _, err := NewColor(prop.value); err == nil {
+				e.Color = prop.value
+			}
+		case "DTSTART":
+			start = prop
+		case "DTEND":
+			end = prop
+		case "DURATION":
+			duration = prop
+		case "RRULE", "RDATE", "EXRULE":
+			e.Recurrence = append(e.Recurrence, prop.name+":"+prop.value)
+		case "EXDATE":
+			exDateProps = append(exDateProps, *prop)
+		case "RECURRENCE-ID":
+			e.Err = fmt.Errorf("%w: overrides of single occurrences are not supported", ErrInvalidICalendar)
+			return e
+		}
+	}
+
+	if e.UID == "" {
+		e.Err = fmt.Errorf("%w: VEVENT is missing UID", ErrInvalidICalendar)
+		return e
+	}
+	if start == nil {
+		e.Err = ErrStartTimeRequired
+		return e
+	}
+
+	startTime, allDay, timeZone, err := parseICalendarTime(*start)
+	if err != nil {
+		e.Err = err
+		return e
+	}
+	e.StartTime, e.AllDay, e.TimeZone = startTime, allDay, timeZone
+
+	exDates, err := parseICalendarExDates(exDateProps, timeZone)
+	if err != nil {
+		e.Err = err
+		return e
+	}
+	if len(exDates) > 0 {
+		e.Recurrence = append(e.Recurrence, "EXDATE:"+strings.Join(exDates, ","))
+	}
+
+	switch {
+	case end != nil:
+		endTime, endAllDay, _, err := parseICalendarTime(*end)
+		if err != nil {
+			e.Err = err
+			return e
+		}
+		if endAllDay != allDay {
+			e.Err = ErrInvalidAllDayRange
+			return e
+		}
+		e.EndTime = endTime
+	case duration != nil:
+		d, err := parseICalendarDuration(duration.value)
+		if err != nil {
+			e.Err = err
+			return e
+		}
+		e.EndTime = startTime.Add(d)
+	case allDay:
+		// RFC 5545 section 3.6.1: a date-only event without an end lasts one day.
+		e.EndTime = startTime.AddDate(0, 0, 1)
+	default:
+		e.EndTime = startTime
+	}
+
+	return e
+}
+
+// parseICalendarExDates parses EXDATE properties into recurrence values.
+// Floating times are read in timeZone, the zone of the event's DTSTART.
+func parseICalendarExDates(props []iCalendarProperty, timeZone string) ([]string, error) {
+	var exDates []string
+	for _, prop := range props {
+		params := prop.params
+		if params["TZID"] == "" && timeZone != "" {
+			params = map[string]string{"VALUE": prop.params["VALUE"], "TZID": timeZone}
+		}
+		for _, v := range strings.Split(prop.value, ",") {
+			t, allDay, _, err := parseICalendarTime(iCalendarProperty{name: prop.name, params: params, value: v})
+			if err != nil {
+				return nil, err
+			}
+			if allDay {
+				exDates = append(exDates, t.Format(iCalendarDateLayout))
+			} else {
+				exDates = append(exDates, t.UTC().Format(iCalendarUTCLayout))
+			}
+		}
+	}
+	return exDates, nil
+}
+
+// parseICalendarTime parses a DATE or DATE-TIME property value. It reports
+// whether the value is a date and the IANA zone named by its TZID parameter,
+// which may also be a Windows zone name. Floating times are read as UTC.
+func parseICalendarTime(prop iCalendarProperty) (time.Time, bool, string, error) {
+	value := strings.TrimSpace(prop.value)
+
+	if prop.params["VALUE"] == "DATE" || len(value) == len(iCalendarDateLayout) {
+		t, err := time.Parse(iCalendarDateLayout, value)
+		if err != nil {
+			return time.Time{}, false, "", fmt.Errorf("%w: invalid %s %q", ErrInvalidICalendar, prop.name, value)
+		}
+		return t, true, "", nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(iCalendarUTCLayout, value)
+		if err != nil {
+			return time.Time{}, false, "", fmt.Errorf("%w: invalid %s %q", ErrInvalidICalendar, prop.name, value)
+		}
+		return t, false, "", nil
+	}
+
+	tzid := prop.params["TZID"]
+	if name, ok := windowsTimeZones[tzid]; ok {
+		tzid = name
+	}
+
+	timeZone, err := NewTimeZone(tzid)
+	if err != nil {
+		return time.Time{}, false, "", err
+	}
+
+	t, err := time.ParseInLocation(iCalendarDateTimeLayout, value, timeZone.Location())
+	if err != nil {
+		return time.Time{}, false, "", fmt.Errorf("%w: invalid %s %q", ErrInvalidICalendar, prop.name, value)
+	}
+
+	return t, false, tzid, nil
+}
+
+func parseICalendarDuration(s string) (time.Duration, error) {
+	m := iCalendarDurationRegexp.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("%w: invalid DURATION %q", ErrInvalidICalendar, s)
+	}
+
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid DURATION %q", ErrInvalidICalendar, s)
+		}
+		d += time.Duration(n) * unit
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// parseICalendarProperty splits a content line into its name, parameters and
+// value. Colons and semicolons inside quoted parameter values are preserved.
+func parseICalendarProperty(line string) (iCalendarProperty, error) {
+	var parts []string
+	quoted := false
+	last := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				parts = append(parts, line[last:i])
+				last = i + 1
+			}
+		case ':':
+			if quoted {
+				continue
+			}
+			parts = append(parts, line[last:i])
+
+			prop := iCalendarProperty{
+				name:   strings.ToUpper(parts[0]),
+				params: make(map[string]string, len(parts)-1),
+				value:  line[i+1:],
+			}
+			for _, param := range parts[1:] {
+				key, value, _ := strings.Cut(param, "=")
+				prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+			}
+			return prop, nil
+		}
+	}
+
+	return iCalendarProperty{}, fmt.Errorf("%w: invalid content line %q", ErrInvalidICalendar, line)
+}
+
+func unfoldICalendarLines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\n ", "")
+	s = strings.ReplaceAll(s, "\n\t", "")
+	return strings.Split(s, "\n")
+}
+
+func unescapeICalendarText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,374 @@
+package event
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestMarshalICalendar(t *testing.T) {
+	t.Parallel()
+	id, err := NewEventIDFromString("fe8c2263-bbac-4bb9-a41d-b04f5afc4425")
+	if err != nil {
+		t.Errorf("failed to new event id: %v", err)
+	}
+	userID, err := user.NewUserIDFromString("6d322c66-bf4d-427a-970c-874f3745f653")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	tokyo, err := NewTimeZone("Asia/Tokyo")
+	if err != nil {
+		t.Errorf("failed to new time zone: %v", err)
+	}
+	newYork, err := NewTimeZone("America/New_York")
+	if err != nil {
+		t.Errorf("failed to new time zone: %v", err)
+	}
+	weekly, err := NewRecurrence([]string{"RRULE:FREQ=WEEKLY;BYDAY=MO"})
+	if err != nil {
+		t.Errorf("failed to new recurrence: %v", err)
+	}
+	weeklyWithExDate, err := NewRecurrence([]string{"RRULE:FREQ=WEEKLY;BYDAY=MO", "EXDATE:20250113T000000Z"})
+	if err != nil {
+		t.Errorf("failed to new recurrence: %v", err)
+	}
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name             string
+		event            Event
+		expectedLines    []string
+		notExpectedLines []string
+	}{
+		{
+			"success utc event",
+			NewEvent(id, userID, calendar.CalendarID{}, Title("title"), Description("description"), start, start.Add(time.Hour), false, TimeZone{}, Color("#FFFFFF"), Recurrence{}, nil, nil, "", 2, createdAt, updatedAt, time.Time{}),
+			[]string{
+				"UID:fe8c2263-bbac-4bb9-a41d-b04f5afc4425@event-service",
+				"DTSTAMP:20250102T030405Z",
+				"DTSTART:20250106T000000Z",
+				"DTEND:20250106T010000Z",
+				"SUMMARY:title",
+				"DESCRIPTION:description",
+				"SEQUENCE:1",
+				"CREATED:20250101T000000Z",
+				"LAST-MODIFIED:20250102T030405Z",
+			},
+			[]string{"RRULE:", "BEGIN:VTIMEZONE"},
+		},
+		{
+			"success zoned recurring event",
+			NewEvent(id, userID, calendar.CalendarID{}, Title("title"), Description(""), start, start.Add(time.Hour), false, tokyo, Color("#FFFFFF"), weekly, nil, nil, "", 1, createdAt, updatedAt, time.Time{}),
+			[]string{
+				"DTSTART;TZID=Asia/Tokyo:20250106T090000",
+				"DTEND;TZID=Asia/Tokyo:20250106T100000",
+				"RRULE:FREQ=WEEKLY;BYDAY=MO",
+				"SEQUENCE:0",
+				"BEGIN:VTIMEZONE",
+				"TZID:Asia/Tokyo",
+				"TZOFFSETTO:+0900",
+			},
+			[]string{"DESCRIPTION:", "BEGIN:DAYLIGHT"},
+		},
+		{
+			"success utc event with exdate",
+			NewEvent(id, userID, calendar.CalendarID{}, Title("title"), Description(""), start, start.Add(time.Hour), false, TimeZone{}, Color("#FFFFFF"), weeklyWithExDate, nil, nil, "", 1, createdAt, updatedAt, time.Time{}),
+			[]string{
+				"RRULE:FREQ=WEEKLY;BYDAY=MO",
+				"EXDATE:20250113T000000Z",
+			},
+			nil,
+		},
+		{
+			"success zoned event with exdate",
+			NewEvent(id, userID, calendar.CalendarID{}, Title("title"), Description(""), start, start.Add(time.Hour), false, tokyo, Color("#FFFFFF"), weeklyWithExDate, nil, nil, "", 1, createdAt, updatedAt, time.Time{}),
+			[]string{
+				"EXDATE;TZID=Asia/Tokyo:20250113T090000",
+			},
+			nil,
+		},
+		{
+			"success zone with daylight saving time",
+			NewEvent(id, userID, calendar.CalendarID{}, Title("title"), Description(""), start, start.Add(time.Hour), false, newYork, Color("#FFFFFF"), Recurrence{}, nil, nil, "", 1, createdAt, updatedAt, time.Time{}),
+			[]string{
+				"DTSTART;TZID=America/New_York:20250105T190000",
+				"TZID:America/New_York",
+				"BEGIN:DAYLIGHT",
+				"DTSTART:20250309T020000",
+				"TZOFFSETFROM:-0500",
+				"TZOFFSETTO:-0400",
+				"TZNAME:EDT",
+				"BEGIN:STANDARD",
+				"DTSTART:20251102T020000",
+				"TZNAME:EST",
+			},
+			nil,
+		},
+		{
+			"success all day event",
+			NewEvent(id, userID, calendar.CalendarID{}, Title("title"), Description(""), start, start.Add(24*time.Hour), true, tokyo, Color("#FFFFFF"), Recurrence{}, nil, nil, "", 1, createdAt, updatedAt, time.Time{}),
+			[]string{
+				"DTSTART;VALUE=DATE:20250106",
+				"DTEND;VALUE=DATE:20250107",
+			},
+			[]string{"BEGIN:VTIMEZONE"},
+		},
+		{
+			"success all day event with exdate",
+			NewEvent(id, userID, calendar.CalendarID{}, Title("title"), Description(""), start, start.Add(24*time.Hour), true, tokyo, Color("#FFFFFF"), weeklyWithExDate, nil, nil, "", 1, createdAt, updatedAt, time.Time{}),
+			[]string{
+				"EXDATE;VALUE=DATE:20250113",
+			},
+			nil,
+		},
+		{
+			"success escaped text",
+			NewEvent(id, userID, calendar.CalendarID{}, Title(`a,b;c\d`), Description("line1\nline2"), start, start.Add(time.Hour), false, TimeZone{}, Color("#FFFFFF"), Recurrence{}, nil, nil, "", 1, createdAt, updatedAt, time.Time{}),
+			[]string{
+				`SUMMARY:a\,b\;c\\d`,
+				`DESCRIPTION:line1\nline2`,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := string(MarshalICalendar([]Event{tt.event}))
+
+			if !strings.HasPrefix(data, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n") {
+				t.Errorf("MarshalICalendar() does not start with a VCALENDAR header: %q", data)
+			}
+			if !strings.HasSuffix(data, "END:VEVENT\r\nEND:VCALENDAR\r\n") {
+				t.Errorf("MarshalICalendar() does not end with END:VCALENDAR: %q", data)
+			}
+			lines := strings.Split(data, "\r\n")
+			for _, want := range tt.expectedLines {
+				if !slices.Contains(lines, want) {
+					t.Errorf("MarshalICalendar() is missing line %q in %q", want, data)
+				}
+			}
+			for _, prefix := range tt.notExpectedLines {
+				for _, line := range lines {
+					if strings.HasPrefix(line, prefix) {
+						t.Errorf("MarshalICalendar() has unexpected line %q", line)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestWriteICalendarLine(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"success short line", "SUMMARY:title"},
+		{"success long ascii line", "DESCRIPTION:" + strings.Repeat("a", 200)},
+		{"success long multibyte line", "DESCRIPTION:" + strings.Repeat("あ", 100)},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			writeICalendarLine(&b, tt.line)
+
+			physical := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+			for i, line := range physical {
+				if len(line) > iCalendarMaxLineOctets {
+					t.Errorf("line %d has %d octets, want at most %d", i, len(line), iCalendarMaxLineOctets)
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d does not start with a space: %q", i, line)
+				}
+			}
+
+			unfolded := strings.ReplaceAll(strings.TrimSuffix(b.String(), "\r\n"), "\r\n ", "")
+			if unfolded != tt.line {
+				t.Errorf("unfolded line = %q, want %q", unfolded, tt.line)
+			}
+		})
+	}
+}
+
+func TestParseICalendar(t *testing.T) {
+	t.Parallel()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Errorf("failed to load location: %v", err)
+	}
+	wrap := func(lines ...string) []byte {
+		return []byte(strings.Join(append(append([]string{"BEGIN:VCALENDAR", "VERSION:2.0"}, lines...), "END:VCALENDAR"), "\r\n"))
+	}
+	tests := []struct {
+		name           string
+		success        bool
+		data           []byte
+		expectedEvents []ICalendarEvent
+	}{
+		{
+			"success utc event",
+			true,
+			wrap("BEGIN:VEVENT", "UID:a@example.com", "SUMMARY:Weekly\\, sync", "DESCRIPTION:line1\\nline2", "DTSTART:20250106T090000Z", "DTEND:20250106T100000Z", "RRULE:FREQ=WEEKLY;BYDAY=MO", "END:VEVENT"),
+			[]ICalendarEvent{{UID: "a@example.com", Summary: "Weekly, sync", Description: "line1\nline2", StartTime: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC), Recurrence: []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}}},
+		},
+		{
+			"success zoned event with duration and exdate",
+			true,
+			wrap("BEGIN:VEVENT", "UID:b@example.com", "SUMMARY:title", "DTSTART;TZID=Asia/Tokyo:20250106T090000", "DURATION:PT1H30M", "RRULE:FREQ=DAILY", "EXDATE;TZID=Asia/Tokyo:20250107T090000", "END:VEVENT"),
+			[]ICalendarEvent{{UID: "b@example.com", Summary: "title", StartTime: time.Date(2025, 1, 6, 9, 0, 0, 0, tokyo), EndTime: time.Date(2025, 1, 6, 10, 30, 0, 0, tokyo), TimeZone: "Asia/Tokyo", Recurrence: []string{"RRULE:FREQ=DAILY", "EXDATE:20250107T000000Z"}}},
+		},
+		{
+			"success floating exdate in the event's zone",
+			true,
+			wrap("BEGIN:VEVENT", "UID:f@example.com", "DTSTART;TZID=Asia/Tokyo:20250106T090000", "DTEND;TZID=Asia/Tokyo:20250106T100000", "RRULE:FREQ=DAILY", "EXDATE:20250107T090000,20250108T090000", "END:VEVENT"),
+			[]ICalendarEvent{{UID: "f@example.com", StartTime: time.Date(2025, 1, 6, 9, 0, 0, 0, tokyo), EndTime: time.Date(2025, 1, 6, 10, 0, 0, 0, tokyo), TimeZone: "Asia/Tokyo", Recurrence: []string{"RRULE:FREQ=DAILY", "EXDATE:20250107T000000Z,20250108T000000Z"}}},
+		},
+		{
+			"success windows time zone",
+			true,
+			wrap("BEGIN:VTIMEZONE", "TZID:Tokyo Standard Time", "BEGIN:STANDARD", "DTSTART:16010101T000000", "TZOFFSETFROM:+0900", "TZOFFSETTO:+0900", "END:STANDARD", "END:VTIMEZONE", "BEGIN:VEVENT", "UID:g@example.com", "DTSTART;TZID=Tokyo Standard Time:20250106T090000", "DTEND;TZID=Tokyo Standard Time:20250106T100000", "END:VEVENT"),
+			[]ICalendarEvent{{UID: "g@example.com", StartTime: time.Date(2025, 1, 6, 9, 0, 0, 0, tokyo), EndTime: time.Date(2025, 1, 6, 10, 0, 0, 0, tokyo), TimeZone: "Asia/Tokyo"}},
+		},
+		{
+			"success all day event without end",
+			true,
+			wrap("BEGIN:VEVENT", "UID:c@example.com", "SUMMARY:holiday", "DTSTART;VALUE=DATE:20250101", "COLOR:#FF0000", "END:VEVENT"),
+			[]ICalendarEvent{{UID: "c@example.com", Summary: "holiday", Color: "#FF0000", StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), AllDay: true}},
+		},
+		{
+			"success folded lines and nested alarm",
+			true,
+			wrap("BEGIN:VEVENT", "UID:d@example.com", "SUMMARY:long", " er title", "DTSTART:20250106T090000Z", "DTEND:20250106T100000Z", "BEGIN:VALARM", "DESCRIPTION:alarm", "END:VALARM", "END:VEVENT"),
+			[]ICalendarEvent{{UID: "d@example.com", Summary: "longer title", StartTime: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)}},
+		},
+		{
+			"success css color is ignored",
+			true,
+			wrap("BEGIN:VEVENT", "UID:e@example.com", "DTSTART:20250106T090000Z", "DTEND:20250106T100000Z", "COLOR:red", "END:VEVENT"),
+			[]ICalendarEvent{{UID: "e@example.com", StartTime: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC), EndTime: time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)}},
+		},
+		{"failure not a calendar", false, []byte("hello"), nil},
+		{"failure missing end", false, []byte("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VEVENT\r\n"), nil},
+		{"failure mismatched end", false, wrap("BEGIN:VEVENT", "END:VTODO"), nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			events, err := ParseICalendar(tt.data)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if !tt.success {
+				return
+			}
+			if len(events) != len(tt.expectedEvents) {
+				t.Fatalf("len(ParseICalendar()) = %v, want %v", len(events), len(tt.expectedEvents))
+			}
+			for i, want := range tt.expectedEvents {
+				got := events[i]
+				if got.Err != nil {
+					t.Errorf("Err = %v, want nil", got.Err)
+				}
+				if got.UID != want.UID || got.Summary != want.Summary || got.Description != want.Description || got.Color != want.Color {
+					t.Errorf("ParseICalendar()[%d] = %+v, want %+v", i, got, want)
+				}
+				if !got.StartTime.Equal(want.StartTime) || !got.EndTime.Equal(want.EndTime) || got.AllDay != want.AllDay || got.TimeZone != want.TimeZone {
+					t.Errorf("ParseICalendar()[%d] times = %v-%v %v %q, want %v-%v %v %q", i, got.StartTime, got.EndTime, got.AllDay, got.TimeZone, want.StartTime, want.EndTime, want.AllDay, want.TimeZone)
+				}
+				if !slices.Equal(got.Recurrence, want.Recurrence) {
+					t.Errorf("Recurrence = %v, want %v", got.Recurrence, want.Recurrence)
+				}
+			}
+		})
+	}
+}
+
+func TestParseICalendarItemErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		lines []string
+	}{
+		{"failure missing uid", []string{"DTSTART:20250106T090000Z"}},
+		{"failure missing dtstart", []string{"UID:a@example.com"}},
+		{"failure invalid dtstart", []string{"UID:a@example.com", "DTSTART:2025-01-06"}},
+		{"failure unknown tzid", []string{"UID:a@example.com", "DTSTART;TZID=Mars/Olympus:20250106T090000"}},
+		{"failure mixed date and date time", []string{"UID:a@example.com", "DTSTART;VALUE=DATE:20250106", "DTEND:20250107T000000Z"}},
+		{"failure invalid duration", []string{"UID:a@example.com", "DTSTART:20250106T090000Z", "DURATION:1H"}},
+		{"failure recurrence override", []string{"UID:a@example.com", "RECURRENCE-ID:20250106T090000Z", "DTSTART:20250106T100000Z"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n" + strings.Join(tt.lines, "\r\n") + "\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+			events, err := ParseICalendar([]byte(data))
+			if err != nil {
+				t.Fatalf("expected no error, but got %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("len(ParseICalendar()) = %v, want 1", len(events))
+			}
+			if events[0].Err == nil {
+				t.Errorf("expected item error, but got nil")
+			}
+		})
+	}
+}
+
+func TestICalendarRoundTrip(t *testing.T) {
+	t.Parallel()
+	tokyo, err := NewTimeZone("Asia/Tokyo")
+	if err != nil {
+		t.Errorf("failed to new time zone: %v", err)
+	}
+	recurrence, err := NewRecurrence([]string{"RRULE:FREQ=WEEKLY;BYDAY=MO", "EXDATE:20250113T000000Z"})
+	if err != nil {
+		t.Errorf("failed to new recurrence: %v", err)
+	}
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	e := NewEvent(NewEventID(), user.UserID{}, calendar.CalendarID{}, Title("a, b; c"), Description("x\ny"), start, start.Add(time.Hour), false, tokyo, Color("#FFFFFF"), recurrence, nil, nil, "", 1, start, start, time.Time{})
+
+	events, err := ParseICalendar(MarshalICalendar([]Event{e}))
+	if err != nil {
+		t.Fatalf("expected no error, but got %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("len(ParseICalendar()) = %v, want 1", len(events))
+	}
+	got := events[0]
+	if got.Err != nil {
+		t.Errorf("Err = %v, want nil", got.Err)
+	}
+	if got.UID != e.ICalUID() {
+		t.Errorf("UID = %v, want %v", got.UID, e.ICalUID())
+	}
+	if got.Summary != e.Title().String() || got.Description != e.Description().String() {
+		t.Errorf("Summary, Description = %q, %q, want %q, %q", got.Summary, got.Description, e.Title(), e.Description())
+	}
+	if !got.StartTime.Equal(e.StartTime()) || !got.EndTime.Equal(e.EndTime()) || got.TimeZone != "Asia/Tokyo" {
+		t.Errorf("times = %v-%v %q, want %v-%v %q", got.StartTime, got.EndTime, got.TimeZone, e.StartTime(), e.EndTime(), "Asia/Tokyo")
+	}
+	if !slices.Equal(got.Recurrence, e.Recurrence().Lines()) {
+		t.Errorf("Recurrence = %v, want %v", got.Recurrence, e.Recurrence().Lines())
+	}
+}
@@ -0,0 +1,529 @@
+package event
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyYearly  Frequency = "YEARLY"
+)
+
+const maxRecurrencePeriods = 100000
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var weekdayNames = map[time.Weekday]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+var recurrenceTimeLayouts = []string{
+	"20060102T150405Z",
+	"20060102T150405",
+	"20060102",
+}
+
+// WeekdayNum is a BYDAY value. A non-zero Ordinal picks a single weekday of
+// the month, or of the year for yearly rules, such as the second Tuesday or,
+// counting from the end, the last Friday.
+type WeekdayNum struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+func (d WeekdayNum) String() string {
+	if d.Ordinal == 0 {
+		return weekdayNames[d.Weekday]
+	}
+	return strconv.Itoa(d.Ordinal) + weekdayNames[d.Weekday]
+}
+
+// Recurrence is an RFC 5545 recurrence rule together with its excluded dates.
+// The zero value means the event does not repeat.
+type Recurrence struct {
+	frequency  Frequency
+	interval   int
+	byDay      []WeekdayNum
+	byMonthDay []int
+	count      int
+	until      time.Time
+	exDates    []time.Time
+}
+
+func (r Recurrence) IsZero() bool {
+	return r.frequency == ""
+}
+
+func (r Recurrence) Frequency() Frequency {
+	return r.frequency
+}
+
+func (r Recurrence) Interval() int {
+	return r.interval
+}
+
+func (r Recurrence) ByDay() []WeekdayNum {
+	return r.byDay
+}
+
+func (r Recurrence) ByMonthDay() []int {
+	return r.byMonthDay
+}
+
+func (r Recurrence) Count() int {
+	return r.count
+}
+
+func (r Recurrence) Until() time.Time {
+	return r.until
+}
+
+func (r Recurrence) ExDates() []time.Time {
+	return r.exDates
+}
+
+// Lines returns the recurrence as RRULE and EXDATE content lines.
+func (r Recurrence) Lines() []string {
+	if r.IsZero() {
+		return nil
+	}
+
+	parts := []string{"FREQ=" + string(r.frequency)}
+	if r.interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+	if len(r.byDay) > 0 {
+		days := make([]string, 0, len(r.byDay))
+		for _, d := range r.byDay {
+			days = append(days, d.String())
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.byMonthDay) > 0 {
+		days := make([]string, 0, len(r.byMonthDay))
+		for _, d := range r.byMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.count))
+	}
+	if !r.until.IsZero() {
+		parts = append(parts, "UNTIL="+r.until.UTC().Format(recurrenceTimeLayouts[0]))
+	}
+
+	lines := []string{"RRULE:" + strings.Join(parts, ";")}
+	if len(r.exDates) > 0 {
+		dates := make([]string, 0, len(r.exDates))
+		for _, d := range r.exDates {
+			dates = append(dates, d.UTC().Format(recurrenceTimeLayouts[0]))
+		}
+		lines = append(lines, "EXDATE:"+strings.Join(dates, ","))
+	}
+
+	return lines
+}
+
+func (r Recurrence) String() string {
+	return strings.Join(r.Lines(), "\n")
+}
+
+// Occurrences returns the start times of the occurrences of a series that
+// begins at start and whose instances last d, overlapping [from, to).
+func (r Recurrence) Occurrences(start time.Time, d time.Duration, from, to time.Time) []time.Time {
+	overlaps := func(s time.Time) bool {
+		return s.Before(to) && (s.Add(d).After(from) || (d == 0 && !s.Before(from)))
+	}
+
+	if r.IsZero() {
+		if overlaps(start) {
+			return []time.Time{start}
+		}
+		return nil
+	}
+
+	var occurrences []time.Time
+	generated := 0
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		candidates := r.candidates(start, period)
+		for _, c := range candidates {
+			if c.Before(start) {
+				continue
+			}
+			if !r.until.IsZero() && c.After(r.until) {
+				return occurrences
+			}
+			if !c.Before(to) {
+				return occurrences
+			}
+			generated++
+			if !r.isExcluded(c) && overlaps(c) {
+				occurrences = append(occurrences, c)
+			}
+			if r.count > 0 && generated >= r.count {
+				return occurrences
+			}
+		}
+	}
+
+	return occurrences
+}
+
+func (r Recurrence) isExcluded(t time.Time) bool {
+	for _, d := range r.exDates {
+		if d.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Recurrence) candidates(start time.Time, period int) []time.Time {
+	n := period * r.interval
+	hour, minute, sec := start.Clock()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, minute, sec, start.Nanosecond(), start.Location())
+	}
+
+	var candidates []time.Time
+	switch r.frequency {
+	case FrequencyDaily:
+		day := start.AddDate(0, 0, n)
+		if r.matchesByDay(day) && r.matchesByMonthDay(day) {
+			candidates = append(candidates, day)
+		}
+	case FrequencyWeekly:
+		offset := (int(start.Weekday()) + 6) % 7
+		monday := start.AddDate(0, 0, 7*n-offset)
+		days := r.byDay
+		if len(days) == 0 {
+			days = []WeekdayNum{{Weekday: start.Weekday()}}
+		}
+		for _, wd := range days {
+			day := monday.AddDate(0, 0, (int(wd.Weekday)+6)%7)
+			if r.matchesByMonthDay(day) {
+				candidates = append(candidates, day)
+			}
+		}
+	case FrequencyMonthly:
+		first := time.Date(start.Year(), start.Month()+time.Month(n), 1, 0, 0, 0, 0, start.Location())
+		candidates = r.monthCandidates(first, start.Day(), at)
+	case FrequencyYearly:
+		year := start.Year() + n
+		if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+			day := at(year, start.Month(), start.Day())
+			if day.Day() == start.Day() {
+				candidates = append(candidates, day)
+			}
+			break
+		}
+		for month := time.January; month <= time.December; month++ {
+			first := time.Date(year, month, 1, 0, 0, 0, 0, start.Location())
+			candidates = append(candidates, r.monthCandidates(first, 0, at)...)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+
+	return candidates
+}
+
+func (r Recurrence) monthCandidates(first time.Time, defaultDay int, at func(int, time.Month, int) time.Time) []time.Time {
+	year, month := first.Year(), first.Month()
+	daysInMonth := first.AddDate(0, 1, -1).Day()
+
+	var candidates []time.Time
+	switch {
+	case len(r.byMonthDay) > 0:
+		for _, d := range r.byMonthDay {
+			if d < 0 {
+				d = daysInMonth + d + 1
+			}
+			if d < 1 || d > daysInMonth {
+				continue
+			}
+			day := at(year, month, d)
+			if r.matchesByDay(day) {
+				candidates = append(candidates, day)
+			}
+		}
+	case len(r.byDay) > 0:
+		for d := 1; d <= daysInMonth; d++ {
+			day := at(year, month, d)
+			if r.matchesByDay(day) {
+				candidates = append(candidates, day)
+			}
+		}
+	case defaultDay > 0 && defaultDay <= daysInMonth:
+		candidates = append(candidates, at(year, month, defaultDay))
+	}
+
+	return candidates
+}
+
+func (r Recurrence) matchesByDay(t time.Time) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+	for _, d := range r.byDay {
+		if t.Weekday() != d.Weekday {
+			continue
+		}
+		if d.Ordinal == 0 || r.weekdayOrdinal(t, d.Ordinal > 0) == d.Ordinal {
+			return true
+		}
+	}
+	return false
+}
+
+// weekdayOrdinal returns which of the same weekdays of its month, or of its
+// year for yearly rules, t is. It counts from the start when fromStart is
+// set, and otherwise from the end as a negative number.
+func (r Recurrence) weekdayOrdinal(t time.Time, fromStart bool) int {
+	day := t.Day()
+	days := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if r.frequency == FrequencyYearly {
+		day = t.YearDay()
+		days = time.Date(t.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+
+	if fromStart {
+		return (day-1)/7 + 1
+	}
+	return -((days-day)/7 + 1)
+}
+
+func (r Recurrence) matchesByMonthDay(t time.Time) bool {
+	if len(r.byMonthDay) == 0 {
+		return true
+	}
+	daysInMonth := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	for _, d := range r.byMonthDay {
+		if d == t.Day() || (d < 0 && daysInMonth+d+1 == t.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewRecurrence parses RRULE and EXDATE content lines. No lines means the
+// event does not repeat. Date-only and floating UNTIL and EXDATE values are
+// read as UTC, as in the lines Lines returns.
+func NewRecurrence(lines []string) (Recurrence, error) {
+	return parseRecurrenceIn(lines, time.UTC)
+}
+
+// NewRecurrenceIn is NewRecurrence for the lines of an event in timeZone,
+// whose date-only and floating UNTIL and EXDATE values are read in that zone.
+// All-day events keep reading them as UTC, which is how their dates are
+// stored.
+func NewRecurrenceIn(lines []string, allDay bool, timeZone TimeZone) (Recurrence, error) {
+	if allDay {
+		return NewRecurrence(lines)
+	}
+	return parseRecurrenceIn(lines, timeZone.Location())
+}
+
+func parseRecurrenceIn(lines []string, location *time.Location) (Recurrence, error) {
+	r, err := parseRecurrence(lines, location)
+	if err != nil {
+		return Recurrence{}, fmt.Errorf("%w: %v", ErrInvalidRecurrence, err)
+	}
+	return r, nil
+}
+
+func parseRecurrence(lines []string, location *time.Location) (Recurrence, error) {
+	var r Recurrence
+	var exDates []time.Time
+	hasRule := false
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return Recurrence{}, fmt.Errorf("invalid recurrence line: %q", line)
+		}
+		name, _, _ = strings.Cut(strings.ToUpper(name), ";")
+
+		switch name {
+		case "RRULE":
+			if hasRule {
+				return Recurrence{}, fmt.Errorf("multiple RRULE lines are not supported")
+			}
+			rule, err := parseRecurrenceRule(value, location)
+			if err != nil {
+				return Recurrence{}, err
+			}
+			r = rule
+			hasRule = true
+		case "EXDATE":
+			for _, v := range strings.Split(value, ",") {
+				t, err := parseRecurrenceTime(v, location)
+				if err != nil {
+					return Recurrence{}, err
+				}
+				exDates = append(exDates, t)
+			}
+		default:
+			return Recurrence{}, fmt.Errorf("unsupported recurrence line: %q", name)
+		}
+	}
+
+	if !hasRule {
+		if len(exDates) > 0 {
+			return Recurrence{}, fmt.Errorf("EXDATE requires an RRULE")
+		}
+		return Recurrence{}, nil
+	}
+
+	r.exDates = exDates
+
+	return r, nil
+}
+
+func parseRecurrenceRule(s string, location *time.Location) (Recurrence, error) {
+	r := Recurrence{interval: 1}
+
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Recurrence{}, fmt.Errorf("invalid RRULE part: %q", part)
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			switch f := Frequency(strings.ToUpper(value)); f {
+			case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+				r.frequency = f
+			default:
+				return Recurrence{}, fmt.Errorf("unsupported FREQ: %q", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return Recurrence{}, fmt.Errorf("invalid INTERVAL: %q", value)
+			}
+			r.interval = n
+		case "BYDAY":
+			for _, v := range strings.Split(value, ",") {
+				d, err := parseWeekdayNum(v)
+				if err != nil {
+					return Recurrence{}, err
+				}
+				r.byDay = append(r.byDay, d)
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				d, err := strconv.Atoi(v)
+				if err != nil || d == 0 || d < -31 || d > 31 {
+					return Recurrence{}, fmt.Errorf("invalid BYMONTHDAY: %q", v)
+				}
+				r.byMonthDay = append(r.byMonthDay, d)
+			}
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return Recurrence{}, fmt.Errorf("invalid COUNT: %q", value)
+			}
+			r.count = n
+		case "UNTIL":
+			t, err := parseRecurrenceTime(value, location)
+			if err != nil {
+				return Recurrence{}, err
+			}
+			r.until = t
+		case "WKST":
+			if strings.ToUpper(value) != "MO" {
+				return Recurrence{}, fmt.Errorf("unsupported WKST: %q", value)
+			}
+		default:
+			return Recurrence{}, fmt.Errorf("unsupported RRULE part: %q", key)
+		}
+	}
+
+	if r.frequency == "" {
+		return Recurrence{}, fmt.Errorf("RRULE requires FREQ")
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return Recurrence{}, fmt.Errorf("RRULE must not contain both COUNT and UNTIL")
+	}
+	for _, d := range r.byDay {
+		switch {
+		case d.Ordinal == 0:
+		case r.frequency == FrequencyMonthly && (d.Ordinal < -5 || d.Ordinal > 5):
+			return Recurrence{}, fmt.Errorf("invalid BYDAY for FREQ=MONTHLY: %q", d)
+		case r.frequency != FrequencyMonthly && r.frequency != FrequencyYearly:
+			return Recurrence{}, fmt.Errorf("BYDAY with an ordinal requires FREQ=MONTHLY or FREQ=YEARLY: %q", d)
+		}
+	}
+
+	return r, nil
+}
+
+// parseWeekdayNum parses a BYDAY value such as "MO", "2TU" or "-1FR".
+func parseWeekdayNum(s string) (WeekdayNum, error) {
+	v := strings.ToUpper(s)
+	if len(v) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY: %q", s)
+	}
+
+	wd, ok := weekdays[v[len(v)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY: %q", s)
+	}
+
+	var ordinal int
+	if n := v[:len(v)-2]; n != "" {
+		var err error
+		ordinal, err = strconv.Atoi(n)
+		if err != nil || ordinal == 0 || ordinal < -53 || ordinal > 53 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY: %q", s)
+		}
+	}
+
+	return WeekdayNum{Ordinal: ordinal, Weekday: wd}, nil
+}
+
+// parseRecurrenceTime reads date-only and floating values in location. The Z
+// in the first layout is a literal, so UTC values are parsed without it.
+func parseRecurrenceTime(s string, location *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(recurrenceTimeLayouts[0], s); err == nil {
+		return t, nil
+	}
+	for _, layout := range recurrenceTimeLayouts[1:] {
+		if t, err := time.ParseInLocation(layout, s, location); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid recurrence time: %q", s)
+}
@@ -0,0 +1,242 @@
+package event
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewRecurrence(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		success        bool
+		lines          []string
+		expectedString string
+	}{
+		{"success no recurrence", true, nil, ""},
+		{"success daily", true, []string{"RRULE:FREQ=DAILY"}, "RRULE:FREQ=DAILY"},
+		{"success weekly by day", true, []string{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"}, "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+		{"success monthly by nth weekday", true, []string{"RRULE:FREQ=MONTHLY;BYDAY=2TU"}, "RRULE:FREQ=MONTHLY;BYDAY=2TU"},
+		{"success monthly by last weekday", true, []string{"RRULE:FREQ=MONTHLY;BYDAY=+1MO,-1FR"}, "RRULE:FREQ=MONTHLY;BYDAY=1MO,-1FR"},
+		{"success yearly by nth weekday", true, []string{"RRULE:FREQ=YEARLY;BYDAY=20MO"}, "RRULE:FREQ=YEARLY;BYDAY=20MO"},
+		{"success monthly by month day", true, []string{"RRULE:FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=3"}, "RRULE:FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=3"},
+		{"success until", true, []string{"RRULE:FREQ=YEARLY;UNTIL=20301231T000000Z"}, "RRULE:FREQ=YEARLY;UNTIL=20301231T000000Z"},
+		{"success exdate", true, []string{"RRULE:FREQ=DAILY", "EXDATE:20250102T100000Z"}, "RRULE:FREQ=DAILY\nEXDATE:20250102T100000Z"},
+		{"failure missing freq", false, []string{"RRULE:INTERVAL=2"}, ""},
+		{"failure unsupported freq", false, []string{"RRULE:FREQ=HOURLY"}, ""},
+		{"failure invalid interval", false, []string{"RRULE:FREQ=DAILY;INTERVAL=0"}, ""},
+		{"failure invalid by day", false, []string{"RRULE:FREQ=WEEKLY;BYDAY=XX"}, ""},
+		{"failure weekly by nth weekday", false, []string{"RRULE:FREQ=WEEKLY;BYDAY=2TU"}, ""},
+		{"failure monthly ordinal out of range", false, []string{"RRULE:FREQ=MONTHLY;BYDAY=6MO"}, ""},
+		{"failure zero ordinal", false, []string{"RRULE:FREQ=MONTHLY;BYDAY=0MO"}, ""},
+		{"failure invalid ordinal", false, []string{"RRULE:FREQ=YEARLY;BYDAY=XMO"}, ""},
+		{"failure invalid by month day", false, []string{"RRULE:FREQ=MONTHLY;BYMONTHDAY=32"}, ""},
+		{"failure count and until", false, []string{"RRULE:FREQ=DAILY;COUNT=2;UNTIL=20301231T000000Z"}, ""},
+		{"failure exdate without rrule", false, []string{"EXDATE:20250102T100000Z"}, ""},
+		{"failure multiple rrules", false, []string{"RRULE:FREQ=DAILY", "RRULE:FREQ=WEEKLY"}, ""},
+		{"failure unsupported line", false, []string{"RDATE:20250102T100000Z"}, ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recurrence, err := NewRecurrence(tt.lines)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && recurrence.String() != tt.expectedString {
+				t.Errorf("String() = %v, want %v", recurrence.String(), tt.expectedString)
+			}
+		})
+	}
+}
+
+func TestNewRecurrenceIn(t *testing.T) {
+	t.Parallel()
+	tokyo, err := NewTimeZone("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to new time zone: %v", err)
+	}
+	tests := []struct {
+		name           string
+		success        bool
+		lines          []string
+		allDay         bool
+		timeZone       TimeZone
+		expectedString string
+	}{
+		{"success floating until in time zone", true, []string{"RRULE:FREQ=DAILY;UNTIL=20250110T090000"}, false, tokyo, "RRULE:FREQ=DAILY;UNTIL=20250110T000000Z"},
+		{"success date-only until in time zone", true, []string{"RRULE:FREQ=DAILY;UNTIL=20250110"}, false, tokyo, "RRULE:FREQ=DAILY;UNTIL=20250109T150000Z"},
+		{"success floating exdate in time zone", true, []string{"RRULE:FREQ=DAILY", "EXDATE:20250107T090000"}, false, tokyo, "RRULE:FREQ=DAILY\nEXDATE:20250107T000000Z"},
+		{"success utc values are kept", true, []string{"RRULE:FREQ=DAILY;UNTIL=20250110T090000Z", "EXDATE:20250107T090000Z"}, false, tokyo, "RRULE:FREQ=DAILY;UNTIL=20250110T090000Z\nEXDATE:20250107T090000Z"},
+		{"success all-day dates stay utc", true, []string{"RRULE:FREQ=DAILY;UNTIL=20250110", "EXDATE:20250107"}, true, tokyo, "RRULE:FREQ=DAILY;UNTIL=20250110T000000Z\nEXDATE:20250107T000000Z"},
+		{"failure invalid exdate", false, []string{"RRULE:FREQ=DAILY", "EXDATE:2025-01-07"}, false, tokyo, ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recurrence, err := NewRecurrenceIn(tt.lines, tt.allDay, tt.timeZone)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && recurrence.String() != tt.expectedString {
+				t.Errorf("String() = %v, want %v", recurrence.String(), tt.expectedString)
+			}
+		})
+	}
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC) // Monday
+	tests := []struct {
+		name     string
+		lines    []string
+		start    time.Time
+		from     time.Time
+		to       time.Time
+		expected []string
+	}{
+		{
+			name:     "daily with count",
+			lines:    []string{"RRULE:FREQ=DAILY;COUNT=3"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(0, 1, 0),
+			expected: []string{"2025-01-06", "2025-01-07", "2025-01-08"},
+		},
+		{
+			name:     "daily with interval and exdate",
+			lines:    []string{"RRULE:FREQ=DAILY;INTERVAL=2", "EXDATE:20250108T100000Z"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(0, 0, 7),
+			expected: []string{"2025-01-06", "2025-01-10", "2025-01-12"},
+		},
+		{
+			name:     "weekly by day",
+			lines:    []string{"RRULE:FREQ=WEEKLY;BYDAY=MO,FR"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(0, 0, 14),
+			expected: []string{"2025-01-06", "2025-01-10", "2025-01-13", "2025-01-17"},
+		},
+		{
+			name:     "weekly until",
+			lines:    []string{"RRULE:FREQ=WEEKLY;UNTIL=20250120T100000Z"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(1, 0, 0),
+			expected: []string{"2025-01-06", "2025-01-13", "2025-01-20"},
+		},
+		{
+			name:     "weekly count is counted from the series start",
+			lines:    []string{"RRULE:FREQ=WEEKLY;COUNT=3"},
+			start:    start,
+			from:     start.AddDate(0, 0, 10),
+			to:       start.AddDate(1, 0, 0),
+			expected: []string{"2025-01-20"},
+		},
+		{
+			name:     "monthly by month day",
+			lines:    []string{"RRULE:FREQ=MONTHLY;BYMONTHDAY=-1"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(0, 3, 0),
+			expected: []string{"2025-01-31", "2025-02-28", "2025-03-31"},
+		},
+		{
+			name:     "monthly by day",
+			lines:    []string{"RRULE:FREQ=MONTHLY;BYDAY=MO;BYMONTHDAY=1,2,3,4,5,6,7"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(0, 3, 0),
+			expected: []string{"2025-01-06", "2025-02-03", "2025-03-03"},
+		},
+		{
+			name:     "monthly by nth weekday",
+			lines:    []string{"RRULE:FREQ=MONTHLY;BYDAY=2TU"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(0, 3, 0),
+			expected: []string{"2025-01-14", "2025-02-11", "2025-03-11"},
+		},
+		{
+			name:     "monthly by first and last weekday",
+			lines:    []string{"RRULE:FREQ=MONTHLY;BYDAY=1MO,-1FR"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(0, 3, 0),
+			expected: []string{"2025-01-06", "2025-01-31", "2025-02-03", "2025-02-28", "2025-03-03", "2025-03-28"},
+		},
+		{
+			name:     "monthly by last weekday with count",
+			lines:    []string{"RRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=2"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(1, 0, 0),
+			expected: []string{"2025-01-31", "2025-02-28"},
+		},
+		{
+			name:     "yearly by nth weekday of the year",
+			lines:    []string{"RRULE:FREQ=YEARLY;BYDAY=1MO"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(3, 0, 0),
+			expected: []string{"2025-01-06", "2026-01-05", "2027-01-04", "2028-01-03"},
+		},
+		{
+			name:     "yearly by last weekday of the year",
+			lines:    []string{"RRULE:FREQ=YEARLY;BYDAY=-1SU"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(2, 0, 0),
+			expected: []string{"2025-12-28", "2026-12-27"},
+		},
+		{
+			name:     "monthly skips short months",
+			lines:    []string{"RRULE:FREQ=MONTHLY"},
+			start:    time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC),
+			from:     time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2025-01-31", "2025-03-31"},
+		},
+		{
+			name:     "yearly",
+			lines:    []string{"RRULE:FREQ=YEARLY"},
+			start:    start,
+			from:     start,
+			to:       start.AddDate(3, 0, 0),
+			expected: []string{"2025-01-06", "2026-01-06", "2027-01-06"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recurrence, err := NewRecurrence(tt.lines)
+			if err != nil {
+				t.Fatalf("failed to new recurrence: %v", err)
+			}
+
+			var got []string
+			for _, o := range recurrence.Occurrences(tt.start, time.Hour, tt.from, tt.to) {
+				got = append(got, o.Format("2006-01-02"))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("Occurrences() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package event
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+const (
+	maxReminders      = 5
+	maxReminderOffset = 4 * 7 * 24 * time.Hour
+	// reminderHorizon bounds how far ahead the next occurrence of a recurring
+	// event is looked for when scheduling its reminders.
+	reminderHorizon = 366 * 24 * time.Hour
+)
+
+type ReminderChannel string
+
+const (
+	ReminderChannelPopup ReminderChannel = "popup"
+	ReminderChannelEmail ReminderChannel = "email"
+)
+
+func (c ReminderChannel) String() string {
+	return string(c)
+}
+
+// NewReminderChannel defaults to ReminderChannelPopup when s is empty.
+func NewReminderChannel(s string) (ReminderChannel, error) {
+	switch c := ReminderChannel(s); c {
+	case "":
+		return ReminderChannelPopup, nil
+	case ReminderChannelPopup, ReminderChannelEmail:
+		return c, nil
+	}
+
+	return ReminderChannel(""), ErrInvalidChannel
+}
+
+// Reminder notifies the organizer of an event some time before each of its
+// occurrences starts.
+type Reminder struct {
+	offset  time.Duration
+	channel ReminderChannel
+}
+
+func (r Reminder) Offset() time.Duration {
+	return r.offset
+}
+
+func (r Reminder) Channel() ReminderChannel {
+	return r.channel
+}
+
+// NewReminder requires a whole number of minutes of at most four weeks.
+func NewReminder(offset time.Duration, channel ReminderChannel) (Reminder, error) {
+	if offset < 0 || offset > maxReminderOffset || offset%time.Minute != 0 {
+		return Reminder{}, ErrInvalidReminder
+	}
+
+	return Reminder{offset: offset, channel: channel}, nil
+}
+
+// ValidateReminders rejects lists that are too long or repeat a reminder.
+func ValidateReminders(reminders []Reminder) error {
+	if len(reminders) > maxReminders {
+		return ErrTooManyReminders
+	}
+
+	for i, a := range reminders {
+		for _, b := range reminders[:i] {
+			if a == b {
+				return ErrDuplicateReminder
+			}
+		}
+	}
+
+	return nil
+}
+
+// DueReminder is a reminder whose trigger time has come, for the occurrence
+// of an event starting at StartTime.
+type DueReminder struct {
+	EventID     EventID
+	UserID      user.UserID
+	Title       Title
+	StartTime   time.Time
+	Reminder    Reminder
+	TriggerTime time.Time
+	// Version is the version of the event when the reminder was claimed.
+	Version int64
+}
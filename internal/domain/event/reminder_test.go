@@ -0,0 +1,102 @@
+package event
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewReminderChannel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		success  bool
+		channel  string
+		expected ReminderChannel
+	}{
+		{"success popup", true, "popup", ReminderChannelPopup},
+		{"success email", true, "email", ReminderChannelEmail},
+		{"success default channel", true, "", ReminderChannelPopup},
+		{"failure unknown channel", false, "sms", ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			channel, err := NewReminderChannel(tt.channel)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if channel != tt.expected {
+				t.Errorf("NewReminderChannel() = %v, want %v", channel, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewReminder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		offset  time.Duration
+	}{
+		{"success new reminder", true, 10 * time.Minute},
+		{"success at start", true, 0},
+		{"success four weeks before", true, maxReminderOffset},
+		{"failure negative offset", false, -time.Minute},
+		{"failure offset too long", false, maxReminderOffset + time.Minute},
+		{"failure partial minute", false, 90 * time.Second},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reminder, err := NewReminder(tt.offset, ReminderChannelPopup)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && reminder.Offset() != tt.offset {
+				t.Errorf("Offset() = %v, want %v", reminder.Offset(), tt.offset)
+			}
+		})
+	}
+}
+
+func TestValidateReminders(t *testing.T) {
+	t.Parallel()
+	newReminder := func(minutes int, channel ReminderChannel) Reminder {
+		return Reminder{offset: time.Duration(minutes) * time.Minute, channel: channel}
+	}
+	tests := []struct {
+		name      string
+		success   bool
+		reminders []Reminder
+	}{
+		{"success no reminders", true, nil},
+		{"success same offset on different channels", true, []Reminder{newReminder(10, ReminderChannelPopup), newReminder(10, ReminderChannelEmail)}},
+		{"failure duplicate reminder", false, []Reminder{newReminder(10, ReminderChannelPopup), newReminder(10, ReminderChannelPopup)}},
+		{"failure too many reminders", false, []Reminder{newReminder(1, ReminderChannelPopup), newReminder(2, ReminderChannelPopup), newReminder(3, ReminderChannelPopup), newReminder(4, ReminderChannelPopup), newReminder(5, ReminderChannelPopup), newReminder(6, ReminderChannelPopup)}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateReminders(tt.reminders)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
@@ -1,11 +1,66 @@
 package event
 
-import "github.com/qkitzero/event-service/internal/domain/user"
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
 
 type EventRepository interface {
 	Create(event Event) error
-	Update(event Event) error
+	Update(event Event, version int64) error
 	FindByID(id EventID) (Event, error)
 	FindAllByUserID(userID user.UserID) ([]Event, error)
-	Delete(id EventID) error
+	FindByICalUID(userID user.UserID, icalUID string) (Event, error)
+	// The next five methods find the events in calendarIDs together with,
+	// unless attendeeID is zero, the events attendeeID is invited to. A
+	// negative limit finds every match.
+	FindAllByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID) ([]Event, error)
+	// FindByCalendarIDsAfterID finds them in the order of their IDs, after
+	// the event with the ID afterID unless it is nil.
+	FindByCalendarIDsAfterID(calendarIDs []calendar.CalendarID, attendeeID user.UserID, afterID *EventID, limit int) ([]Event, error)
+	FindByCalendarIDsInRange(calendarIDs []calendar.CalendarID, attendeeID user.UserID, from, to time.Time, after *Cursor, limit int) ([]Event, error)
+	FindRecurringByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID, before time.Time) ([]Event, error)
+	// FindChangedByCalendarIDs also finds deleted events, and only those
+	// updated or deleted after since.
+	FindChangedByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID, since time.Time) ([]Event, error)
+	// FindDepartedByCalendarIDs finds the IDs of the events changed after
+	// since that were in calendarIDs, or that attendeeID was invited to,
+	// before the change, whether or not they still are.
+	FindDepartedByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID, since time.Time) ([]EventID, error)
+	// FindByUserIDInRange finds the timed events userID organizes or is
+	// invited to that overlap [from, to), together with the recurring ones
+	// that start before to.
+	FindByUserIDInRange(userID user.UserID, from, to time.Time) ([]Event, error)
+	Delete(id EventID, version int64) error
+	FindDeletedByID(id EventID) (Event, error)
+	FindDeletedByCalendarIDs(calendarIDs []calendar.CalendarID) ([]Event, error)
+	// LatestDeletionByUserID returns when the last of userID's events still
+	// in the trash was deleted, or the zero time if there is none.
+	LatestDeletionByUserID(userID user.UserID) (time.Time, error)
+	// Restore fails with ErrICalUIDConflict when another live event of the
+	// same user has taken the deleted event's iCalendar UID.
+	Restore(id EventID) error
+	PurgeDeletedBefore(before time.Time) (int64, error)
+	// ClaimDueReminders returns the reminders due by now and reschedules
+	// them, so that concurrent callers never claim the same reminder twice.
+	ClaimDueReminders(now time.Time, limit int) ([]DueReminder, error)
+	// ReleaseReminder makes a claimed reminder due again at its trigger time,
+	// unless the event was changed or deleted since it was claimed.
+	ReleaseReminder(dueReminder DueReminder) error
+	// RelayChanges hands the changes recorded by Create, Update, Delete and
+	// Restore to publish, at least once each and in the order they were made.
+	RelayChanges(limit int, publish func(Change) error) (int, error)
+	// FindChangesAfter finds up to limit changes after the given position,
+	// in position order. It leaves out the changes of transactions that
+	// started after one that is still running, so that no change is ever
+	// found after a later one.
+	FindChangesAfter(after ChangePosition, limit int) ([]Change, error)
+	// LatestChangePosition returns the position of the latest change that
+	// FindChangesAfter can find, or the zero position if there is none.
+	LatestChangePosition() (ChangePosition, error)
+	// PurgePublishedChangesBefore deletes the published changes made before
+	// the given time. Unpublished changes are kept until they are relayed.
+	PurgePublishedChangesBefore(before time.Time) (int64, error)
 }
@@ -0,0 +1,60 @@
+package event
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ResumeToken identifies the last change a watcher has seen and when it was
+// made, and is exchanged with clients as an opaque token so that they can
+// resume watching after it.
+type ResumeToken struct {
+	position   ChangePosition
+	occurredAt time.Time
+}
+
+func (t ResumeToken) Position() ChangePosition {
+	return t.position
+}
+
+func (t ResumeToken) OccurredAt() time.Time {
+	return t.occurredAt
+}
+
+func (t ResumeToken) Token() string {
+	s := strconv.FormatInt(t.position.TxID, 10) + "|" +
+		strconv.FormatInt(t.position.ChangeID, 10) + "|" +
+		strconv.FormatInt(t.occurredAt.UnixNano(), 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+func NewResumeToken(position ChangePosition, occurredAt time.Time) ResumeToken {
+	return ResumeToken{position: position, occurredAt: occurredAt}
+}
+
+func NewResumeTokenFromToken(token string) (ResumeToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ResumeToken{}, ErrInvalidResumeToken
+	}
+
+	parts := strings.Split(string(b), "|")
+	if len(parts) != 3 {
+		return ResumeToken{}, ErrInvalidResumeToken
+	}
+
+	var values [3]int64
+	for i, part := range parts {
+		values[i], err = strconv.ParseInt(part, 10, 64)
+		if err != nil || values[i] < 0 {
+			return ResumeToken{}, ErrInvalidResumeToken
+		}
+	}
+
+	return ResumeToken{
+		position:   ChangePosition{TxID: values[0], ChangeID: values[1]},
+		occurredAt: time.Unix(0, values[2]).UTC(),
+	}, nil
+}
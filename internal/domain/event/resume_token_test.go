@@ -0,0 +1,48 @@
+package event
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestNewResumeTokenFromToken(t *testing.T) {
+	t.Parallel()
+	occurredAt := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		success    bool
+		token      string
+		position   ChangePosition
+		occurredAt time.Time
+	}{
+		{"success new resume token from token", true, NewResumeToken(ChangePosition{TxID: 700, ChangeID: 42}, occurredAt).Token(), ChangePosition{TxID: 700, ChangeID: 42}, occurredAt},
+		{"success zero position", true, NewResumeToken(ChangePosition{}, occurredAt).Token(), ChangePosition{}, occurredAt},
+		{"failure empty token", false, "", ChangePosition{}, time.Time{}},
+		{"failure invalid base64", false, "!!!", ChangePosition{}, time.Time{}},
+		{"failure change id only", false, base64.RawURLEncoding.EncodeToString([]byte("42")), ChangePosition{}, time.Time{}},
+		{"failure not a number", false, base64.RawURLEncoding.EncodeToString([]byte("700|abc|0")), ChangePosition{}, time.Time{}},
+		{"failure negative change id", false, base64.RawURLEncoding.EncodeToString([]byte("700|-1|0")), ChangePosition{}, time.Time{}},
+		{"failure invalid time", false, base64.RawURLEncoding.EncodeToString([]byte("700|42|abc")), ChangePosition{}, time.Time{}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resumeToken, err := NewResumeTokenFromToken(tt.token)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && resumeToken.Position() != tt.position {
+				t.Errorf("Position() = %v, want %v", resumeToken.Position(), tt.position)
+			}
+			if tt.success && !resumeToken.OccurredAt().Equal(tt.occurredAt) {
+				t.Errorf("OccurredAt() = %v, want %v", resumeToken.OccurredAt(), tt.occurredAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package event
+
+import (
+	"sort"
+	"time"
+)
+
+// slotStep is how far apart candidate slots start. Candidates are aligned to
+// it, so they start on the hour or half hour in most time zones.
+const slotStep = 30 * time.Minute
+
+// FreeSlots returns, earliest first, every slot of the given duration within
+// [from, to) that lies inside the working hours in location and stays at
+// least buffer away from each of the busy intervals. Slots may overlap one
+// another.
+func FreeSlots(busy []Interval, from, to time.Time, duration, buffer time.Duration, hours WorkingHours, location *time.Location) []Interval {
+	blocked := make([]Interval, 0, len(busy))
+	for _, interval := range busy {
+		blocked = append(blocked, Interval{Start: interval.Start.Add(-buffer), End: interval.End.Add(buffer)})
+	}
+	blocked = mergeIntervals(blocked)
+
+	var slots []Interval
+	for _, period := range workingPeriods(from, to, hours, location) {
+		for _, gap := range subtractIntervals(period, blocked) {
+			start := gap.Start.Truncate(slotStep)
+			if start.Before(gap.Start) {
+				start = start.Add(slotStep)
+			}
+			for ; !start.Add(duration).After(gap.End); start = start.Add(slotStep) {
+				slots = append(slots, Interval{Start: start, End: start.Add(duration)})
+			}
+		}
+	}
+
+	return slots
+}
+
+// PickSlots returns up to count of the slots, which must be sorted, earliest
+// first and without any two overlapping. Days in location take turns, so
+// every day with a free slot gets one before any day gets a second.
+func PickSlots(slots []Interval, count int, location *time.Location) []Interval {
+	var days [][]Interval
+	var day, end time.Time
+	for _, slot := range slots {
+		if slot.Start.Before(end) {
+			continue
+		}
+		end = slot.End
+
+		local := slot.Start.In(location)
+		slotDay := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+		if len(days) == 0 || !slotDay.Equal(day) {
+			days = append(days, nil)
+			day = slotDay
+		}
+		days[len(days)-1] = append(days[len(days)-1], slot)
+	}
+
+	var picked []Interval
+	for round := 0; len(picked) < count; round++ {
+		added := false
+		for _, daySlots := range days {
+			if round < len(daySlots) && len(picked) < count {
+				picked = append(picked, daySlots[round])
+				added = true
+			}
+		}
+		if !added {
+			break
+		}
+	}
+	sort.Slice(picked, func(i, j int) bool {
+		return picked[i].Start.Before(picked[j].Start)
+	})
+
+	return picked
+}
+
+// workingPeriods returns the working hours of each day in location, clipped
+// to [from, to).
+func workingPeriods(from, to time.Time, hours WorkingHours, location *time.Location) []Interval {
+	var periods []Interval
+	local := from.In(location)
+	for day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location); day.Before(to); day = day.AddDate(0, 0, 1) {
+		start := time.Date(day.Year(), day.Month(), day.Day(), 0, int(hours.Start()/time.Minute), 0, 0, location)
+		end := time.Date(day.Year(), day.Month(), day.Day(), 0, int(hours.End()/time.Minute), 0, 0, location)
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if start.Before(end) {
+			periods = append(periods, Interval{Start: start, End: end})
+		}
+	}
+
+	return periods
+}
+
+// subtractIntervals returns the parts of period not covered by intervals,
+// which must be sorted and merged.
+func subtractIntervals(period Interval, intervals []Interval) []Interval {
+	var gaps []Interval
+	start := period.Start
+	for _, interval := range intervals {
+		if !interval.End.After(start) {
+			continue
+		}
+		if !interval.Start.Before(period.End) {
+			break
+		}
+		if interval.Start.After(start) {
+			gaps = append(gaps, Interval{Start: start, End: interval.Start})
+		}
+		start = interval.End
+	}
+	if start.Before(period.End) {
+		gaps = append(gaps, Interval{Start: start, End: period.End})
+	}
+
+	return gaps
+}
@@ -0,0 +1,144 @@
+package event
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFreeSlots(t *testing.T) {
+	t.Parallel()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+	officeHours, err := NewWorkingHours("09:00", "12:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		busy     []Interval
+		from     time.Time
+		to       time.Time
+		duration time.Duration
+		buffer   time.Duration
+		hours    WorkingHours
+		location *time.Location
+		expected []Interval
+	}{
+		{
+			"free window",
+			nil, at(6, 9, 0), at(6, 11, 0), time.Hour, 0, WorkingHours{}, time.UTC,
+			[]Interval{{at(6, 9, 0), at(6, 10, 0)}, {at(6, 9, 30), at(6, 10, 30)}, {at(6, 10, 0), at(6, 11, 0)}},
+		},
+		{
+			"busy intervals are avoided",
+			[]Interval{{at(6, 10, 0), at(6, 10, 30)}}, at(6, 9, 0), at(6, 12, 0), time.Hour, 0, WorkingHours{}, time.UTC,
+			[]Interval{{at(6, 9, 0), at(6, 10, 0)}, {at(6, 10, 30), at(6, 11, 30)}, {at(6, 11, 0), at(6, 12, 0)}},
+		},
+		{
+			"overlapping busy intervals of several users are combined",
+			[]Interval{{at(6, 9, 0), at(6, 10, 0)}, {at(6, 9, 30), at(6, 11, 0)}}, at(6, 9, 0), at(6, 12, 0), time.Hour, 0, WorkingHours{}, time.UTC,
+			[]Interval{{at(6, 11, 0), at(6, 12, 0)}},
+		},
+		{
+			"buffer is kept around busy intervals",
+			[]Interval{{at(6, 10, 0), at(6, 10, 30)}}, at(6, 9, 0), at(6, 12, 0), time.Hour, 15 * time.Minute, WorkingHours{}, time.UTC,
+			[]Interval{{at(6, 11, 0), at(6, 12, 0)}},
+		},
+		{
+			"slots start on the half hour",
+			nil, at(6, 9, 10), at(6, 10, 40), time.Hour, 0, WorkingHours{}, time.UTC,
+			[]Interval{{at(6, 9, 30), at(6, 10, 30)}},
+		},
+		{
+			"slots stay within working hours",
+			nil, at(6, 0, 0), at(8, 0, 0), 2 * time.Hour, 0, officeHours, time.UTC,
+			[]Interval{{at(6, 9, 0), at(6, 11, 0)}, {at(6, 9, 30), at(6, 11, 30)}, {at(6, 10, 0), at(6, 12, 0)}, {at(7, 9, 0), at(7, 11, 0)}, {at(7, 9, 30), at(7, 11, 30)}, {at(7, 10, 0), at(7, 12, 0)}},
+		},
+		{
+			"working hours are in the given location",
+			nil, at(6, 0, 0), at(6, 23, 0), 3 * time.Hour, 0, officeHours, tokyo,
+			[]Interval{{at(6, 0, 0), at(6, 3, 0)}},
+		},
+		{
+			"no slot is long enough",
+			[]Interval{{at(6, 9, 30), at(6, 10, 0)}}, at(6, 9, 0), at(6, 10, 30), time.Hour, 0, WorkingHours{}, time.UTC,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			slots := FreeSlots(tt.busy, tt.from, tt.to, tt.duration, tt.buffer, tt.hours, tt.location)
+			if len(slots) != len(tt.expected) {
+				t.Fatalf("len(FreeSlots()) = %d, want %d", len(slots), len(tt.expected))
+			}
+			for i := range tt.expected {
+				if !slots[i].Start.Equal(tt.expected[i].Start) || !slots[i].End.Equal(tt.expected[i].End) {
+					t.Errorf("slots[%d] = %v, want %v", i, slots[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPickSlots(t *testing.T) {
+	t.Parallel()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name     string
+		slots    []Interval
+		count    int
+		location *time.Location
+		expected []Interval
+	}{
+		{
+			"overlapping slots are skipped",
+			[]Interval{{at(6, 9, 0), at(6, 10, 0)}, {at(6, 9, 30), at(6, 10, 30)}, {at(6, 10, 0), at(6, 11, 0)}}, 10, time.UTC,
+			[]Interval{{at(6, 9, 0), at(6, 10, 0)}, {at(6, 10, 0), at(6, 11, 0)}},
+		},
+		{
+			"days take turns",
+			[]Interval{{at(6, 9, 0), at(6, 10, 0)}, {at(6, 10, 0), at(6, 11, 0)}, {at(6, 11, 0), at(6, 12, 0)}, {at(7, 9, 0), at(7, 10, 0)}, {at(8, 9, 0), at(8, 10, 0)}, {at(8, 10, 0), at(8, 11, 0)}}, 4, time.UTC,
+			[]Interval{{at(6, 9, 0), at(6, 10, 0)}, {at(6, 10, 0), at(6, 11, 0)}, {at(7, 9, 0), at(7, 10, 0)}, {at(8, 9, 0), at(8, 10, 0)}},
+		},
+		{
+			"days are in the given location",
+			[]Interval{{at(6, 13, 0), at(6, 14, 0)}, {at(6, 14, 0), at(6, 15, 0)}, {at(6, 15, 0), at(6, 16, 0)}}, 2, tokyo,
+			[]Interval{{at(6, 13, 0), at(6, 14, 0)}, {at(6, 15, 0), at(6, 16, 0)}},
+		},
+		{
+			"no slots",
+			nil, 10, time.UTC,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			slots := PickSlots(tt.slots, tt.count, tt.location)
+			if len(slots) != len(tt.expected) {
+				t.Fatalf("len(PickSlots()) = %d, want %d", len(slots), len(tt.expected))
+			}
+			for i := range tt.expected {
+				if !slots[i].Start.Equal(tt.expected[i].Start) || !slots[i].End.Equal(tt.expected[i].End) {
+					t.Errorf("slots[%d] = %v, want %v", i, slots[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
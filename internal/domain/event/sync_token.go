@@ -0,0 +1,117 @@
+package event
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+)
+
+// SyncToken marks the time up to which a client has synced its events and
+// the calendars it could read then, and is exchanged with clients as an
+// opaque token.
+type SyncToken struct {
+	syncTime  time.Time
+	calendars string
+}
+
+func (t SyncToken) SyncTime() time.Time {
+	return t.syncTime
+}
+
+func (t SyncToken) Token() string {
+	s := strconv.FormatInt(t.syncTime.UnixNano(), 10) + "|" + t.calendars
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+// SameCalendars reports whether calendarIDs are the calendars the client
+// could read when the token was issued. Events stop being reported once
+// their calendar is no longer readable, so a changed set requires a full
+// sync.
+func (t SyncToken) SameCalendars(calendarIDs []calendar.CalendarID) bool {
+	return t.calendars == calendarsDigest(calendarIDs)
+}
+
+func NewSyncToken(syncTime time.Time, calendarIDs []calendar.CalendarID) SyncToken {
+	return SyncToken{syncTime: syncTime, calendars: calendarsDigest(calendarIDs)}
+}
+
+func NewSyncTokenFromToken(token string) (SyncToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return SyncToken{}, ErrInvalidSyncToken
+	}
+
+	s, calendars, _ := strings.Cut(string(b), "|")
+	nanos, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || nanos <= 0 {
+		return SyncToken{}, ErrInvalidSyncToken
+	}
+
+	return SyncToken{syncTime: time.Unix(0, nanos).UTC(), calendars: calendars}, nil
+}
+
+func calendarsDigest(calendarIDs []calendar.CalendarID) string {
+	ids := make([]string, 0, len(calendarIDs))
+	for _, id := range calendarIDs {
+		ids = append(ids, id.String())
+	}
+	slices.Sort(ids)
+
+	sum := sha256.Sum256([]byte(strings.Join(ids, ",")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// SyncPageToken continues a full sync after the event with the ID afterID.
+// It carries the sync token that the last page returns, so that the changes
+// made while the client pages are picked up by the next sync.
+type SyncPageToken struct {
+	syncToken SyncToken
+	afterID   EventID
+}
+
+func (t SyncPageToken) SyncToken() SyncToken {
+	return t.syncToken
+}
+
+func (t SyncPageToken) AfterID() EventID {
+	return t.afterID
+}
+
+func (t SyncPageToken) Token() string {
+	s := t.syncToken.Token() + "|" + t.afterID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+func NewSyncPageToken(syncToken SyncToken, afterID EventID) SyncPageToken {
+	return SyncPageToken{syncToken: syncToken, afterID: afterID}
+}
+
+func NewSyncPageTokenFromToken(token string) (SyncPageToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return SyncPageToken{}, ErrInvalidPageToken
+	}
+
+	s, id, ok := strings.Cut(string(b), "|")
+	if !ok {
+		return SyncPageToken{}, ErrInvalidPageToken
+	}
+
+	syncToken, err := NewSyncTokenFromToken(s)
+	if err != nil {
+		return SyncPageToken{}, ErrInvalidPageToken
+	}
+
+	afterID, err := NewEventIDFromString(id)
+	if err != nil {
+		return SyncPageToken{}, ErrInvalidPageToken
+	}
+
+	return SyncPageToken{syncToken: syncToken, afterID: afterID}, nil
+}
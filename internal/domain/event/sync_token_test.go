@@ -0,0 +1,118 @@
+package event
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+)
+
+func TestNewSyncTokenFromToken(t *testing.T) {
+	t.Parallel()
+	syncTime := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	calendarIDs := []calendar.CalendarID{calendar.NewCalendarID()}
+	tests := []struct {
+		name    string
+		success bool
+		token   string
+	}{
+		{"success new sync token from token", true, NewSyncToken(syncTime, calendarIDs).Token()},
+		{"failure empty token", false, ""},
+		{"failure invalid base64", false, "!!!"},
+		{"failure not a number", false, "YWJj"},
+		{"failure negative time", false, "LTE"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			syncToken, err := NewSyncTokenFromToken(tt.token)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && !syncToken.SyncTime().Equal(syncTime) {
+				t.Errorf("SyncTime() = %v, want %v", syncToken.SyncTime(), syncTime)
+			}
+			if tt.success && !syncToken.SameCalendars(calendarIDs) {
+				t.Errorf("SameCalendars() = false, want true")
+			}
+		})
+	}
+}
+
+func TestSyncTokenSameCalendars(t *testing.T) {
+	t.Parallel()
+	first, second := calendar.NewCalendarID(), calendar.NewCalendarID()
+	syncToken := NewSyncToken(time.Now(), []calendar.CalendarID{first, second})
+	withoutCalendars, err := NewSyncTokenFromToken(base64.RawURLEncoding.EncodeToString([]byte("1")))
+	if err != nil {
+		t.Fatalf("expected no error, but got %v", err)
+	}
+	tests := []struct {
+		name        string
+		syncToken   SyncToken
+		calendarIDs []calendar.CalendarID
+		expected    bool
+	}{
+		{"same calendars", syncToken, []calendar.CalendarID{first, second}, true},
+		{"same calendars in another order", syncToken, []calendar.CalendarID{second, first}, true},
+		{"calendar removed", syncToken, []calendar.CalendarID{first}, false},
+		{"calendar added", syncToken, []calendar.CalendarID{first, second, calendar.NewCalendarID()}, false},
+		{"token without calendars", withoutCalendars, []calendar.CalendarID{first, second}, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.syncToken.SameCalendars(tt.calendarIDs); got != tt.expected {
+				t.Errorf("SameCalendars() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewSyncPageTokenFromToken(t *testing.T) {
+	t.Parallel()
+	syncToken := NewSyncToken(time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC), []calendar.CalendarID{calendar.NewCalendarID()})
+	afterID := EventID{UUID: uuid.New()}
+	tests := []struct {
+		name    string
+		success bool
+		token   string
+	}{
+		{"success new sync page token from token", true, NewSyncPageToken(syncToken, afterID).Token()},
+		{"failure empty token", false, ""},
+		{"failure invalid base64", false, "!!!"},
+		{"failure missing event id", false, base64.RawURLEncoding.EncodeToString([]byte(syncToken.Token()))},
+		{"failure invalid sync token", false, base64.RawURLEncoding.EncodeToString([]byte("!!!|" + afterID.String()))},
+		{"failure invalid event id", false, base64.RawURLEncoding.EncodeToString([]byte(syncToken.Token() + "|abc"))},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pageToken, err := NewSyncPageTokenFromToken(tt.token)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && pageToken.SyncToken() != syncToken {
+				t.Errorf("SyncToken() = %v, want %v", pageToken.SyncToken(), syncToken)
+			}
+			if tt.success && pageToken.AfterID() != afterID {
+				t.Errorf("AfterID() = %v, want %v", pageToken.AfterID(), afterID)
+			}
+		})
+	}
+}
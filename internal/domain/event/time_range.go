@@ -0,0 +1,85 @@
+package event
+
+import "time"
+
+const DefaultMaxDuration = 366 * 24 * time.Hour
+
+// All-day events are floating dates: a date starts at local midnight, which
+// falls between UTC-12:00 and UTC+14:00 depending on the viewer's zone.
+const (
+	earliestUTCOffset = 14 * time.Hour
+	latestUTCOffset   = 12 * time.Hour
+)
+
+type TimeRange struct {
+	start  time.Time
+	end    time.Time
+	allDay bool
+}
+
+func (r TimeRange) Start() time.Time {
+	return r.start
+}
+
+func (r TimeRange) End() time.Time {
+	return r.end
+}
+
+func (r TimeRange) AllDay() bool {
+	return r.allDay
+}
+
+func (r TimeRange) Duration() time.Duration {
+	return r.end.Sub(r.start)
+}
+
+// NewTimeRange rejects ranges whose end is not after their start and, when
+// maxDuration is positive, ranges longer than maxDuration.
+func NewTimeRange(start, end time.Time, maxDuration time.Duration) (TimeRange, error) {
+	if !end.After(start) {
+		return TimeRange{}, ErrInvalidTimeRange
+	}
+	if maxDuration > 0 && end.Sub(start) > maxDuration {
+		return TimeRange{}, ErrDurationTooLong
+	}
+	return TimeRange{start: start, end: end}, nil
+}
+
+// NewAllDayTimeRange returns a range of whole dates. start and end must be
+// midnight UTC, and end is exclusive.
+func NewAllDayTimeRange(start, end time.Time, maxDuration time.Duration) (TimeRange, error) {
+	if !isDateAligned(start) || !isDateAligned(end) {
+		return TimeRange{}, ErrInvalidAllDayRange
+	}
+
+	r, err := NewTimeRange(start.UTC(), end.UTC(), maxDuration)
+	if err != nil {
+		return TimeRange{}, err
+	}
+	r.allDay = true
+
+	return r, nil
+}
+
+// NewDate returns midnight UTC of the given calendar date, which is how the
+// dates of all-day events are stored.
+func NewDate(year, month, day int) (time.Time, error) {
+	d := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if year < 1 || d.Year() != year || int(d.Month()) != month || d.Day() != day {
+		return time.Time{}, ErrInvalidDate
+	}
+	return d, nil
+}
+
+// AllDayWindow widens a window so that it contains every all-day date that
+// overlaps the window in some time zone.
+func AllDayWindow(from, to time.Time) (time.Time, time.Time) {
+	return from.Add(-latestUTCOffset), to.Add(earliestUTCOffset)
+}
+
+// isDateAligned compares the offset rather than the location, since times
+// read back from the database carry time.Local even when it is UTC.
+func isDateAligned(t time.Time) bool {
+	_, offset := t.Zone()
+	return offset == 0 && t.Equal(t.Truncate(24*time.Hour))
+}
@@ -0,0 +1,120 @@
+package event
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewTimeRange(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		success     bool
+		start       time.Time
+		end         time.Time
+		maxDuration time.Duration
+	}{
+		{"success new time range", true, start, start.Add(time.Hour), DefaultMaxDuration},
+		{"success max duration", true, start, start.Add(DefaultMaxDuration), DefaultMaxDuration},
+		{"success no max duration", true, start, start.AddDate(5, 0, 0), 0},
+		{"failure zero length", false, start, start, DefaultMaxDuration},
+		{"failure inverted", false, start, start.Add(-time.Hour), DefaultMaxDuration},
+		{"failure too long", false, start, start.Add(DefaultMaxDuration + time.Second), DefaultMaxDuration},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			timeRange, err := NewTimeRange(tt.start, tt.end, tt.maxDuration)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && !timeRange.Start().Equal(tt.start) {
+				t.Errorf("Start() = %v, want %v", timeRange.Start(), tt.start)
+			}
+			if tt.success && !timeRange.End().Equal(tt.end) {
+				t.Errorf("End() = %v, want %v", timeRange.End(), tt.end)
+			}
+		})
+	}
+}
+
+func TestNewAllDayTimeRange(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		success     bool
+		start       time.Time
+		end         time.Time
+		maxDuration time.Duration
+	}{
+		{"success single day", true, start, start.AddDate(0, 0, 1), DefaultMaxDuration},
+		{"success multi day", true, start, start.AddDate(0, 0, 5), DefaultMaxDuration},
+		{"failure zero days", false, start, start, DefaultMaxDuration},
+		{"failure start not aligned", false, start.Add(time.Hour), start.AddDate(0, 0, 1), DefaultMaxDuration},
+		{"failure end not aligned", false, start, start.Add(36 * time.Hour), DefaultMaxDuration},
+		{"success utc offset in another location", true, start.In(time.FixedZone("Local", 0)), start.AddDate(0, 0, 1).In(time.FixedZone("Local", 0)), DefaultMaxDuration},
+		{"failure not utc", false, start.In(time.FixedZone("JST", 9*60*60)), start.AddDate(0, 0, 1), DefaultMaxDuration},
+		{"failure too long", false, start, start.AddDate(2, 0, 0), DefaultMaxDuration},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			timeRange, err := NewAllDayTimeRange(tt.start, tt.end, tt.maxDuration)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && !timeRange.AllDay() {
+				t.Errorf("AllDay() = %v, want %v", timeRange.AllDay(), true)
+			}
+			if tt.success && timeRange.Start().Location() != time.UTC {
+				t.Errorf("Start().Location() = %v, want %v", timeRange.Start().Location(), time.UTC)
+			}
+		})
+	}
+}
+
+func TestNewDate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		year    int
+		month   int
+		day     int
+	}{
+		{"success new date", true, 2025, 1, 6},
+		{"success leap day", true, 2024, 2, 29},
+		{"failure zero year", false, 0, 1, 6},
+		{"failure invalid month", false, 2025, 13, 1},
+		{"failure invalid day", false, 2025, 2, 29},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			date, err := NewDate(tt.year, tt.month, tt.day)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && !date.Equal(time.Date(tt.year, time.Month(tt.month), tt.day, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("NewDate() = %v, want %04d-%02d-%02d", date, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+}
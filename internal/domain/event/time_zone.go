@@ -0,0 +1,37 @@
+package event
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+)
+
+type TimeZone struct {
+	name     string
+	location *time.Location
+}
+
+func (z TimeZone) String() string {
+	if z.name == "" {
+		return "UTC"
+	}
+	return z.name
+}
+
+func (z TimeZone) Location() *time.Location {
+	if z.location == nil {
+		return time.UTC
+	}
+	return z.location
+}
+
+// NewTimeZone validates s like calendar.NewTimeZone, so that events accept the
+// same time zones as their calendars. An empty string defaults to UTC.
+func NewTimeZone(s string) (TimeZone, error) {
+	name, location, err := calendar.LoadTimeZone(s)
+	if err != nil {
+		return TimeZone{}, err
+	}
+
+	return TimeZone{name: name.String(), location: location}, nil
+}
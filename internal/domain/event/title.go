@@ -1,10 +1,13 @@
 package event
 
 import (
-	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
+// maxTitleLength is the length of the title column, in characters.
+const maxTitleLength = 255
+
 type Title string
 
 func (t Title) String() string {
@@ -13,8 +16,8 @@ func (t Title) String() string {
 
 func NewTitle(s string) (Title, error) {
 	s = strings.TrimSpace(s)
-	if s == "" {
-		return Title(""), fmt.Errorf("invalid title")
+	if s == "" || utf8.RuneCountInString(s) > maxTitleLength {
+		return Title(""), ErrInvalidTitle
 	}
 	return Title(s), nil
 }
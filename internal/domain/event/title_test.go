@@ -1,6 +1,9 @@
 package event
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestNewTitle(t *testing.T) {
 	t.Parallel()
@@ -11,6 +14,8 @@ func TestNewTitle(t *testing.T) {
 	}{
 		{"success new title", true, "title"},
 		{"failure empty title", false, ""},
+		{"success longest title", true, strings.Repeat("あ", maxTitleLength)},
+		{"failure title too long", false, strings.Repeat("a", maxTitleLength+1)},
 	}
 	for _, tt := range tests {
 		tt := tt
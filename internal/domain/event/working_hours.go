@@ -0,0 +1,58 @@
+package event
+
+import "time"
+
+// WorkingHours is the part of every day, as offsets from midnight, that
+// meetings may be scheduled in. The zero value spans the whole day.
+type WorkingHours struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func (h WorkingHours) Start() time.Duration {
+	return h.start
+}
+
+func (h WorkingHours) End() time.Duration {
+	if h.end == 0 {
+		return 24 * time.Hour
+	}
+	return h.end
+}
+
+// NewWorkingHours parses start and end as "HH:MM". Both empty means the whole
+// day; an end of "24:00" means midnight at the end of the day.
+func NewWorkingHours(start, end string) (WorkingHours, error) {
+	if start == "" && end == "" {
+		return WorkingHours{}, nil
+	}
+
+	startOffset, err := parseTimeOfDay(start)
+	if err != nil {
+		return WorkingHours{}, err
+	}
+
+	endOffset, err := parseTimeOfDay(end)
+	if err != nil {
+		return WorkingHours{}, err
+	}
+
+	if startOffset >= endOffset {
+		return WorkingHours{}, ErrInvalidWorkingHours
+	}
+
+	return WorkingHours{start: startOffset, end: endOffset}, nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, ErrInvalidWorkingHours
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -0,0 +1,48 @@
+package event
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewWorkingHours(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		success       bool
+		start         string
+		end           string
+		expectedStart time.Duration
+		expectedEnd   time.Duration
+	}{
+		{"success new working hours", true, "09:00", "17:30", 9 * time.Hour, 17*time.Hour + 30*time.Minute},
+		{"success whole day", true, "", "", 0, 24 * time.Hour},
+		{"success until midnight", true, "18:00", "24:00", 18 * time.Hour, 24 * time.Hour},
+		{"failure missing end", false, "09:00", "", 0, 0},
+		{"failure invalid format", false, "9am", "17:00", 0, 0},
+		{"failure out of range", false, "09:00", "25:00", 0, 0},
+		{"failure end before start", false, "17:00", "09:00", 0, 0},
+		{"failure empty range", false, "09:00", "09:00", 0, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hours, err := NewWorkingHours(tt.start, tt.end)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if tt.success && hours.Start() != tt.expectedStart {
+				t.Errorf("Start() = %v, want %v", hours.Start(), tt.expectedStart)
+			}
+			if tt.success && hours.End() != tt.expectedEnd {
+				t.Errorf("End() = %v, want %v", hours.End(), tt.expectedEnd)
+			}
+		})
+	}
+}
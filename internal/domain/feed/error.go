@@ -0,0 +1,8 @@
+package feed
+
+import "errors"
+
+var (
+	ErrFeedNotFound = errors.New("feed not found")
+	ErrInvalidToken = errors.New("invalid feed token")
+)
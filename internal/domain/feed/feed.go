@@ -0,0 +1,45 @@
+package feed
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+// Feed is a user's subscribable calendar feed. A user has at most one feed,
+// and rotating its token revokes the previous one.
+type Feed interface {
+	UserID() user.UserID
+	TokenHash() string
+	CreatedAt() time.Time
+}
+
+type feed struct {
+	userID    user.UserID
+	tokenHash string
+	createdAt time.Time
+}
+
+func (f feed) UserID() user.UserID {
+	return f.userID
+}
+
+func (f feed) TokenHash() string {
+	return f.tokenHash
+}
+
+func (f feed) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+func NewFeed(
+	userID user.UserID,
+	tokenHash string,
+	createdAt time.Time,
+) Feed {
+	return &feed{
+		userID:    userID,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+	}
+}
@@ -0,0 +1,42 @@
+package feed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestNewFeed(t *testing.T) {
+	t.Parallel()
+	userID, err := user.NewUserIDFromString("6d322c66-bf4d-427a-970c-874f3745f653")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	tests := []struct {
+		name      string
+		success   bool
+		userID    user.UserID
+		tokenHash string
+		createdAt time.Time
+	}{
+		{"success new feed", true, userID, Token("token").Hash(), time.Now()},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			feed := NewFeed(tt.userID, tt.tokenHash, tt.createdAt)
+			if tt.success && feed.UserID() != tt.userID {
+				t.Errorf("UserID() = %v, want %v", feed.UserID(), tt.userID)
+			}
+			if tt.success && feed.TokenHash() != tt.tokenHash {
+				t.Errorf("TokenHash() = %v, want %v", feed.TokenHash(), tt.tokenHash)
+			}
+			if tt.success && !feed.CreatedAt().Equal(tt.createdAt) {
+				t.Errorf("CreatedAt() = %v, want %v", feed.CreatedAt(), tt.createdAt)
+			}
+		})
+	}
+}
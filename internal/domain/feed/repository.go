@@ -0,0 +1,6 @@
+package feed
+
+type FeedRepository interface {
+	Save(feed Feed) error
+	FindByTokenHash(tokenHash string) (Feed, error)
+}
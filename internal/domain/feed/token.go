@@ -0,0 +1,39 @@
+package feed
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const tokenBytes = 32
+
+// Token is the secret that authenticates requests for a feed. Only its hash
+// is stored.
+type Token string
+
+func (t Token) String() string {
+	return string(t)
+}
+
+func (t Token) Hash() string {
+	sum := sha256.Sum256([]byte(t))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewToken() (Token, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return Token(""), err
+	}
+	return Token(base64.RawURLEncoding.EncodeToString(b)), nil
+}
+
+func NewTokenFromString(s string) (Token, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) != tokenBytes {
+		return Token(""), ErrInvalidToken
+	}
+	return Token(s), nil
+}
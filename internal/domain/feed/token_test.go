@@ -0,0 +1,54 @@
+package feed
+
+import (
+	"testing"
+)
+
+func TestNewToken(t *testing.T) {
+	t.Parallel()
+	token, err := NewToken()
+	if err != nil {
+		t.Errorf("expected no error, but got %v", err)
+	}
+	if _, err := NewTokenFromString(token.String()); err != nil {
+		t.Errorf("expected no error, but got %v", err)
+	}
+	other, err := NewToken()
+	if err != nil {
+		t.Errorf("expected no error, but got %v", err)
+	}
+	if token == other {
+		t.Errorf("NewToken() returned the same token twice: %v", token)
+	}
+	if token.Hash() == other.Hash() {
+		t.Errorf("Hash() collided for different tokens")
+	}
+}
+
+func TestNewTokenFromString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		token   string
+	}{
+		{"success new token from string", true, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"},
+		{"failure empty token", false, ""},
+		{"failure short token", false, "AAAA"},
+		{"failure invalid encoding", false, "!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewTokenFromString(tt.token)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package webhook
+
+import "time"
+
+const (
+	// MaxAttempts is how many times a delivery is tried before it fails.
+	MaxAttempts = 8
+	baseBackoff = time.Minute
+	maxBackoff  = time.Hour
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+	// DeliveryStatusCancelled is the status of the deliveries that were
+	// pending when their webhook was disabled.
+	DeliveryStatusCancelled DeliveryStatus = "cancelled"
+)
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
+
+// Attempt is one try at sending a delivery. StatusCode is zero when no
+// response was received.
+type Attempt struct {
+	statusCode  int
+	errMessage  string
+	attemptedAt time.Time
+}
+
+func (a Attempt) StatusCode() int {
+	return a.statusCode
+}
+
+func (a Attempt) Error() string {
+	return a.errMessage
+}
+
+func (a Attempt) AttemptedAt() time.Time {
+	return a.attemptedAt
+}
+
+func (a Attempt) Succeeded() bool {
+	return a.errMessage == "" && a.statusCode >= 200 && a.statusCode < 300
+}
+
+func NewAttempt(statusCode int, errMessage string, attemptedAt time.Time) Attempt {
+	return Attempt{statusCode: statusCode, errMessage: errMessage, attemptedAt: attemptedAt}
+}
+
+// Delivery is the payload of one change sent to one webhook, retried with
+// exponential backoff until it succeeds or runs out of attempts.
+type Delivery interface {
+	ID() DeliveryID
+	WebhookID() WebhookID
+	ChangeID() int64
+	EventType() EventType
+	Payload() []byte
+	Status() DeliveryStatus
+	Attempts() []Attempt
+	NextAttemptAt() time.Time
+	CreatedAt() time.Time
+	RecordAttempt(attempt Attempt)
+}
+
+type delivery struct {
+	id            DeliveryID
+	webhookID     WebhookID
+	changeID      int64
+	eventType     EventType
+	payload       []byte
+	status        DeliveryStatus
+	attempts      []Attempt
+	nextAttemptAt time.Time
+	createdAt     time.Time
+}
+
+func (d delivery) ID() DeliveryID {
+	return d.id
+}
+
+func (d delivery) WebhookID() WebhookID {
+	return d.webhookID
+}
+
+// ChangeID is the outbox change the delivery was made for.
+func (d delivery) ChangeID() int64 {
+	return d.changeID
+}
+
+func (d delivery) EventType() EventType {
+	return d.eventType
+}
+
+func (d delivery) Payload() []byte {
+	return d.payload
+}
+
+func (d delivery) Status() DeliveryStatus {
+	return d.status
+}
+
+func (d delivery) Attempts() []Attempt {
+	return d.attempts
+}
+
+// NextAttemptAt is zero once the delivery has succeeded, failed or been
+// cancelled.
+func (d delivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+func (d delivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *delivery) RecordAttempt(attempt Attempt) {
+	d.attempts = append(d.attempts, attempt)
+
+	switch {
+	case attempt.Succeeded():
+		d.status = DeliveryStatusSucceeded
+		d.nextAttemptAt = time.Time{}
+	case len(d.attempts) >= MaxAttempts:
+		d.status = DeliveryStatusFailed
+		d.nextAttemptAt = time.Time{}
+	default:
+		d.nextAttemptAt = attempt.AttemptedAt().Add(backoff(len(d.attempts)))
+	}
+}
+
+// backoff doubles the wait after each failed attempt, up to maxBackoff.
+func backoff(attempts int) time.Duration {
+	wait := baseBackoff
+	for i := 1; i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+
+	return min(wait, maxBackoff)
+}
+
+func NewDelivery(
+	id DeliveryID,
+	webhookID WebhookID,
+	changeID int64,
+	eventType EventType,
+	payload []byte,
+	status DeliveryStatus,
+	attempts []Attempt,
+	nextAttemptAt time.Time,
+	createdAt time.Time,
+) Delivery {
+	return &delivery{
+		id:            id,
+		webhookID:     webhookID,
+		changeID:      changeID,
+		eventType:     eventType,
+		payload:       payload,
+		status:        status,
+		attempts:      attempts,
+		nextAttemptAt: nextAttemptAt,
+		createdAt:     createdAt,
+	}
+}
@@ -0,0 +1,12 @@
+package webhook
+
+import "github.com/google/uuid"
+
+type DeliveryID struct {
+	uuid.UUID
+}
+
+func NewDeliveryID() DeliveryID {
+	id := uuid.New()
+	return DeliveryID{id}
+}
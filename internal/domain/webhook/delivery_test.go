@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAttemptSucceeded(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		success    bool
+		statusCode int
+		errMessage string
+	}{
+		{"success ok", true, 200, ""},
+		{"success no content", true, 204, ""},
+		{"failure server error", false, 500, ""},
+		{"failure redirect", false, 302, ""},
+		{"failure no response", false, 0, "connection refused"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			attempt := NewAttempt(tt.statusCode, tt.errMessage, time.Now())
+			if attempt.Succeeded() != tt.success {
+				t.Errorf("Succeeded() = %v, want %v", attempt.Succeeded(), tt.success)
+			}
+		})
+	}
+}
+
+func TestRecordAttempt(t *testing.T) {
+	t.Parallel()
+	attemptedAt := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	failures := func(n int) []Attempt {
+		attempts := make([]Attempt, n)
+		for i := range attempts {
+			attempts[i] = NewAttempt(500, "", attemptedAt)
+		}
+		return attempts
+	}
+	tests := []struct {
+		name                  string
+		success               bool
+		attempts              []Attempt
+		attempt               Attempt
+		expectedStatus        DeliveryStatus
+		expectedNextAttemptAt time.Time
+	}{
+		{"success first attempt succeeded", true, nil, NewAttempt(200, "", attemptedAt), DeliveryStatusSucceeded, time.Time{}},
+		{"success first attempt failed", true, nil, NewAttempt(500, "", attemptedAt), DeliveryStatusPending, attemptedAt.Add(time.Minute)},
+		{"success third attempt failed", true, failures(2), NewAttempt(0, "timeout", attemptedAt), DeliveryStatusPending, attemptedAt.Add(4 * time.Minute)},
+		{"success backoff capped", true, failures(MaxAttempts - 2), NewAttempt(500, "", attemptedAt), DeliveryStatusPending, attemptedAt.Add(time.Hour)},
+		{"success last attempt failed", true, failures(MaxAttempts - 1), NewAttempt(500, "", attemptedAt), DeliveryStatusFailed, time.Time{}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			delivery := NewDelivery(NewDeliveryID(), NewWebhookID(), 1, EventTypeCreated, []byte(`{}`), DeliveryStatusPending, tt.attempts, attemptedAt, attemptedAt)
+			delivery.RecordAttempt(tt.attempt)
+
+			if tt.success && len(delivery.Attempts()) != len(tt.attempts)+1 {
+				t.Errorf("len(Attempts()) = %v, want %v", len(delivery.Attempts()), len(tt.attempts)+1)
+			}
+			if tt.success && delivery.Status() != tt.expectedStatus {
+				t.Errorf("Status() = %v, want %v", delivery.Status(), tt.expectedStatus)
+			}
+			if tt.success && !delivery.NextAttemptAt().Equal(tt.expectedNextAttemptAt) {
+				t.Errorf("NextAttemptAt() = %v, want %v", delivery.NextAttemptAt(), tt.expectedNextAttemptAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package webhook
+
+import "errors"
+
+var (
+	ErrWebhookNotFound   = errors.New("webhook not found")
+	ErrDeliveryNotFound  = errors.New("webhook delivery not found")
+	ErrInvalidWebhookID  = errors.New("invalid webhook id")
+	ErrInvalidURL        = errors.New("invalid webhook url")
+	ErrInvalidEventType  = errors.New("invalid event type")
+	ErrInvalidUpdateMask = errors.New("invalid update mask")
+	ErrInvalidPageSize   = errors.New("invalid page size")
+)
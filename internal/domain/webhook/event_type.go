@@ -0,0 +1,59 @@
+package webhook
+
+import "github.com/qkitzero/event-service/internal/domain/event"
+
+type EventType string
+
+const (
+	EventTypeCreated EventType = "event.created"
+	EventTypeUpdated EventType = "event.updated"
+	EventTypeDeleted EventType = "event.deleted"
+)
+
+func (t EventType) String() string {
+	return string(t)
+}
+
+func NewEventType(s string) (EventType, error) {
+	switch t := EventType(s); t {
+	case EventTypeCreated, EventTypeUpdated, EventTypeDeleted:
+		return t, nil
+	}
+
+	return EventType(""), ErrInvalidEventType
+}
+
+// NewEventTypes parses a filter of event types, dropping repeats. An empty
+// filter subscribes to every event type.
+func NewEventTypes(ss []string) ([]EventType, error) {
+	var eventTypes []EventType
+	seen := make(map[EventType]bool, len(ss))
+	for _, s := range ss {
+		t, err := NewEventType(s)
+		if err != nil {
+			return nil, err
+		}
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		eventTypes = append(eventTypes, t)
+	}
+
+	return eventTypes, nil
+}
+
+// EventTypeFromChange reports the event type webhooks are notified of for a
+// change recorded in the outbox.
+func EventTypeFromChange(changeType event.ChangeType) (EventType, bool) {
+	switch changeType {
+	case event.ChangeTypeCreated:
+		return EventTypeCreated, true
+	case event.ChangeTypeUpdated:
+		return EventTypeUpdated, true
+	case event.ChangeTypeDeleted:
+		return EventTypeDeleted, true
+	}
+
+	return EventType(""), false
+}
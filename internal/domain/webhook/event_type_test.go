@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+func TestNewEventType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		success   bool
+		eventType string
+	}{
+		{"success event created", true, "event.created"},
+		{"success event updated", true, "event.updated"},
+		{"success event deleted", true, "event.deleted"},
+		{"failure empty event type", false, ""},
+		{"failure unknown event type", false, "event.moved"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			eventType, err := NewEventType(tt.eventType)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if tt.success && eventType.String() != tt.eventType {
+				t.Errorf("String() = %v, want %v", eventType.String(), tt.eventType)
+			}
+		})
+	}
+}
+
+func TestNewEventTypes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name               string
+		success            bool
+		eventTypes         []string
+		expectedEventTypes []EventType
+	}{
+		{"success empty event types", true, nil, nil},
+		{"success drop repeated event types", true, []string{"event.created", "event.deleted", "event.created"}, []EventType{EventTypeCreated, EventTypeDeleted}},
+		{"failure invalid event type", false, []string{"event.created", "event.moved"}, nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			eventTypes, err := NewEventTypes(tt.eventTypes)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if tt.success && !slices.Equal(eventTypes, tt.expectedEventTypes) {
+				t.Errorf("NewEventTypes() = %v, want %v", eventTypes, tt.expectedEventTypes)
+			}
+		})
+	}
+}
+
+func TestEventTypeFromChange(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		success           bool
+		changeType        event.ChangeType
+		expectedEventType EventType
+	}{
+		{"success created", true, event.ChangeTypeCreated, EventTypeCreated},
+		{"success updated", true, event.ChangeTypeUpdated, EventTypeUpdated},
+		{"success deleted", true, event.ChangeTypeDeleted, EventTypeDeleted},
+		{"failure unknown change type", false, event.ChangeType("event.moved"), EventType("")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			eventType, ok := EventTypeFromChange(tt.changeType)
+			if ok != tt.success {
+				t.Errorf("EventTypeFromChange() ok = %v, want %v", ok, tt.success)
+			}
+			if eventType != tt.expectedEventType {
+				t.Errorf("EventTypeFromChange() = %v, want %v", eventType, tt.expectedEventType)
+			}
+		})
+	}
+}
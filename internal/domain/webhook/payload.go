@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+type payload struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      payloadData `json:"data"`
+}
+
+type payloadData struct {
+	Event payloadEvent `json:"event"`
+}
+
+type payloadEvent struct {
+	ID          string            `json:"id"`
+	UserID      string            `json:"user_id"`
+	CalendarID  string            `json:"calendar_id"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	StartTime   time.Time         `json:"start_time"`
+	EndTime     time.Time         `json:"end_time"`
+	AllDay      bool              `json:"all_day"`
+	TimeZone    string            `json:"time_zone"`
+	Color       string            `json:"color"`
+	Recurrence  []string          `json:"recurrence"`
+	Attendees   []payloadAttendee `json:"attendees"`
+	Version     int64             `json:"version"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	DeletedAt   *time.Time        `json:"deleted_at"`
+}
+
+type payloadAttendee struct {
+	UserID         string `json:"user_id"`
+	Email          string `json:"email"`
+	Role           string `json:"role"`
+	ResponseStatus string `json:"response_status"`
+}
+
+// NewPayload builds the JSON body of a delivery from the state of the event
+// after the change. Deleted events are sent with their deleted_at set.
+func NewPayload(id DeliveryID, eventType EventType, e event.Event, occurredAt time.Time) ([]byte, error) {
+	attendees := make([]payloadAttendee, 0, len(e.Attendees()))
+	for _, a := range e.Attendees() {
+		attendees = append(attendees, payloadAttendee{
+			UserID:         a.UserID().String(),
+			Email:          a.Email(),
+			Role:           a.Role().String(),
+			ResponseStatus: a.ResponseStatus().String(),
+		})
+	}
+
+	recurrence := e.Recurrence().Lines()
+	if recurrence == nil {
+		recurrence = []string{}
+	}
+
+	var deletedAt *time.Time
+	if e.IsDeleted() {
+		t := e.DeletedAt()
+		deletedAt = &t
+	}
+
+	return json.Marshal(payload{
+		ID:        id.String(),
+		Type:      eventType.String(),
+		CreatedAt: occurredAt,
+		Data: payloadData{
+			Event: payloadEvent{
+				ID:          e.ID().String(),
+				UserID:      e.UserID().String(),
+				CalendarID:  e.CalendarID().String(),
+				Title:       e.Title().String(),
+				Description: e.Description().String(),
+				StartTime:   e.StartTime(),
+				EndTime:     e.EndTime(),
+				AllDay:      e.AllDay(),
+				TimeZone:    e.TimeZone().String(),
+				Color:       e.Color().String(),
+				Recurrence:  recurrence,
+				Attendees:   attendees,
+				Version:     e.Version(),
+				CreatedAt:   e.CreatedAt(),
+				UpdatedAt:   e.UpdatedAt(),
+				DeletedAt:   deletedAt,
+			},
+		},
+	})
+}
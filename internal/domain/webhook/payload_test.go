@@ -0,0 +1,65 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestNewPayload(t *testing.T) {
+	t.Parallel()
+	deliveryID := NewDeliveryID()
+	startTime := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	occurredAt := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	newEvent := func(deletedAt time.Time) event.Event {
+		return event.NewEvent(event.NewEventID(), user.UserID{}, calendar.CalendarID{}, event.Title("Standup"), event.Description(""), startTime, startTime.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "uid", 2, occurredAt, occurredAt, deletedAt)
+	}
+	tests := []struct {
+		name            string
+		success         bool
+		eventType       EventType
+		event           event.Event
+		expectedDeleted bool
+	}{
+		{"success created payload", true, EventTypeCreated, newEvent(time.Time{}), false},
+		{"success deleted payload", true, EventTypeDeleted, newEvent(occurredAt), true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := NewPayload(deliveryID, tt.eventType, tt.event, occurredAt)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+
+			var decoded payload
+			if err := json.Unmarshal(body, &decoded); err != nil {
+				t.Fatalf("failed to unmarshal payload: %v", err)
+			}
+			if tt.success && decoded.ID != deliveryID.String() {
+				t.Errorf("id = %v, want %v", decoded.ID, deliveryID.String())
+			}
+			if tt.success && decoded.Type != tt.eventType.String() {
+				t.Errorf("type = %v, want %v", decoded.Type, tt.eventType)
+			}
+			if tt.success && !decoded.CreatedAt.Equal(occurredAt) {
+				t.Errorf("created_at = %v, want %v", decoded.CreatedAt, occurredAt)
+			}
+			if tt.success && decoded.Data.Event.ID != tt.event.ID().String() {
+				t.Errorf("data.event.id = %v, want %v", decoded.Data.Event.ID, tt.event.ID().String())
+			}
+			if tt.success && decoded.Data.Event.Title != "Standup" {
+				t.Errorf("data.event.title = %v, want %v", decoded.Data.Event.Title, "Standup")
+			}
+			if tt.success && (decoded.Data.Event.DeletedAt != nil) != tt.expectedDeleted {
+				t.Errorf("data.event.deleted_at = %v, want deleted %v", decoded.Data.Event.DeletedAt, tt.expectedDeleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type WebhookRepository interface {
+	Create(webhook Webhook) error
+	// Update cancels the pending deliveries of a disabled webhook.
+	Update(webhook Webhook) error
+	Delete(id WebhookID) error
+	FindByID(id WebhookID) (Webhook, error)
+	FindAllByUserID(userID user.UserID) ([]Webhook, error)
+}
+
+type DeliveryRepository interface {
+	// CreateAll skips deliveries already made for the same webhook and
+	// change, so that a change relayed twice is only delivered once.
+	CreateAll(deliveries []Delivery) error
+	Update(delivery Delivery) error
+	FindAllByWebhookID(webhookID WebhookID, limit int) ([]Delivery, error)
+	// ClaimDue returns the pending deliveries of enabled webhooks that are
+	// due by now and pushes their next attempt back by lease, so that
+	// concurrent callers never send the same delivery at once.
+	ClaimDue(now time.Time, lease time.Duration, limit int) ([]Delivery, error)
+}
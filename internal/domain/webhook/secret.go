@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+const secretBytes = 32
+
+// Secret is shared with the receiver of a webhook so that it can verify
+// the signature of each delivery.
+type Secret string
+
+func (s Secret) String() string {
+	return string(s)
+}
+
+func NewSecret() (Secret, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return Secret(""), err
+	}
+	return Secret("whsec_" + hex.EncodeToString(b)), nil
+}
+
+// Sign returns the HMAC-SHA256 of the Unix timestamp and body joined by a
+// dot, so that receivers can reject replayed deliveries by their timestamp.
+func (s Secret) Sign(timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(s))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewSecret(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+	}{
+		{"success new secret", true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			secret, err := NewSecret()
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+
+			if tt.success && (!strings.HasPrefix(secret.String(), "whsec_") || len(secret.String()) != len("whsec_")+2*secretBytes) {
+				t.Errorf("NewSecret() = %v, want whsec_ and %d hex characters", secret, 2*secretBytes)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	timestamp := time.Unix(1700000000, 0)
+	tests := []struct {
+		name              string
+		success           bool
+		secret            Secret
+		timestamp         time.Time
+		body              []byte
+		expectedSignature string
+	}{
+		{"success sign", true, Secret("secret"), timestamp, []byte(`{"id":"1"}`), "sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54"},
+		{"failure different secret", false, Secret("other"), timestamp, []byte(`{"id":"1"}`), "sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54"},
+		{"failure different timestamp", false, Secret("secret"), timestamp.Add(time.Second), []byte(`{"id":"1"}`), "sha256=086f6aff7bd084c98679825129c5a64dbad88c760016d6d2c0fb123f27951d54"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			signature := tt.secret.Sign(tt.timestamp, tt.body)
+			if tt.success && signature != tt.expectedSignature {
+				t.Errorf("Sign() = %v, want %v", signature, tt.expectedSignature)
+			}
+			if !tt.success && signature == tt.expectedSignature {
+				t.Errorf("Sign() = %v, want a different signature", signature)
+			}
+		})
+	}
+}
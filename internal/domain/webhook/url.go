@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"net/url"
+	"strings"
+)
+
+type URL string
+
+func (u URL) String() string {
+	return string(u)
+}
+
+// Secure reports whether deliveries to the URL are sent over TLS.
+func (u URL) Secure() bool {
+	parsed, err := url.Parse(string(u))
+	return err == nil && parsed.Scheme == "https"
+}
+
+// NewURL accepts absolute http and https URLs.
+func NewURL(s string) (URL, error) {
+	s = strings.TrimSpace(s)
+	parsed, err := url.Parse(s)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return URL(""), ErrInvalidURL
+	}
+	return URL(s), nil
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import "testing"
+
+func TestNewURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		success     bool
+		url         string
+		expectedURL string
+	}{
+		{"success new https url", true, "https://example.com/hooks", "https://example.com/hooks"},
+		{"success new upper-case https url", true, "HTTPS://example.com/hooks", "HTTPS://example.com/hooks"},
+		{"success new http url", true, " http://localhost:8080/hooks ", "http://localhost:8080/hooks"},
+		{"failure empty url", false, "", ""},
+		{"failure relative url", false, "/hooks", ""},
+		{"failure unsupported scheme", false, "ftp://example.com/hooks", ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			url, err := NewURL(tt.url)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if tt.success && url.String() != tt.expectedURL {
+				t.Errorf("String() = %v, want %v", url.String(), tt.expectedURL)
+			}
+		})
+	}
+}
+
+func TestURLSecure(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		url      URL
+		expected bool
+	}{
+		{"https url", URL("https://example.com/hooks"), true},
+		{"upper-case https url", URL("HTTPS://example.com/hooks"), true},
+		{"http url", URL("http://example.com/hooks"), false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.url.Secure(); got != tt.expected {
+				t.Errorf("Secure() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
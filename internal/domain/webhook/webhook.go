@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"slices"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+// MaxConsecutiveFailures is how many deliveries in a row may fail before a
+// webhook is disabled.
+const MaxConsecutiveFailures = 10
+
+// Webhook is an endpoint a user registers to be called when their events
+// change.
+type Webhook interface {
+	ID() WebhookID
+	UserID() user.UserID
+	URL() URL
+	EventTypes() []EventType
+	Secret() Secret
+	Enabled() bool
+	ConsecutiveFailures() int
+	CreatedAt() time.Time
+	UpdatedAt() time.Time
+	Subscribes(eventType EventType) bool
+	Update(url URL, eventTypes []EventType, enabled bool)
+	RecordSuccess()
+	RecordFailure()
+}
+
+type webhook struct {
+	id                  WebhookID
+	userID              user.UserID
+	url                 URL
+	eventTypes          []EventType
+	secret              Secret
+	enabled             bool
+	consecutiveFailures int
+	createdAt           time.Time
+	updatedAt           time.Time
+}
+
+func (w webhook) ID() WebhookID {
+	return w.id
+}
+
+func (w webhook) UserID() user.UserID {
+	return w.userID
+}
+
+func (w webhook) URL() URL {
+	return w.url
+}
+
+func (w webhook) EventTypes() []EventType {
+	return w.eventTypes
+}
+
+func (w webhook) Secret() Secret {
+	return w.secret
+}
+
+func (w webhook) Enabled() bool {
+	return w.enabled
+}
+
+func (w webhook) ConsecutiveFailures() int {
+	return w.consecutiveFailures
+}
+
+func (w webhook) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+func (w webhook) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+// Subscribes reports whether eventType passes the webhook's filter. An empty
+// filter passes every event type.
+func (w webhook) Subscribes(eventType EventType) bool {
+	return len(w.eventTypes) == 0 || slices.Contains(w.eventTypes, eventType)
+}
+
+// Update re-enabling a webhook gives it a fresh allowance of failures.
+func (w *webhook) Update(url URL, eventTypes []EventType, enabled bool) {
+	if enabled && !w.enabled {
+		w.consecutiveFailures = 0
+	}
+	w.url = url
+	w.eventTypes = eventTypes
+	w.enabled = enabled
+	w.updatedAt = time.Now()
+}
+
+func (w *webhook) RecordSuccess() {
+	w.consecutiveFailures = 0
+}
+
+// RecordFailure disables the webhook once MaxConsecutiveFailures deliveries
+// in a row have failed.
+func (w *webhook) RecordFailure() {
+	w.consecutiveFailures++
+	if w.consecutiveFailures >= MaxConsecutiveFailures && w.enabled {
+		w.enabled = false
+		w.updatedAt = time.Now()
+	}
+}
+
+func NewWebhook(
+	id WebhookID,
+	userID user.UserID,
+	url URL,
+	eventTypes []EventType,
+	secret Secret,
+	enabled bool,
+	consecutiveFailures int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Webhook {
+	return &webhook{
+		id:                  id,
+		userID:              userID,
+		url:                 url,
+		eventTypes:          eventTypes,
+		secret:              secret,
+		enabled:             enabled,
+		consecutiveFailures: consecutiveFailures,
+		createdAt:           createdAt,
+		updatedAt:           updatedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type WebhookID struct {
+	uuid.UUID
+}
+
+func NewWebhookID() WebhookID {
+	id := uuid.New()
+	return WebhookID{id}
+}
+
+func NewWebhookIDFromString(s string) (WebhookID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return WebhookID{}, fmt.Errorf("%w: %v", ErrInvalidWebhookID, err)
+	}
+	return WebhookID{id}, nil
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewWebhookID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+	}{
+		{"success new webhook id", true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id := NewWebhookID()
+			if tt.success && id.UUID == uuid.Nil {
+				t.Errorf("expected valid webhook id, but got a nil UUID")
+			}
+		})
+	}
+}
+
+func TestNewWebhookIDFromString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		id      string
+	}{
+		{"success new webhook id from string", true, "fe8c2263-bbac-4bb9-a41d-b04f5afc4425"},
+		{"failure empty webhook id", false, ""},
+		{"failure invalid webhook id", false, "0123456789"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewWebhookIDFromString(tt.id)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
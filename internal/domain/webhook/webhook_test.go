@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestNewWebhook(t *testing.T) {
+	t.Parallel()
+	id, err := NewWebhookIDFromString("fe8c2263-bbac-4bb9-a41d-b04f5afc4425")
+	if err != nil {
+		t.Errorf("failed to new webhook id: %v", err)
+	}
+	userID, err := user.NewUserIDFromString("6d322c66-bf4d-427a-970c-874f3745f653")
+	if err != nil {
+		t.Errorf("failed to new user id: %v", err)
+	}
+	tests := []struct {
+		name                string
+		success             bool
+		id                  WebhookID
+		userID              user.UserID
+		url                 URL
+		eventTypes          []EventType
+		secret              Secret
+		enabled             bool
+		consecutiveFailures int
+		createdAt           time.Time
+		updatedAt           time.Time
+	}{
+		{"success new webhook", true, id, userID, URL("https://example.com/hooks"), []EventType{EventTypeCreated}, Secret("secret"), true, 0, time.Now(), time.Now()},
+		{"success new disabled webhook", true, id, userID, URL("https://example.com/hooks"), nil, Secret("secret"), false, MaxConsecutiveFailures, time.Now(), time.Now()},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := NewWebhook(tt.id, tt.userID, tt.url, tt.eventTypes, tt.secret, tt.enabled, tt.consecutiveFailures, tt.createdAt, tt.updatedAt)
+			if tt.success && webhook.ID() != tt.id {
+				t.Errorf("ID() = %v, want %v", webhook.ID(), tt.id)
+			}
+			if tt.success && webhook.UserID() != tt.userID {
+				t.Errorf("UserID() = %v, want %v", webhook.UserID(), tt.userID)
+			}
+			if tt.success && webhook.URL() != tt.url {
+				t.Errorf("URL() = %v, want %v", webhook.URL(), tt.url)
+			}
+			if tt.success && !slices.Equal(webhook.EventTypes(), tt.eventTypes) {
+				t.Errorf("EventTypes() = %v, want %v", webhook.EventTypes(), tt.eventTypes)
+			}
+			if tt.success && webhook.Secret() != tt.secret {
+				t.Errorf("Secret() = %v, want %v", webhook.Secret(), tt.secret)
+			}
+			if tt.success && webhook.Enabled() != tt.enabled {
+				t.Errorf("Enabled() = %v, want %v", webhook.Enabled(), tt.enabled)
+			}
+			if tt.success && webhook.ConsecutiveFailures() != tt.consecutiveFailures {
+				t.Errorf("ConsecutiveFailures() = %v, want %v", webhook.ConsecutiveFailures(), tt.consecutiveFailures)
+			}
+			if tt.success && !webhook.CreatedAt().Equal(tt.createdAt) {
+				t.Errorf("CreatedAt() = %v, want %v", webhook.CreatedAt(), tt.createdAt)
+			}
+			if tt.success && !webhook.UpdatedAt().Equal(tt.updatedAt) {
+				t.Errorf("UpdatedAt() = %v, want %v", webhook.UpdatedAt(), tt.updatedAt)
+			}
+		})
+	}
+}
+
+func TestSubscribes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		success    bool
+		eventTypes []EventType
+		eventType  EventType
+	}{
+		{"success empty filter", true, nil, EventTypeDeleted},
+		{"success filtered event type", true, []EventType{EventTypeCreated, EventTypeUpdated}, EventTypeUpdated},
+		{"failure unfiltered event type", false, []EventType{EventTypeCreated, EventTypeUpdated}, EventTypeDeleted},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := NewWebhook(NewWebhookID(), user.UserID{}, URL("https://example.com/hooks"), tt.eventTypes, Secret("secret"), true, 0, time.Now(), time.Now())
+			if webhook.Subscribes(tt.eventType) != tt.success {
+				t.Errorf("Subscribes() = %v, want %v", webhook.Subscribes(tt.eventType), tt.success)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                        string
+		success                     bool
+		enabled                     bool
+		consecutiveFailures         int
+		updateEnabled               bool
+		expectedConsecutiveFailures int
+	}{
+		{"success update enabled webhook", true, true, 3, true, 3},
+		{"success re-enable webhook", true, false, MaxConsecutiveFailures, true, 0},
+		{"success disable webhook", true, true, 3, false, 3},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := NewWebhook(NewWebhookID(), user.UserID{}, URL("https://example.com/hooks"), nil, Secret("secret"), tt.enabled, tt.consecutiveFailures, time.Now(), time.Now())
+			url := URL("https://example.com/other")
+			eventTypes := []EventType{EventTypeDeleted}
+			webhook.Update(url, eventTypes, tt.updateEnabled)
+
+			if tt.success && webhook.URL() != url {
+				t.Errorf("URL() = %v, want %v", webhook.URL(), url)
+			}
+			if tt.success && !slices.Equal(webhook.EventTypes(), eventTypes) {
+				t.Errorf("EventTypes() = %v, want %v", webhook.EventTypes(), eventTypes)
+			}
+			if tt.success && webhook.Enabled() != tt.updateEnabled {
+				t.Errorf("Enabled() = %v, want %v", webhook.Enabled(), tt.updateEnabled)
+			}
+			if tt.success && webhook.ConsecutiveFailures() != tt.expectedConsecutiveFailures {
+				t.Errorf("ConsecutiveFailures() = %v, want %v", webhook.ConsecutiveFailures(), tt.expectedConsecutiveFailures)
+			}
+		})
+	}
+}
+
+func TestRecordFailure(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                string
+		success             bool
+		consecutiveFailures int
+		expectedEnabled     bool
+	}{
+		{"success keep enabled", true, 0, true},
+		{"success disable after repeated failures", true, MaxConsecutiveFailures - 1, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhook := NewWebhook(NewWebhookID(), user.UserID{}, URL("https://example.com/hooks"), nil, Secret("secret"), true, tt.consecutiveFailures, time.Now(), time.Now())
+			webhook.RecordFailure()
+
+			if tt.success && webhook.ConsecutiveFailures() != tt.consecutiveFailures+1 {
+				t.Errorf("ConsecutiveFailures() = %v, want %v", webhook.ConsecutiveFailures(), tt.consecutiveFailures+1)
+			}
+			if tt.success && webhook.Enabled() != tt.expectedEnabled {
+				t.Errorf("Enabled() = %v, want %v", webhook.Enabled(), tt.expectedEnabled)
+			}
+
+			webhook.RecordSuccess()
+			if tt.success && webhook.ConsecutiveFailures() != 0 {
+				t.Errorf("ConsecutiveFailures() = %v, want 0", webhook.ConsecutiveFailures())
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type CalendarModel struct {
+	ID        calendar.CalendarID
+	UserID    user.UserID
+	Name      calendar.Name
+	Color     calendar.Color
+	TimeZone  calendar.TimeZone
+	IsDefault bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (CalendarModel) TableName() string {
+	return "calendars"
+}
+
+func newCalendarModel(c calendar.Calendar) CalendarModel {
+	return CalendarModel{
+		ID:        c.ID(),
+		UserID:    c.UserID(),
+		Name:      c.Name(),
+		Color:     c.Color(),
+		TimeZone:  c.TimeZone(),
+		IsDefault: c.IsDefault(),
+		CreatedAt: c.CreatedAt(),
+		UpdatedAt: c.UpdatedAt(),
+	}
+}
+
+func (m CalendarModel) toCalendar() calendar.Calendar {
+	return calendar.NewCalendar(
+		m.ID,
+		m.UserID,
+		m.Name,
+		m.Color,
+		m.TimeZone,
+		m.IsDefault,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
+
+func toCalendars(calendarModels []CalendarModel) []calendar.Calendar {
+	var calendars []calendar.Calendar
+	for _, calendarModel := range calendarModels {
+		calendars = append(calendars, calendarModel.toCalendar())
+	}
+
+	return calendars
+}
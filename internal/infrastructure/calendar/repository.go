@@ -0,0 +1,118 @@
+package calendar
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+	infraevent "github.com/qkitzero/event-service/internal/infrastructure/event"
+)
+
+type calendarRepository struct {
+	db *gorm.DB
+}
+
+func NewCalendarRepository(db *gorm.DB) calendar.CalendarRepository {
+	return &calendarRepository{db: db}
+}
+
+func (r *calendarRepository) Create(c calendar.Calendar) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		calendarModel := newCalendarModel(c)
+
+		if err := tx.Create(&calendarModel).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *calendarRepository) Update(c calendar.Calendar) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		calendarModel := newCalendarModel(c)
+
+		result := tx.Model(&calendarModel).Select("name", "color", "time_zone", "updated_at").Updates(&calendarModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return calendar.ErrCalendarNotFound
+		}
+
+		return nil
+	})
+}
+
+// Delete removes a calendar after moving its events to the trash of the
+// owner's default calendar.
+func (r *calendarRepository) Delete(id calendar.CalendarID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var calendarModel CalendarModel
+		err := tx.First(&calendarModel, "id = ?", id).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return calendar.ErrCalendarNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		var defaultModel CalendarModel
+		if err := tx.First(&defaultModel, "user_id = ? AND is_default = true", calendarModel.UserID).Error; err != nil {
+			return err
+		}
+		if defaultModel.ID == calendarModel.ID {
+			return calendar.ErrDefaultCalendarDelete
+		}
+
+		if err := infraevent.TrashCalendarEvents(tx, id, defaultModel.ID); err != nil {
+			return err
+		}
+
+		result := tx.Delete(&CalendarModel{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return calendar.ErrCalendarNotFound
+		}
+		return nil
+	})
+}
+
+func (r *calendarRepository) FindByID(id calendar.CalendarID) (calendar.Calendar, error) {
+	var calendarModel CalendarModel
+	err := r.db.First(&calendarModel, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, calendar.ErrCalendarNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return calendarModel.toCalendar(), nil
+}
+
+func (r *calendarRepository) FindAllByUserID(userID user.UserID) ([]calendar.Calendar, error) {
+	var calendarModels []CalendarModel
+	if err := r.db.Where("user_id = ?", userID).Order("is_default desc, created_at asc").Find(&calendarModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toCalendars(calendarModels), nil
+}
+
+func (r *calendarRepository) FindDefaultByUserID(userID user.UserID) (calendar.Calendar, error) {
+	var calendarModel CalendarModel
+	err := r.db.First(&calendarModel, "user_id = ? AND is_default = true", userID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, calendar.ErrCalendarNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return calendarModel.toCalendar(), nil
+}
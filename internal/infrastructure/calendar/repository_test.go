@@ -0,0 +1,532 @@
+package calendar
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/testutil"
+)
+
+func newTestCalendar() calendar.Calendar {
+	return calendar.NewCalendar(calendar.NewCalendarID(), user.UserID{UUID: uuid.New()}, calendar.Name("Work"), calendar.Color("#FF0000"), calendar.TimeZone("Asia/Tokyo"), false, time.Now(), time.Now())
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		success  bool
+		calendar calendar.Calendar
+		setup    func(mock sqlmock.Sqlmock, c calendar.Calendar)
+	}{
+		{
+			name:     "success create calendar",
+			success:  true,
+			calendar: newTestCalendar(),
+			setup: func(mock sqlmock.Sqlmock, c calendar.Calendar) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "calendars" ("id","user_id","name","color","time_zone","is_default","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`)).
+					WithArgs(c.ID(), c.UserID(), c.Name(), c.Color(), c.TimeZone(), c.IsDefault(), testutil.AnyTime{}, testutil.AnyTime{}).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:     "failure create calendar error",
+			success:  false,
+			calendar: newTestCalendar(),
+			setup: func(mock sqlmock.Sqlmock, c calendar.Calendar) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "calendars" ("id","user_id","name","color","time_zone","is_default","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`)).
+					WithArgs(c.ID(), c.UserID(), c.Name(), c.Color(), c.TimeZone(), c.IsDefault(), testutil.AnyTime{}, testutil.AnyTime{}).
+					WillReturnError(errors.New("create calendar error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.calendar)
+
+			repo := NewCalendarRepository(gormDB)
+
+			err = repo.Create(tt.calendar)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		success  bool
+		calendar calendar.Calendar
+		setup    func(mock sqlmock.Sqlmock, c calendar.Calendar)
+	}{
+		{
+			name:     "success update calendar",
+			success:  true,
+			calendar: newTestCalendar(),
+			setup: func(mock sqlmock.Sqlmock, c calendar.Calendar) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "calendars" SET "name"=$1,"color"=$2,"time_zone"=$3,"updated_at"=$4 WHERE "id" = $5`)).
+					WithArgs(c.Name(), c.Color(), c.TimeZone(), testutil.AnyTime{}, c.ID()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:     "failure calendar not found",
+			success:  false,
+			calendar: newTestCalendar(),
+			setup: func(mock sqlmock.Sqlmock, c calendar.Calendar) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "calendars" SET "name"=$1,"color"=$2,"time_zone"=$3,"updated_at"=$4 WHERE "id" = $5`)).
+					WithArgs(c.Name(), c.Color(), c.TimeZone(), testutil.AnyTime{}, c.ID()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:     "failure update calendar error",
+			success:  false,
+			calendar: newTestCalendar(),
+			setup: func(mock sqlmock.Sqlmock, c calendar.Calendar) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "calendars" SET "name"=$1,"color"=$2,"time_zone"=$3,"updated_at"=$4 WHERE "id" = $5`)).
+					WithArgs(c.Name(), c.Color(), c.TimeZone(), testutil.AnyTime{}, c.ID()).
+					WillReturnError(errors.New("update calendar error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.calendar)
+
+			repo := NewCalendarRepository(gormDB)
+
+			err = repo.Update(tt.calendar)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+	calendarColumns := []string{"id", "user_id", "name", "color", "time_zone", "is_default", "created_at", "updated_at"}
+	expectCalendars := func(mock sqlmock.Sqlmock, id, defaultID calendar.CalendarID, userID uuid.UUID) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE id = $1 ORDER BY "calendars"."id" LIMIT $2`)).
+			WithArgs(id, 1).
+			WillReturnRows(sqlmock.NewRows(calendarColumns).AddRow(id, userID, "Work", "#FF0000", "Asia/Tokyo", id == defaultID, time.Now(), time.Now()))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE user_id = $1 AND is_default = true ORDER BY "calendars"."id" LIMIT $2`)).
+			WithArgs(userID, 1).
+			WillReturnRows(sqlmock.NewRows(calendarColumns).AddRow(defaultID, userID, "Default", "#FFFFFF", "Asia/Tokyo", true, time.Now(), time.Now()))
+	}
+	expectTrash := func(mock sqlmock.Sqlmock, id, defaultID calendar.CalendarID) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE calendar_id = $1 AND "events"."deleted_at" IS NULL`)).
+			WithArgs(id).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "calendar_id"=$1 WHERE calendar_id = $2`)).
+			WithArgs(defaultID, id).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	tests := []struct {
+		name    string
+		success bool
+		id      calendar.CalendarID
+		setup   func(mock sqlmock.Sqlmock, id calendar.CalendarID)
+	}{
+		{
+			name:    "success delete calendar",
+			success: true,
+			id:      calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, id calendar.CalendarID) {
+				defaultID := calendar.NewCalendarID()
+
+				mock.ExpectBegin()
+
+				expectCalendars(mock, id, defaultID, uuid.New())
+				expectTrash(mock, id, defaultID)
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "calendars" WHERE id = $1`)).
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "failure calendar not found",
+			success: false,
+			id:      calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, id calendar.CalendarID) {
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE id = $1 ORDER BY "calendars"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "failure default calendar",
+			success: false,
+			id:      calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, id calendar.CalendarID) {
+				mock.ExpectBegin()
+
+				expectCalendars(mock, id, id, uuid.New())
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "failure trash events error",
+			success: false,
+			id:      calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, id calendar.CalendarID) {
+				mock.ExpectBegin()
+
+				expectCalendars(mock, id, calendar.NewCalendarID(), uuid.New())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE calendar_id = $1 AND "events"."deleted_at" IS NULL`)).
+					WithArgs(id).
+					WillReturnError(errors.New("find events error"))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "failure delete calendar error",
+			success: false,
+			id:      calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, id calendar.CalendarID) {
+				defaultID := calendar.NewCalendarID()
+
+				mock.ExpectBegin()
+
+				expectCalendars(mock, id, defaultID, uuid.New())
+				expectTrash(mock, id, defaultID)
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "calendars" WHERE id = $1`)).
+					WithArgs(id).
+					WillReturnError(errors.New("delete calendar error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.id)
+
+			repo := NewCalendarRepository(gormDB)
+
+			err = repo.Delete(tt.id)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindByID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		id      calendar.CalendarID
+		setup   func(mock sqlmock.Sqlmock, id calendar.CalendarID)
+	}{
+		{
+			name:    "success find by id",
+			success: true,
+			id:      calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, id calendar.CalendarID) {
+				calendarRows := sqlmock.NewRows([]string{"id", "user_id", "name", "color", "time_zone", "is_default", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "Work", "#FF0000", "Asia/Tokyo", false, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE id = $1 ORDER BY "calendars"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnRows(calendarRows)
+			},
+		},
+		{
+			name:    "failure calendar not found",
+			success: false,
+			id:      calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, id calendar.CalendarID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE id = $1 ORDER BY "calendars"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name:    "failure find by id error",
+			success: false,
+			id:      calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, id calendar.CalendarID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE id = $1 ORDER BY "calendars"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnError(errors.New("find by id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.id)
+
+			repo := NewCalendarRepository(gormDB)
+
+			_, err = repo.FindByID(tt.id)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindAllByUserID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		userID  user.UserID
+		setup   func(mock sqlmock.Sqlmock, userID user.UserID)
+	}{
+		{
+			name:    "success find all by user id",
+			success: true,
+			userID:  user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				calendarRows := sqlmock.NewRows([]string{"id", "user_id", "name", "color", "time_zone", "is_default", "created_at", "updated_at"}).
+					AddRow(uuid.New(), userID, "Default", "#FFFFFF", "UTC", true, time.Now(), time.Now()).
+					AddRow(uuid.New(), userID, "Work", "#FF0000", "Asia/Tokyo", false, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE user_id = $1 ORDER BY is_default desc, created_at asc`)).
+					WithArgs(userID).
+					WillReturnRows(calendarRows)
+			},
+		},
+		{
+			name:    "failure find all by user id error",
+			success: false,
+			userID:  user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE user_id = $1 ORDER BY is_default desc, created_at asc`)).
+					WithArgs(userID).
+					WillReturnError(errors.New("find all by user id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.userID)
+
+			repo := NewCalendarRepository(gormDB)
+
+			_, err = repo.FindAllByUserID(tt.userID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindDefaultByUserID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		userID  user.UserID
+		setup   func(mock sqlmock.Sqlmock, userID user.UserID)
+	}{
+		{
+			name:    "success find default by user id",
+			success: true,
+			userID:  user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				calendarRows := sqlmock.NewRows([]string{"id", "user_id", "name", "color", "time_zone", "is_default", "created_at", "updated_at"}).
+					AddRow(uuid.New(), userID, "Default", "#FFFFFF", "UTC", true, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE user_id = $1 AND is_default = true ORDER BY "calendars"."id" LIMIT $2`)).
+					WithArgs(userID, 1).
+					WillReturnRows(calendarRows)
+			},
+		},
+		{
+			name:    "failure calendar not found",
+			success: false,
+			userID:  user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE user_id = $1 AND is_default = true ORDER BY "calendars"."id" LIMIT $2`)).
+					WithArgs(userID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name:    "failure find default by user id error",
+			success: false,
+			userID:  user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendars" WHERE user_id = $1 AND is_default = true ORDER BY "calendars"."id" LIMIT $2`)).
+					WithArgs(userID, 1).
+					WillReturnError(errors.New("find default by user id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.userID)
+
+			repo := NewCalendarRepository(gormDB)
+
+			_, err = repo.FindDefaultByUserID(tt.userID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type ShareModel struct {
+	CalendarID  calendar.CalendarID
+	UserID      user.UserID
+	AccessLevel calendar.AccessLevel
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (ShareModel) TableName() string {
+	return "calendar_shares"
+}
+
+func newShareModel(s calendar.Share) ShareModel {
+	return ShareModel{
+		CalendarID:  s.CalendarID(),
+		UserID:      s.UserID(),
+		AccessLevel: s.AccessLevel(),
+		CreatedAt:   s.CreatedAt(),
+		UpdatedAt:   s.UpdatedAt(),
+	}
+}
+
+func (m ShareModel) toShare() calendar.Share {
+	return calendar.NewShare(
+		m.CalendarID,
+		m.UserID,
+		m.AccessLevel,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
+
+func toShares(shareModels []ShareModel) []calendar.Share {
+	var shares []calendar.Share
+	for _, shareModel := range shareModels {
+		shares = append(shares, shareModel.toShare())
+	}
+
+	return shares
+}
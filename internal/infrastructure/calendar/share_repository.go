@@ -0,0 +1,79 @@
+package calendar
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type shareRepository struct {
+	db *gorm.DB
+}
+
+func NewShareRepository(db *gorm.DB) calendar.ShareRepository {
+	return &shareRepository{db: db}
+}
+
+// Save grants a share, or changes the access level of an existing one.
+func (r *shareRepository) Save(s calendar.Share) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		shareModel := newShareModel(s)
+
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "calendar_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"access_level", "updated_at"}),
+		}).Create(&shareModel).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *shareRepository) Delete(calendarID calendar.CalendarID, userID user.UserID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&ShareModel{}, "calendar_id = ? AND user_id = ?", calendarID, userID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return calendar.ErrShareNotFound
+		}
+		return nil
+	})
+}
+
+func (r *shareRepository) FindByCalendarIDAndUserID(calendarID calendar.CalendarID, userID user.UserID) (calendar.Share, error) {
+	var shareModel ShareModel
+	err := r.db.Where("calendar_id = ? AND user_id = ?", calendarID, userID).Take(&shareModel).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, calendar.ErrShareNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return shareModel.toShare(), nil
+}
+
+func (r *shareRepository) FindAllByCalendarID(calendarID calendar.CalendarID) ([]calendar.Share, error) {
+	var shareModels []ShareModel
+	if err := r.db.Where("calendar_id = ?", calendarID).Order("created_at asc").Find(&shareModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toShares(shareModels), nil
+}
+
+func (r *shareRepository) FindAllByUserID(userID user.UserID) ([]calendar.Share, error) {
+	var shareModels []ShareModel
+	if err := r.db.Where("user_id = ?", userID).Order("created_at asc").Find(&shareModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toShares(shareModels), nil
+}
@@ -0,0 +1,387 @@
+package calendar
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/testutil"
+)
+
+func TestSaveShare(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		share   calendar.Share
+		setup   func(mock sqlmock.Sqlmock, s calendar.Share)
+	}{
+		{
+			name:    "success save share",
+			success: true,
+			share:   calendar.NewShare(calendar.NewCalendarID(), user.UserID{UUID: uuid.New()}, calendar.AccessLevelRead, time.Now(), time.Now()),
+			setup: func(mock sqlmock.Sqlmock, s calendar.Share) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "calendar_shares" ("calendar_id","user_id","access_level","created_at","updated_at") VALUES ($1,$2,$3,$4,$5) ON CONFLICT ("calendar_id","user_id") DO UPDATE SET "access_level"="excluded"."access_level","updated_at"="excluded"."updated_at"`)).
+					WithArgs(s.CalendarID(), s.UserID(), s.AccessLevel(), testutil.AnyTime{}, testutil.AnyTime{}).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "failure save share error",
+			success: false,
+			share:   calendar.NewShare(calendar.NewCalendarID(), user.UserID{UUID: uuid.New()}, calendar.AccessLevelRead, time.Now(), time.Now()),
+			setup: func(mock sqlmock.Sqlmock, s calendar.Share) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "calendar_shares" ("calendar_id","user_id","access_level","created_at","updated_at") VALUES ($1,$2,$3,$4,$5) ON CONFLICT ("calendar_id","user_id") DO UPDATE SET "access_level"="excluded"."access_level","updated_at"="excluded"."updated_at"`)).
+					WithArgs(s.CalendarID(), s.UserID(), s.AccessLevel(), testutil.AnyTime{}, testutil.AnyTime{}).
+					WillReturnError(errors.New("save share error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.share)
+
+			repo := NewShareRepository(gormDB)
+
+			err = repo.Save(tt.share)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestDeleteShare(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		success    bool
+		calendarID calendar.CalendarID
+		userID     user.UserID
+		setup      func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID, userID user.UserID)
+	}{
+		{
+			name:       "success delete share",
+			success:    true,
+			calendarID: calendar.NewCalendarID(),
+			userID:     user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID, userID user.UserID) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "calendar_shares" WHERE calendar_id = $1 AND user_id = $2`)).
+					WithArgs(calendarID, userID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:       "failure share not found",
+			success:    false,
+			calendarID: calendar.NewCalendarID(),
+			userID:     user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID, userID user.UserID) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "calendar_shares" WHERE calendar_id = $1 AND user_id = $2`)).
+					WithArgs(calendarID, userID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:       "failure delete share error",
+			success:    false,
+			calendarID: calendar.NewCalendarID(),
+			userID:     user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID, userID user.UserID) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "calendar_shares" WHERE calendar_id = $1 AND user_id = $2`)).
+					WithArgs(calendarID, userID).
+					WillReturnError(errors.New("delete share error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.calendarID, tt.userID)
+
+			repo := NewShareRepository(gormDB)
+
+			err = repo.Delete(tt.calendarID, tt.userID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindByCalendarIDAndUserID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		success    bool
+		calendarID calendar.CalendarID
+		userID     user.UserID
+		setup      func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID, userID user.UserID)
+	}{
+		{
+			name:       "success find by calendar id and user id",
+			success:    true,
+			calendarID: calendar.NewCalendarID(),
+			userID:     user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID, userID user.UserID) {
+				shareRows := sqlmock.NewRows([]string{"calendar_id", "user_id", "access_level", "created_at", "updated_at"}).
+					AddRow(calendarID, userID, "read", time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendar_shares" WHERE calendar_id = $1 AND user_id = $2 LIMIT $3`)).
+					WithArgs(calendarID, userID, 1).
+					WillReturnRows(shareRows)
+			},
+		},
+		{
+			name:       "failure share not found",
+			success:    false,
+			calendarID: calendar.NewCalendarID(),
+			userID:     user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendar_shares" WHERE calendar_id = $1 AND user_id = $2 LIMIT $3`)).
+					WithArgs(calendarID, userID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name:       "failure find by calendar id and user id error",
+			success:    false,
+			calendarID: calendar.NewCalendarID(),
+			userID:     user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendar_shares" WHERE calendar_id = $1 AND user_id = $2 LIMIT $3`)).
+					WithArgs(calendarID, userID, 1).
+					WillReturnError(errors.New("find by calendar id and user id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.calendarID, tt.userID)
+
+			repo := NewShareRepository(gormDB)
+
+			_, err = repo.FindByCalendarIDAndUserID(tt.calendarID, tt.userID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindAllByCalendarID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		success    bool
+		calendarID calendar.CalendarID
+		setup      func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID)
+	}{
+		{
+			name:       "success find all by calendar id",
+			success:    true,
+			calendarID: calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID) {
+				shareRows := sqlmock.NewRows([]string{"calendar_id", "user_id", "access_level", "created_at", "updated_at"}).
+					AddRow(calendarID, uuid.New(), "read", time.Now(), time.Now()).
+					AddRow(calendarID, uuid.New(), "write", time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendar_shares" WHERE calendar_id = $1 ORDER BY created_at asc`)).
+					WithArgs(calendarID).
+					WillReturnRows(shareRows)
+			},
+		},
+		{
+			name:       "failure find all by calendar id error",
+			success:    false,
+			calendarID: calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, calendarID calendar.CalendarID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendar_shares" WHERE calendar_id = $1 ORDER BY created_at asc`)).
+					WithArgs(calendarID).
+					WillReturnError(errors.New("find all by calendar id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.calendarID)
+
+			repo := NewShareRepository(gormDB)
+
+			_, err = repo.FindAllByCalendarID(tt.calendarID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindAllSharesByUserID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		userID  user.UserID
+		setup   func(mock sqlmock.Sqlmock, userID user.UserID)
+	}{
+		{
+			name:    "success find all by user id",
+			success: true,
+			userID:  user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				shareRows := sqlmock.NewRows([]string{"calendar_id", "user_id", "access_level", "created_at", "updated_at"}).
+					AddRow(uuid.New(), userID, "read", time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendar_shares" WHERE user_id = $1 ORDER BY created_at asc`)).
+					WithArgs(userID).
+					WillReturnRows(shareRows)
+			},
+		},
+		{
+			name:    "failure find all by user id error",
+			success: false,
+			userID:  user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendar_shares" WHERE user_id = $1 ORDER BY created_at asc`)).
+					WithArgs(userID).
+					WillReturnError(errors.New("find all by user id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.userID)
+
+			repo := NewShareRepository(gormDB)
+
+			_, err = repo.FindAllByUserID(tt.userID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
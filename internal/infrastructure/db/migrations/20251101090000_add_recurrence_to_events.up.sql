@@ -0,0 +1 @@
+ALTER TABLE events ADD COLUMN recurrence TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_events_user_id_start_time_id;
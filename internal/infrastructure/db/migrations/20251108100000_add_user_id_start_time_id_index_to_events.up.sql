@@ -0,0 +1 @@
+CREATE INDEX idx_events_user_id_start_time_id ON events (user_id, start_time, id);
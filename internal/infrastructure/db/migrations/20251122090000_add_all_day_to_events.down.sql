@@ -0,0 +1 @@
+ALTER TABLE events DROP COLUMN all_day;
\ No newline at end of file
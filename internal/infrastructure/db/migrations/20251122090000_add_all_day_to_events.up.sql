@@ -0,0 +1 @@
+ALTER TABLE events ADD COLUMN all_day BOOLEAN NOT NULL DEFAULT FALSE;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE events ALTER COLUMN end_time   TYPE TIMESTAMP USING end_time AT TIME ZONE 'UTC';
+ALTER TABLE events ALTER COLUMN start_time TYPE TIMESTAMP USING start_time AT TIME ZONE 'UTC';
+ALTER TABLE events DROP COLUMN time_zone;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE events ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'UTC';
+ALTER TABLE events ALTER COLUMN start_time TYPE TIMESTAMPTZ USING start_time AT TIME ZONE 'UTC';
+ALTER TABLE events ALTER COLUMN end_time   TYPE TIMESTAMPTZ USING end_time AT TIME ZONE 'UTC';
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_events_user_id_deleted_at;
+ALTER TABLE events DROP COLUMN deleted_at;
\ No newline at end of file
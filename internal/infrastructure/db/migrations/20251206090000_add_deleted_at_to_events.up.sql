@@ -0,0 +1,2 @@
+ALTER TABLE events ADD COLUMN deleted_at TIMESTAMPTZ;
+CREATE INDEX idx_events_user_id_deleted_at ON events (user_id, deleted_at) WHERE deleted_at IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_events_user_id_ical_uid;
+ALTER TABLE events DROP COLUMN ical_uid;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE events ADD COLUMN ical_uid TEXT NOT NULL DEFAULT '';
+UPDATE events SET ical_uid = id || '@event-service';
+CREATE UNIQUE INDEX idx_events_user_id_ical_uid ON events (user_id, ical_uid) WHERE deleted_at IS NULL;
\ No newline at end of file
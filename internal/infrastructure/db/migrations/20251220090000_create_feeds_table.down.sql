@@ -0,0 +1 @@
+DROP TABLE IF EXISTS feeds;
\ No newline at end of file
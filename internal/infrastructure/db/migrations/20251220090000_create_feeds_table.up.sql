@@ -0,0 +1,5 @@
+CREATE TABLE feeds (
+  user_id VARCHAR(36) PRIMARY KEY,
+  token_hash VARCHAR(64) NOT NULL UNIQUE,
+  created_at TIMESTAMPTZ NOT NULL
+);
\ No newline at end of file
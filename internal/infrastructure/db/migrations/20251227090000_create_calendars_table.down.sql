@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_events_calendar_id;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS fk_events_calendar_id;
+ALTER TABLE events DROP COLUMN calendar_id;
+DROP TABLE IF EXISTS calendars;
\ No newline at end of file
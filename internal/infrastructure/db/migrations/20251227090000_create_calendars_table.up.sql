@@ -0,0 +1,20 @@
+CREATE TABLE calendars (
+  id VARCHAR(36) PRIMARY KEY,
+  user_id VARCHAR(36) NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  color VARCHAR(7) NOT NULL,
+  time_zone TEXT NOT NULL,
+  is_default BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_calendars_user_id ON calendars (user_id);
+CREATE UNIQUE INDEX idx_calendars_user_id_default ON calendars (user_id) WHERE is_default;
+INSERT INTO calendars (id, user_id, name, color, time_zone, is_default, created_at, updated_at)
+SELECT gen_random_uuid()::text, user_id, 'Default', '#FFFFFF', 'UTC', TRUE, NOW(), NOW()
+FROM (SELECT DISTINCT user_id FROM events) AS users;
+ALTER TABLE events ADD COLUMN calendar_id VARCHAR(36);
+UPDATE events SET calendar_id = calendars.id FROM calendars WHERE calendars.user_id = events.user_id AND calendars.is_default;
+ALTER TABLE events ALTER COLUMN calendar_id SET NOT NULL;
+ALTER TABLE events ADD CONSTRAINT fk_events_calendar_id FOREIGN KEY (calendar_id) REFERENCES calendars (id) ON DELETE CASCADE;
+CREATE INDEX idx_events_calendar_id ON events (calendar_id);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS calendar_shares;
\ No newline at end of file
@@ -0,0 +1,9 @@
+CREATE TABLE calendar_shares (
+  calendar_id VARCHAR(36) NOT NULL REFERENCES calendars (id) ON DELETE CASCADE,
+  user_id VARCHAR(36) NOT NULL,
+  access_level VARCHAR(16) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (calendar_id, user_id)
+);
+CREATE INDEX idx_calendar_shares_user_id ON calendar_shares (user_id);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_events_calendar_id_start_time_id;
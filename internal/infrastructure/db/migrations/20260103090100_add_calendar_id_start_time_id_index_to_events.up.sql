@@ -0,0 +1 @@
+CREATE INDEX idx_events_calendar_id_start_time_id ON events (calendar_id, start_time, id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS event_attendees;
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE TABLE event_attendees (
+  event_id VARCHAR(36) NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  user_id VARCHAR(36) NOT NULL DEFAULT '',
+  email VARCHAR(320) NOT NULL DEFAULT '',
+  role VARCHAR(16) NOT NULL,
+  response_status VARCHAR(16) NOT NULL,
+  PRIMARY KEY (event_id, position)
+);
+CREATE INDEX idx_event_attendees_user_id ON event_attendees (user_id);
\ No newline at end of file
@@ -0,0 +1,9 @@
+CREATE TABLE event_reminders (
+  event_id VARCHAR(36) NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  offset_minutes INTEGER NOT NULL,
+  channel VARCHAR(16) NOT NULL,
+  trigger_time TIMESTAMPTZ NULL,
+  PRIMARY KEY (event_id, position)
+);
+CREATE INDEX idx_event_reminders_trigger_time ON event_reminders (trigger_time);
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE TABLE outbox (
+  id BIGSERIAL PRIMARY KEY,
+  type VARCHAR(32) NOT NULL,
+  event_id VARCHAR(36) NOT NULL,
+  before JSONB NULL,
+  after JSONB NULL,
+  occurred_at TIMESTAMPTZ NOT NULL,
+  published_at TIMESTAMPTZ NULL
+);
+CREATE INDEX idx_outbox_unpublished ON outbox (id) WHERE published_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,33 @@
+CREATE TABLE webhooks (
+  id VARCHAR(36) PRIMARY KEY,
+  user_id VARCHAR(36) NOT NULL,
+  url TEXT NOT NULL,
+  event_types TEXT NOT NULL DEFAULT '',
+  secret VARCHAR(255) NOT NULL,
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  consecutive_failures INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_webhooks_user_id ON webhooks (user_id);
+CREATE TABLE webhook_deliveries (
+  id VARCHAR(36) PRIMARY KEY,
+  webhook_id VARCHAR(36) NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+  change_id BIGINT NOT NULL,
+  event_type VARCHAR(32) NOT NULL,
+  payload JSONB NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  next_attempt_at TIMESTAMPTZ NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (webhook_id, change_id)
+);
+CREATE INDEX idx_webhook_deliveries_webhook_id_created_at ON webhook_deliveries (webhook_id, created_at);
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE TABLE webhook_delivery_attempts (
+  delivery_id VARCHAR(36) NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  status_code INTEGER NOT NULL,
+  error TEXT NOT NULL DEFAULT '',
+  attempted_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (delivery_id, position)
+);
\ No newline at end of file
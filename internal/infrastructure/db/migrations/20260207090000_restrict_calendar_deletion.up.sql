@@ -0,0 +1,2 @@
+ALTER TABLE events DROP CONSTRAINT fk_events_calendar_id;
+ALTER TABLE events ADD CONSTRAINT fk_events_calendar_id FOREIGN KEY (calendar_id) REFERENCES calendars (id) ON DELETE RESTRICT;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_outbox_occurred_at;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX idx_outbox_occurred_at ON outbox (occurred_at);
\ No newline at end of file
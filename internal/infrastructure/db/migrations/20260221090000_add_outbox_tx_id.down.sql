@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_outbox_tx_id_id;
+ALTER TABLE outbox DROP COLUMN tx_id;
\ No newline at end of file
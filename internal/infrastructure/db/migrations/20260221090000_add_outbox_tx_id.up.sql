@@ -0,0 +1,2 @@
+ALTER TABLE outbox ADD COLUMN tx_id BIGINT NOT NULL DEFAULT pg_current_xact_id()::text::bigint;
+CREATE INDEX idx_outbox_tx_id_id ON outbox (tx_id, id);
\ No newline at end of file
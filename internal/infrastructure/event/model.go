@@ -1,8 +1,12 @@
 package event
 
 import (
+	"strings"
 	"time"
 
+	"gorm.io/gorm"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
 	"github.com/qkitzero/event-service/internal/domain/event"
 	"github.com/qkitzero/event-service/internal/domain/user"
 )
@@ -10,15 +14,218 @@ import (
 type EventModel struct {
 	ID          event.EventID
 	UserID      user.UserID
+	CalendarID  calendar.CalendarID
 	Title       event.Title
 	Description event.Description
 	StartTime   time.Time
 	EndTime     time.Time
+	AllDay      bool
+	TimeZone    string
 	Color       event.Color
+	Recurrence  string
+	ICalUID     string `gorm:"column:ical_uid"`
+	Version     int64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt
+	Attendees   []AttendeeModel `gorm:"foreignKey:EventID"`
+	Reminders   []ReminderModel `gorm:"foreignKey:EventID"`
 }
 
 func (EventModel) TableName() string {
 	return "events"
 }
+
+type AttendeeModel struct {
+	EventID        event.EventID
+	Position       int
+	UserID         string
+	Email          string
+	Role           event.AttendeeRole
+	ResponseStatus event.ResponseStatus
+}
+
+func (AttendeeModel) TableName() string {
+	return "event_attendees"
+}
+
+type ReminderModel struct {
+	EventID       event.EventID
+	Position      int
+	OffsetMinutes int
+	Channel       event.ReminderChannel
+	TriggerTime   *time.Time
+}
+
+func (ReminderModel) TableName() string {
+	return "event_reminders"
+}
+
+func newEventModel(e event.Event) EventModel {
+	return EventModel{
+		ID:          e.ID(),
+		UserID:      e.UserID(),
+		CalendarID:  e.CalendarID(),
+		Title:       e.Title(),
+		Description: e.Description(),
+		StartTime:   e.StartTime(),
+		EndTime:     e.EndTime(),
+		AllDay:      e.AllDay(),
+		TimeZone:    e.TimeZone().String(),
+		Color:       e.Color(),
+		Recurrence:  e.Recurrence().String(),
+		ICalUID:     e.ICalUID(),
+		Version:     e.Version(),
+		CreatedAt:   e.CreatedAt(),
+		UpdatedAt:   e.UpdatedAt(),
+		DeletedAt:   gorm.DeletedAt{Time: e.DeletedAt(), Valid: e.IsDeleted()},
+		Attendees:   newAttendeeModels(e.ID(), e.Attendees()),
+		Reminders:   newReminderModels(e),
+	}
+}
+
+func newAttendeeModels(eventID event.EventID, attendees []event.Attendee) []AttendeeModel {
+	attendeeModels := make([]AttendeeModel, 0, len(attendees))
+	for i, a := range attendees {
+		attendeeModel := AttendeeModel{
+			EventID:        eventID,
+			Position:       i,
+			Email:          a.Email(),
+			Role:           a.Role(),
+			ResponseStatus: a.ResponseStatus(),
+		}
+		if a.UserID() != (user.UserID{}) {
+			attendeeModel.UserID = a.UserID().String()
+		}
+		attendeeModels = append(attendeeModels, attendeeModel)
+	}
+
+	return attendeeModels
+}
+
+// newReminderModels schedules each reminder for the first occurrence that
+// has not been reminded of by the time e was last updated.
+func newReminderModels(e event.Event) []ReminderModel {
+	reminderModels := make([]ReminderModel, 0, len(e.Reminders()))
+	for i, r := range e.Reminders() {
+		reminderModel := ReminderModel{
+			EventID:       e.ID(),
+			Position:      i,
+			OffsetMinutes: int(r.Offset() / time.Minute),
+			Channel:       r.Channel(),
+		}
+		if triggerTime, ok := e.NextReminderTime(r, e.UpdatedAt()); ok {
+			reminderModel.TriggerTime = &triggerTime
+		}
+		reminderModels = append(reminderModels, reminderModel)
+	}
+
+	return reminderModels
+}
+
+// keepTriggerTimes carries the trigger times stored for before's reminders
+// over to the reminders of after with the same offset, unless the event was
+// rescheduled. newReminderModels only looks ahead of the update, so a
+// reminder that is due but not yet claimed would otherwise be skipped.
+func keepTriggerTimes(before EventModel, after *EventModel) {
+	if !before.StartTime.Equal(after.StartTime) || before.AllDay != after.AllDay ||
+		before.TimeZone != after.TimeZone || before.Recurrence != after.Recurrence {
+		return
+	}
+
+	triggerTimes := make(map[int]*time.Time, len(before.Reminders))
+	for _, r := range before.Reminders {
+		triggerTimes[r.OffsetMinutes] = r.TriggerTime
+	}
+	for i, r := range after.Reminders {
+		if triggerTime, ok := triggerTimes[r.OffsetMinutes]; ok {
+			after.Reminders[i].TriggerTime = triggerTime
+		}
+	}
+}
+
+func (m ReminderModel) toReminder() (event.Reminder, error) {
+	return event.NewReminder(time.Duration(m.OffsetMinutes)*time.Minute, m.Channel)
+}
+
+func (m AttendeeModel) toAttendee() (event.Attendee, error) {
+	var userID user.UserID
+	if m.UserID != "" {
+		id, err := user.NewUserIDFromString(m.UserID)
+		if err != nil {
+			return event.Attendee{}, err
+		}
+		userID = id
+	}
+
+	return event.NewAttendee(userID, m.Email, m.Role, m.ResponseStatus)
+}
+
+func (m EventModel) toEvent() (event.Event, error) {
+	var lines []string
+	if m.Recurrence != "" {
+		lines = strings.Split(m.Recurrence, "\n")
+	}
+
+	recurrence, err := event.NewRecurrence(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	timeZone, err := event.NewTimeZone(m.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	var attendees []event.Attendee
+	for _, attendeeModel := range m.Attendees {
+		attendee, err := attendeeModel.toAttendee()
+		if err != nil {
+			return nil, err
+		}
+		attendees = append(attendees, attendee)
+	}
+
+	var reminders []event.Reminder
+	for _, reminderModel := range m.Reminders {
+		reminder, err := reminderModel.toReminder()
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, reminder)
+	}
+
+	return event.NewEvent(
+		m.ID,
+		m.UserID,
+		m.CalendarID,
+		m.Title,
+		m.Description,
+		m.StartTime.UTC(),
+		m.EndTime.UTC(),
+		m.AllDay,
+		timeZone,
+		m.Color,
+		recurrence,
+		attendees,
+		reminders,
+		m.ICalUID,
+		m.Version,
+		m.CreatedAt,
+		m.UpdatedAt,
+		m.DeletedAt.Time.UTC(),
+	), nil
+}
+
+func toEvents(eventModels []EventModel) ([]event.Event, error) {
+	var events []event.Event
+	for _, eventModel := range eventModels {
+		e, err := eventModel.toEvent()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	return events, nil
+}
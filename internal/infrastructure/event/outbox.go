@@ -0,0 +1,195 @@
+package event
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type OutboxModel struct {
+	ID          int64 `gorm:"primaryKey"`
+	TxID        int64 `gorm:"->"`
+	Type        event.ChangeType
+	EventID     event.EventID
+	Before      []byte
+	After       []byte
+	OccurredAt  time.Time
+	PublishedAt *time.Time
+}
+
+func (OutboxModel) TableName() string {
+	return "outbox"
+}
+
+func (m OutboxModel) toChange() (event.Change, error) {
+	beforeAudience, err := newAudience(m.Before)
+	if err != nil {
+		return event.Change{}, err
+	}
+
+	afterAudience, err := newAudience(m.After)
+	if err != nil {
+		return event.Change{}, err
+	}
+
+	return event.Change{
+		ID:             m.ID,
+		TxID:           m.TxID,
+		Type:           m.Type,
+		EventID:        m.EventID,
+		Before:         m.Before,
+		After:          m.After,
+		BeforeAudience: beforeAudience,
+		AfterAudience:  afterAudience,
+		OccurredAt:     m.OccurredAt,
+	}, nil
+}
+
+// newAudience reads who could see the event from a snapshot. Trashed events
+// and missing snapshots have no audience.
+func newAudience(snapshot []byte) (*event.Audience, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	var s eventSnapshot
+	if err := json.Unmarshal(snapshot, &s); err != nil {
+		return nil, err
+	}
+	if s.DeletedAt != nil {
+		return nil, nil
+	}
+
+	ownerID, err := user.NewUserIDFromString(s.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendarID, err := calendar.NewCalendarIDFromString(s.CalendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	audience := &event.Audience{OwnerID: ownerID, CalendarID: calendarID}
+	for _, a := range s.Attendees {
+		if a.UserID == "" {
+			continue
+		}
+		attendeeID, err := user.NewUserIDFromString(a.UserID)
+		if err != nil {
+			return nil, err
+		}
+		audience.AttendeeIDs = append(audience.AttendeeIDs, attendeeID)
+	}
+
+	return audience, nil
+}
+
+type eventSnapshot struct {
+	ID          string             `json:"id"`
+	UserID      string             `json:"user_id"`
+	CalendarID  string             `json:"calendar_id"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	StartTime   time.Time          `json:"start_time"`
+	EndTime     time.Time          `json:"end_time"`
+	AllDay      bool               `json:"all_day"`
+	TimeZone    string             `json:"time_zone"`
+	Color       string             `json:"color"`
+	Recurrence  []string           `json:"recurrence"`
+	Attendees   []attendeeSnapshot `json:"attendees"`
+	Reminders   []reminderSnapshot `json:"reminders"`
+	ICalUID     string             `json:"ical_uid"`
+	Version     int64              `json:"version"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+	DeletedAt   *time.Time         `json:"deleted_at"`
+}
+
+type attendeeSnapshot struct {
+	UserID         string `json:"user_id"`
+	Email          string `json:"email"`
+	Role           string `json:"role"`
+	ResponseStatus string `json:"response_status"`
+}
+
+type reminderSnapshot struct {
+	OffsetMinutes int    `json:"offset_minutes"`
+	Channel       string `json:"channel"`
+}
+
+func newEventSnapshot(m EventModel) ([]byte, error) {
+	snapshot := eventSnapshot{
+		ID:          m.ID.String(),
+		UserID:      m.UserID.String(),
+		CalendarID:  m.CalendarID.String(),
+		Title:       m.Title.String(),
+		Description: m.Description.String(),
+		StartTime:   m.StartTime,
+		EndTime:     m.EndTime,
+		AllDay:      m.AllDay,
+		TimeZone:    m.TimeZone,
+		Color:       m.Color.String(),
+		Recurrence:  []string{},
+		Attendees:   []attendeeSnapshot{},
+		Reminders:   []reminderSnapshot{},
+		ICalUID:     m.ICalUID,
+		Version:     m.Version,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if m.Recurrence != "" {
+		snapshot.Recurrence = strings.Split(m.Recurrence, "\n")
+	}
+	for _, a := range m.Attendees {
+		snapshot.Attendees = append(snapshot.Attendees, attendeeSnapshot{
+			UserID:         a.UserID,
+			Email:          a.Email,
+			Role:           a.Role.String(),
+			ResponseStatus: a.ResponseStatus.String(),
+		})
+	}
+	for _, r := range m.Reminders {
+		snapshot.Reminders = append(snapshot.Reminders, reminderSnapshot{
+			OffsetMinutes: r.OffsetMinutes,
+			Channel:       r.Channel.String(),
+		})
+	}
+	if m.DeletedAt.Valid {
+		snapshot.DeletedAt = &m.DeletedAt.Time
+	}
+
+	return json.Marshal(snapshot)
+}
+
+// appendChange writes a change of eventID to the outbox in the transaction
+// that makes it. A nil before or after means there is no such snapshot.
+func appendChange(tx *gorm.DB, changeType event.ChangeType, eventID event.EventID, before, after *EventModel) error {
+	outboxModel := OutboxModel{
+		Type:       changeType,
+		EventID:    eventID,
+		OccurredAt: time.Now(),
+	}
+	if before != nil {
+		snapshot, err := newEventSnapshot(*before)
+		if err != nil {
+			return err
+		}
+		outboxModel.Before = snapshot
+	}
+	if after != nil {
+		snapshot, err := newEventSnapshot(*after)
+		if err != nil {
+			return err
+		}
+		outboxModel.After = snapshot
+	}
+
+	return tx.Create(&outboxModel).Error
+}
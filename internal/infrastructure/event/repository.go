@@ -1,14 +1,28 @@
 package event
 
 import (
+	"encoding/json"
 	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/qkitzero/event-service/internal/domain/calendar"
 	"github.com/qkitzero/event-service/internal/domain/event"
 	"github.com/qkitzero/event-service/internal/domain/user"
 )
 
+const (
+	// uniqueViolation is the SQLSTATE Postgres reports when a unique index
+	// rejects a row.
+	uniqueViolation = "23505"
+	// icalUIDIndex keeps the iCalendar UIDs of each user's live events
+	// unique.
+	icalUIDIndex = "idx_events_user_id_ical_uid"
+)
+
 type eventRepository struct {
 	db *gorm.DB
 }
@@ -19,51 +33,128 @@ func NewEventRepository(db *gorm.DB) event.EventRepository {
 
 func (r *eventRepository) Create(e event.Event) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		eventModel := EventModel{
-			ID:          e.ID(),
-			UserID:      e.UserID(),
-			Title:       e.Title(),
-			Description: e.Description(),
-			StartTime:   e.StartTime(),
-			EndTime:     e.EndTime(),
-			Color:       e.Color(),
-			CreatedAt:   e.CreatedAt(),
-			UpdatedAt:   e.UpdatedAt(),
-		}
-
-		if err := tx.Create(&eventModel).Error; err != nil {
+		eventModel := newEventModel(e)
+
+		if err := tx.Omit(clause.Associations).Create(&eventModel).Error; err != nil {
 			return err
 		}
 
-		return nil
+		if err := createAttendees(tx, eventModel.Attendees); err != nil {
+			return err
+		}
+
+		if err := createReminders(tx, eventModel.Reminders); err != nil {
+			return err
+		}
+
+		return appendChange(tx, event.ChangeTypeCreated, eventModel.ID, nil, &eventModel)
 	})
 }
 
-func (r *eventRepository) Update(e event.Event) error {
+func (r *eventRepository) Update(e event.Event, version int64) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		eventModel := EventModel{
-			ID:          e.ID(),
-			UserID:      e.UserID(),
-			Title:       e.Title(),
-			Description: e.Description(),
-			StartTime:   e.StartTime(),
-			EndTime:     e.EndTime(),
-			Color:       e.Color(),
-			CreatedAt:   e.CreatedAt(),
-			UpdatedAt:   e.UpdatedAt(),
-		}
-
-		if err := tx.Save(&eventModel).Error; err != nil {
+		eventModel := newEventModel(e)
+
+		var beforeModel EventModel
+		err := tx.Scopes(withAssociations).First(&beforeModel, "id = ? AND version = ?", eventModel.ID, version).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return event.ErrVersionConflict
+		}
+		if err != nil {
 			return err
 		}
 
-		return nil
+		keepTriggerTimes(beforeModel, &eventModel)
+
+		result := tx.Model(&eventModel).Where("version = ?", version).Select("*").Omit("deleted_at", clause.Associations).Updates(&eventModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return event.ErrVersionConflict
+		}
+
+		if err := tx.Where("event_id = ?", eventModel.ID).Delete(&AttendeeModel{}).Error; err != nil {
+			return err
+		}
+
+		if err := createAttendees(tx, eventModel.Attendees); err != nil {
+			return err
+		}
+
+		if err := tx.Where("event_id = ?", eventModel.ID).Delete(&ReminderModel{}).Error; err != nil {
+			return err
+		}
+
+		if err := createReminders(tx, eventModel.Reminders); err != nil {
+			return err
+		}
+
+		return appendChange(tx, event.ChangeTypeUpdated, eventModel.ID, &beforeModel, &eventModel)
 	})
 }
 
+func createAttendees(tx *gorm.DB, attendeeModels []AttendeeModel) error {
+	if len(attendeeModels) == 0 {
+		return nil
+	}
+
+	return tx.Create(&attendeeModels).Error
+}
+
+func createReminders(tx *gorm.DB, reminderModels []ReminderModel) error {
+	if len(reminderModels) == 0 {
+		return nil
+	}
+
+	return tx.Create(&reminderModels).Error
+}
+
+// withAssociations loads the attendees and reminders of the events found.
+func withAssociations(db *gorm.DB) *gorm.DB {
+	byPosition := func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	}
+
+	return db.Preload("Attendees", byPosition).Preload("Reminders", byPosition)
+}
+
+// inCalendarsOrInvited matches the events in calendarIDs and, unless
+// attendeeID is zero, the events attendeeID is invited to.
+func inCalendarsOrInvited(calendarIDs []calendar.CalendarID, attendeeID user.UserID) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if attendeeID == (user.UserID{}) {
+			return db.Where("calendar_id IN ?", calendarIDs)
+		}
+		return db.Where("calendar_id IN ? OR id IN (SELECT event_id FROM event_attendees WHERE user_id = ?)", calendarIDs, attendeeID.String())
+	}
+}
+
 func (r *eventRepository) FindByID(id event.EventID) (event.Event, error) {
 	var eventModel EventModel
-	err := r.db.First(&eventModel, "id = ?", id).Error
+	err := r.db.Scopes(withAssociations).First(&eventModel, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, event.ErrEventNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return eventModel.toEvent()
+}
+
+func (r *eventRepository) FindAllByUserID(userID user.UserID) ([]event.Event, error) {
+	var eventModels []EventModel
+	if err := r.db.Scopes(withAssociations).Where("user_id = ?", userID).Order("start_time asc, end_time asc").Find(&eventModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toEvents(eventModels)
+}
+
+func (r *eventRepository) FindByICalUID(userID user.UserID, icalUID string) (event.Event, error) {
+	var eventModel EventModel
+	err := r.db.Scopes(withAssociations).First(&eventModel, "user_id = ? AND ical_uid = ?", userID, icalUID).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, event.ErrEventNotFound
 	}
@@ -71,51 +162,391 @@ func (r *eventRepository) FindByID(id event.EventID) (event.Event, error) {
 		return nil, err
 	}
 
-	e := event.NewEvent(
-		eventModel.ID,
-		eventModel.UserID,
-		eventModel.Title,
-		eventModel.Description,
-		eventModel.StartTime,
-		eventModel.EndTime,
-		eventModel.Color,
-		eventModel.CreatedAt,
-		eventModel.UpdatedAt,
+	return eventModel.toEvent()
+}
+
+func (r *eventRepository) FindAllByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID) ([]event.Event, error) {
+	var eventModels []EventModel
+	if err := r.db.Scopes(withAssociations, inCalendarsOrInvited(calendarIDs, attendeeID)).Order("start_time asc, end_time asc").Find(&eventModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toEvents(eventModels)
+}
+
+func (r *eventRepository) FindByCalendarIDsAfterID(calendarIDs []calendar.CalendarID, attendeeID user.UserID, afterID *event.EventID, limit int) ([]event.Event, error) {
+	query := r.db.Scopes(withAssociations, inCalendarsOrInvited(calendarIDs, attendeeID))
+	if afterID != nil {
+		query = query.Where("id > ?", afterID)
+	}
+
+	var eventModels []EventModel
+	if err := query.Order("id asc").Limit(limit).Find(&eventModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toEvents(eventModels)
+}
+
+func (r *eventRepository) FindChangedByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID, since time.Time) ([]event.Event, error) {
+	var eventModels []EventModel
+	if err := r.db.Unscoped().Scopes(withAssociations, inCalendarsOrInvited(calendarIDs, attendeeID)).Where("updated_at > ? OR deleted_at > ?", since, since).Order("updated_at asc, id asc").Find(&eventModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toEvents(eventModels)
+}
+
+// FindDepartedByCalendarIDs reads who could see an event before each change
+// from the snapshot the outbox keeps of it.
+func (r *eventRepository) FindDepartedByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID, since time.Time) ([]event.EventID, error) {
+	ids := make([]string, 0, len(calendarIDs))
+	for _, id := range calendarIDs {
+		ids = append(ids, id.String())
+	}
+
+	query := r.db.Model(&OutboxModel{}).Distinct("event_id").Where("occurred_at > ?", since)
+	if attendeeID == (user.UserID{}) {
+		query = query.Where("before->>'calendar_id' IN ?", ids)
+	} else {
+		attendee, err := json.Marshal([]map[string]string{{"user_id": attendeeID.String()}})
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("before->>'calendar_id' IN ? OR before->'attendees' @> ?", ids, string(attendee))
+	}
+
+	var eventIDs []event.EventID
+	if err := query.Pluck("event_id", &eventIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return eventIDs, nil
+}
+
+func (r *eventRepository) FindByCalendarIDsInRange(calendarIDs []calendar.CalendarID, attendeeID user.UserID, from, to time.Time, after *event.Cursor, limit int) ([]event.Event, error) {
+	allDayFrom, allDayTo := event.AllDayWindow(from, to)
+	query := r.db.Scopes(withAssociations, inCalendarsOrInvited(calendarIDs, attendeeID)).Where(
+		"recurrence = '' AND ((all_day = false AND start_time < ? AND end_time > ?) OR (all_day = true AND start_time < ? AND end_time > ?))",
+		to, from, allDayTo, allDayFrom,
 	)
+	if after != nil {
+		query = query.Where("(start_time, id) > (?, ?)", after.StartTime(), after.ID())
+	}
 
-	return e, nil
+	var eventModels []EventModel
+	if err := query.Order("start_time asc, id asc").Limit(limit).Find(&eventModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toEvents(eventModels)
 }
 
-func (r *eventRepository) FindAllByUserID(userID user.UserID) ([]event.Event, error) {
+func (r *eventRepository) FindRecurringByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID, before time.Time) ([]event.Event, error) {
 	var eventModels []EventModel
-	if err := r.db.Where("user_id = ?", userID).Order("start_time asc, end_time asc").Find(&eventModels).Error; err != nil {
+	if err := r.db.Scopes(withAssociations, inCalendarsOrInvited(calendarIDs, attendeeID)).Where("recurrence <> '' AND start_time < ?", before).Order("start_time asc, id asc").Find(&eventModels).Error; err != nil {
 		return nil, err
 	}
 
-	var events []event.Event
+	return toEvents(eventModels)
+}
+
+func (r *eventRepository) FindByUserIDInRange(userID user.UserID, from, to time.Time) ([]event.Event, error) {
+	var eventModels []EventModel
+	if err := r.db.Scopes(withAssociations).Where(
+		"(user_id = ? OR id IN (SELECT event_id FROM event_attendees WHERE user_id = ?)) AND all_day = false AND ((recurrence = '' AND start_time < ? AND end_time > ?) OR (recurrence <> '' AND start_time < ?))",
+		userID, userID.String(), to, from, to,
+	).Order("start_time asc, id asc").Find(&eventModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toEvents(eventModels)
+}
+
+func (r *eventRepository) Delete(id event.EventID, version int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var beforeModel EventModel
+		err := tx.Scopes(withAssociations).First(&beforeModel, "id = ? AND version = ?", id, version).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return event.ErrVersionConflict
+		}
+		if err != nil {
+			return err
+		}
+
+		result := tx.Delete(&EventModel{}, "id = ? AND version = ?", id, version)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return event.ErrVersionConflict
+		}
+
+		return appendChange(tx, event.ChangeTypeDeleted, id, &beforeModel, nil)
+	})
+}
+
+// TrashCalendarEvents moves the live events of a calendar that is being
+// deleted to the trash in tx, recording a change for each, and hands all of
+// its events over to defaultCalendarID so that they can still be restored.
+func TrashCalendarEvents(tx *gorm.DB, calendarID, defaultCalendarID calendar.CalendarID) error {
+	var eventModels []EventModel
+	if err := tx.Scopes(withAssociations).Where("calendar_id = ?", calendarID).Find(&eventModels).Error; err != nil {
+		return err
+	}
+
+	if len(eventModels) > 0 {
+		if err := tx.Delete(&EventModel{}, "calendar_id = ?", calendarID).Error; err != nil {
+			return err
+		}
+	}
+
 	for _, eventModel := range eventModels {
-		e := event.NewEvent(
-			eventModel.ID,
-			eventModel.UserID,
-			eventModel.Title,
-			eventModel.Description,
-			eventModel.StartTime,
-			eventModel.EndTime,
-			eventModel.Color,
-			eventModel.CreatedAt,
-			eventModel.UpdatedAt,
-		)
-		events = append(events, e)
+		if err := appendChange(tx, event.ChangeTypeDeleted, eventModel.ID, &eventModel, nil); err != nil {
+			return err
+		}
 	}
 
-	return events, nil
+	return tx.Unscoped().Model(&EventModel{}).Where("calendar_id = ?", calendarID).UpdateColumn("calendar_id", defaultCalendarID).Error
 }
 
-func (r *eventRepository) Delete(id event.EventID) error {
+func (r *eventRepository) FindDeletedByID(id event.EventID) (event.Event, error) {
+	var eventModel EventModel
+	err := r.db.Unscoped().Scopes(withAssociations).Where("deleted_at IS NOT NULL").First(&eventModel, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, event.ErrEventNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return eventModel.toEvent()
+}
+
+func (r *eventRepository) FindDeletedByCalendarIDs(calendarIDs []calendar.CalendarID) ([]event.Event, error) {
+	var eventModels []EventModel
+	if err := r.db.Unscoped().Scopes(withAssociations).Where("calendar_id IN ? AND deleted_at IS NOT NULL", calendarIDs).Order("deleted_at desc, id asc").Find(&eventModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toEvents(eventModels)
+}
+
+func (r *eventRepository) LatestDeletionByUserID(userID user.UserID) (time.Time, error) {
+	var eventModels []EventModel
+	if err := r.db.Unscoped().Select("deleted_at").Where("user_id = ? AND deleted_at IS NOT NULL", userID).
+		Order("deleted_at desc").Limit(1).Find(&eventModels).Error; err != nil {
+		return time.Time{}, err
+	}
+	if len(eventModels) == 0 {
+		return time.Time{}, nil
+	}
+
+	return eventModels[0].DeletedAt.Time, nil
+}
+
+func (r *eventRepository) Restore(id event.EventID) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Delete(&EventModel{}, "id = ?", id).Error; err != nil {
+		var beforeModel EventModel
+		err := tx.Unscoped().Scopes(withAssociations).Where("deleted_at IS NOT NULL").First(&beforeModel, "id = ?", id).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return event.ErrEventNotFound
+		}
+		if err != nil {
 			return err
 		}
+
+		result := tx.Unscoped().Model(&EventModel{}).Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil)
+		if isUniqueViolation(result.Error, icalUIDIndex) {
+			return event.ErrICalUIDConflict
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return event.ErrEventNotFound
+		}
+
+		afterModel := beforeModel
+		afterModel.DeletedAt = gorm.DeletedAt{}
+		return appendChange(tx, event.ChangeTypeUpdated, id, &beforeModel, &afterModel)
+	})
+}
+
+func isUniqueViolation(err error, index string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == index
+}
+
+func (r *eventRepository) PurgeDeletedBefore(before time.Time) (int64, error) {
+	result := r.db.Unscoped().Where("deleted_at < ?", before).Delete(&EventModel{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// ClaimDueReminders locks up to limit reminders of live events that are due
+// by now, skipping those another poller holds, and moves each one on to the
+// next occurrence of its event so it is claimed only once.
+func (r *eventRepository) ClaimDueReminders(now time.Time, limit int) ([]event.DueReminder, error) {
+	var dueReminders []event.DueReminder
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var reminderModels []ReminderModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("trigger_time <= ? AND event_id IN (SELECT id FROM events WHERE deleted_at IS NULL)", now).
+			Order("trigger_time asc").Limit(limit).Find(&reminderModels).Error; err != nil {
+			return err
+		}
+		if len(reminderModels) == 0 {
+			return nil
+		}
+
+		var eventIDs []event.EventID
+		for _, reminderModel := range reminderModels {
+			eventIDs = append(eventIDs, reminderModel.EventID)
+		}
+
+		var eventModels []EventModel
+		if err := tx.Scopes(withAssociations).Where("id IN ?", eventIDs).Find(&eventModels).Error; err != nil {
+			return err
+		}
+
+		events := make(map[event.EventID]event.Event, len(eventModels))
+		for _, eventModel := range eventModels {
+			e, err := eventModel.toEvent()
+			if err != nil {
+				return err
+			}
+			events[e.ID()] = e
+		}
+
+		for _, reminderModel := range reminderModels {
+			e, ok := events[reminderModel.EventID]
+			if !ok {
+				continue
+			}
+
+			reminder, err := reminderModel.toReminder()
+			if err != nil {
+				return err
+			}
+
+			triggerTime := *reminderModel.TriggerTime
+			dueReminders = append(dueReminders, event.DueReminder{
+				EventID:     e.ID(),
+				UserID:      e.UserID(),
+				Title:       e.Title(),
+				StartTime:   triggerTime.Add(reminder.Offset()),
+				Reminder:    reminder,
+				TriggerTime: triggerTime,
+				Version:     e.Version(),
+			})
+
+			var nextTriggerTime *time.Time
+			if next, ok := e.NextReminderTime(reminder, triggerTime); ok {
+				nextTriggerTime = &next
+			}
+			if err := tx.Model(&ReminderModel{}).Where("event_id = ? AND position = ?", reminderModel.EventID, reminderModel.Position).Update("trigger_time", nextTriggerTime).Error; err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dueReminders, nil
+}
+
+func (r *eventRepository) ReleaseReminder(dueReminder event.DueReminder) error {
+	return r.db.Model(&ReminderModel{}).
+		Where("event_id = ? AND offset_minutes = ? AND channel = ? AND event_id IN (SELECT id FROM events WHERE version = ? AND deleted_at IS NULL)",
+			dueReminder.EventID, int(dueReminder.Reminder.Offset()/time.Minute), dueReminder.Reminder.Channel(), dueReminder.Version).
+		Update("trigger_time", dueReminder.TriggerTime).Error
+}
+
+// RelayChanges passes up to limit unpublished changes to publish in the
+// order they were made, skipping those another relay holds, and marks them
+// published. It stops at the first change publish fails on, which is then
+// retried by the next call.
+func (r *eventRepository) RelayChanges(limit int, publish func(event.Change) error) (int, error) {
+	var published []int64
+	var publishErr error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var outboxModels []OutboxModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL").Order("id asc").Limit(limit).Find(&outboxModels).Error; err != nil {
+			return err
+		}
+
+		for _, outboxModel := range outboxModels {
+			change, err := outboxModel.toChange()
+			if err != nil {
+				return err
+			}
+			if publishErr = publish(change); publishErr != nil {
+				break
+			}
+			published = append(published, outboxModel.ID)
+		}
+		if len(published) == 0 {
+			return nil
+		}
+
+		return tx.Model(&OutboxModel{}).Where("id IN ?", published).Update("published_at", time.Now()).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(published), publishErr
+}
+
+// finishedTxIDs limits changes to transactions that started before every
+// running one, which have all committed or rolled back.
+const finishedTxIDs = "tx_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint"
+
+func (r *eventRepository) FindChangesAfter(after event.ChangePosition, limit int) ([]event.Change, error) {
+	var outboxModels []OutboxModel
+	if err := r.db.Where("(tx_id, id) > (?, ?) AND "+finishedTxIDs, after.TxID, after.ChangeID).
+		Order("tx_id asc, id asc").Limit(limit).Find(&outboxModels).Error; err != nil {
+		return nil, err
+	}
+
+	changes := make([]event.Change, 0, len(outboxModels))
+	for _, outboxModel := range outboxModels {
+		change, err := outboxModel.toChange()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func (r *eventRepository) LatestChangePosition() (event.ChangePosition, error) {
+	var outboxModels []OutboxModel
+	if err := r.db.Select("tx_id", "id").Where(finishedTxIDs).
+		Order("tx_id desc, id desc").Limit(1).Find(&outboxModels).Error; err != nil {
+		return event.ChangePosition{}, err
+	}
+	if len(outboxModels) == 0 {
+		return event.ChangePosition{}, nil
+	}
+
+	return event.ChangePosition{TxID: outboxModels[0].TxID, ChangeID: outboxModels[0].ID}, nil
+}
+
+func (r *eventRepository) PurgePublishedChangesBefore(before time.Time) (int64, error) {
+	result := r.db.Where("published_at IS NOT NULL AND occurred_at < ?", before).Delete(&OutboxModel{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
 }
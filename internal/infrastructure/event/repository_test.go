@@ -8,10 +8,12 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"go.uber.org/mock/gomock"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"github.com/qkitzero/event-service/internal/domain/calendar"
 	"github.com/qkitzero/event-service/internal/domain/event"
 	"github.com/qkitzero/event-service/internal/domain/user"
 	mocksevent "github.com/qkitzero/event-service/mocks/domain/event"
@@ -20,10 +22,20 @@ import (
 
 func TestCreate(t *testing.T) {
 	t.Parallel()
+	attendee, err := event.NewAttendee(user.UserID{}, "guest@example.com", event.AttendeeRoleRequired, event.ResponseStatusNeedsAction)
+	if err != nil {
+		t.Fatalf("failed to new attendee: %v", err)
+	}
+	reminder, err := event.NewReminder(10*time.Minute, event.ReminderChannelPopup)
+	if err != nil {
+		t.Fatalf("failed to new reminder: %v", err)
+	}
 	tests := []struct {
-		name    string
-		success bool
-		setup   func(mock sqlmock.Sqlmock, event event.Event)
+		name      string
+		success   bool
+		attendees []event.Attendee
+		reminders []event.Reminder
+		setup     func(mock sqlmock.Sqlmock, event event.Event)
 	}{
 		{
 			name:    "success create event",
@@ -31,10 +43,58 @@ func TestCreate(t *testing.T) {
 			setup: func(mock sqlmock.Sqlmock, event event.Event) {
 				mock.ExpectBegin()
 
-				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "events" ("id","user_id","title","description","start_time","end_time","color","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`)).
-					WithArgs(event.ID(), event.UserID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.Color(), testutil.AnyTime{}, testutil.AnyTime{}).
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "events" ("id","user_id","calendar_id","title","description","start_time","end_time","all_day","time_zone","color","recurrence","ical_uid","version","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`)).
+					WithArgs(event.ID(), event.UserID(), event.CalendarID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.AllDay(), event.TimeZone().String(), event.Color(), event.Recurrence().String(), event.ICalUID(), event.Version(), testutil.AnyTime{}, testutil.AnyTime{}, nil).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox" ("type","event_id","before","after","occurred_at","published_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+					WithArgs("EventCreated", event.ID(), []byte(nil), sqlmock.AnyArg(), testutil.AnyTime{}, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:      "success create event with attendees",
+			success:   true,
+			attendees: []event.Attendee{attendee},
+			setup: func(mock sqlmock.Sqlmock, event event.Event) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "events" ("id","user_id","calendar_id","title","description","start_time","end_time","all_day","time_zone","color","recurrence","ical_uid","version","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`)).
+					WithArgs(event.ID(), event.UserID(), event.CalendarID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.AllDay(), event.TimeZone().String(), event.Color(), event.Recurrence().String(), event.ICalUID(), event.Version(), testutil.AnyTime{}, testutil.AnyTime{}, nil).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "event_attendees" ("event_id","position","user_id","email","role","response_status") VALUES ($1,$2,$3,$4,$5,$6)`)).
+					WithArgs(event.ID(), 0, "", "guest@example.com", "required", "needs_action").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox" ("type","event_id","before","after","occurred_at","published_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+					WithArgs("EventCreated", event.ID(), []byte(nil), sqlmock.AnyArg(), testutil.AnyTime{}, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:      "success create event with reminders",
+			success:   true,
+			reminders: []event.Reminder{reminder},
+			setup: func(mock sqlmock.Sqlmock, event event.Event) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "events" ("id","user_id","calendar_id","title","description","start_time","end_time","all_day","time_zone","color","recurrence","ical_uid","version","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`)).
+					WithArgs(event.ID(), event.UserID(), event.CalendarID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.AllDay(), event.TimeZone().String(), event.Color(), event.Recurrence().String(), event.ICalUID(), event.Version(), testutil.AnyTime{}, testutil.AnyTime{}, nil).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "event_reminders" ("event_id","position","offset_minutes","channel","trigger_time") VALUES ($1,$2,$3,$4,$5)`)).
+					WithArgs(event.ID(), 0, 10, "popup", testutil.AnyTime{}).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox" ("type","event_id","before","after","occurred_at","published_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+					WithArgs("EventCreated", event.ID(), []byte(nil), sqlmock.AnyArg(), testutil.AnyTime{}, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
 				mock.ExpectCommit()
 			},
 		},
@@ -44,8 +104,8 @@ func TestCreate(t *testing.T) {
 			setup: func(mock sqlmock.Sqlmock, event event.Event) {
 				mock.ExpectBegin()
 
-				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "events" ("id","user_id","title","description","start_time","end_time","color","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`)).
-					WithArgs(event.ID(), event.UserID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.Color(), testutil.AnyTime{}, testutil.AnyTime{}).
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "events" ("id","user_id","calendar_id","title","description","start_time","end_time","all_day","time_zone","color","recurrence","ical_uid","version","created_at","updated_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`)).
+					WithArgs(event.ID(), event.UserID(), event.CalendarID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.AllDay(), event.TimeZone().String(), event.Color(), event.Recurrence().String(), event.ICalUID(), event.Version(), testutil.AnyTime{}, testutil.AnyTime{}, nil).
 					WillReturnError(errors.New("create event error"))
 
 				mock.ExpectRollback()
@@ -73,13 +133,24 @@ func TestCreate(t *testing.T) {
 			mockEvent := mocksevent.NewMockEvent(ctrl)
 			mockEvent.EXPECT().ID().Return(event.EventID{UUID: uuid.New()}).AnyTimes()
 			mockEvent.EXPECT().UserID().Return(user.UserID{UUID: uuid.New()}).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.CalendarID{UUID: uuid.New()}).AnyTimes()
 			mockEvent.EXPECT().Title().Return(event.Title("title")).AnyTimes()
 			mockEvent.EXPECT().Description().Return(event.Description("description")).AnyTimes()
 			mockEvent.EXPECT().StartTime().Return(time.Now()).AnyTimes()
 			mockEvent.EXPECT().EndTime().Return(time.Now()).AnyTimes()
 			mockEvent.EXPECT().CreatedAt().Return(time.Now()).AnyTimes()
 			mockEvent.EXPECT().UpdatedAt().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(false).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
 			mockEvent.EXPECT().Color().Return(event.Color("#FFFFFF")).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ICalUID().Return("fe8c2263-bbac-4bb9-a41d-b04f5afc4425@event-service").AnyTimes()
+			mockEvent.EXPECT().Version().Return(int64(2)).AnyTimes()
+			mockEvent.EXPECT().DeletedAt().Return(time.Time{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(false).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(tt.attendees).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(tt.reminders).AnyTimes()
+			mockEvent.EXPECT().NextReminderTime(gomock.Any(), gomock.Any()).Return(time.Now(), true).AnyTimes()
 
 			tt.setup(mock, mockEvent)
 
@@ -113,21 +184,71 @@ func TestUpdate(t *testing.T) {
 			setup: func(mock sqlmock.Sqlmock, event event.Event) {
 				mock.ExpectBegin()
 
-				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "user_id"=$1,"title"=$2,"description"=$3,"start_time"=$4,"end_time"=$5,"color"=$6,"created_at"=$7,"updated_at"=$8 WHERE "id" = $9`)).
-					WithArgs(event.UserID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.Color(), testutil.AnyTime{}, testutil.AnyTime{}, event.ID()).
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(event.ID(), uuid.New(), "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (id = $1 AND version = $2) AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $3`)).
+					WithArgs(event.ID(), event.Version()-1, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(event.ID()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(event.ID()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "user_id"=$1,"calendar_id"=$2,"title"=$3,"description"=$4,"start_time"=$5,"end_time"=$6,"all_day"=$7,"time_zone"=$8,"color"=$9,"recurrence"=$10,"ical_uid"=$11,"version"=$12,"created_at"=$13,"updated_at"=$14 WHERE version = $15 AND "events"."deleted_at" IS NULL AND "id" = $16`)).
+					WithArgs(event.UserID(), event.CalendarID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.AllDay(), event.TimeZone().String(), event.Color(), event.Recurrence().String(), event.ICalUID(), event.Version(), testutil.AnyTime{}, testutil.AnyTime{}, event.Version()-1, event.ID()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "event_attendees" WHERE event_id = $1`)).
+					WithArgs(event.ID()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "event_reminders" WHERE event_id = $1`)).
+					WithArgs(event.ID()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox" ("type","event_id","before","after","occurred_at","published_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+					WithArgs("EventUpdated", event.ID(), sqlmock.AnyArg(), sqlmock.AnyArg(), testutil.AnyTime{}, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name:    "failure version conflict",
+			success: false,
+			setup: func(mock sqlmock.Sqlmock, event event.Event) {
+				mock.ExpectBegin()
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"})
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (id = $1 AND version = $2) AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $3`)).
+					WithArgs(event.ID(), event.Version()-1, 1).
+					WillReturnRows(eventRows)
+
+				mock.ExpectRollback()
+			},
+		},
 		{
 			name:    "failure update event error",
 			success: false,
 			setup: func(mock sqlmock.Sqlmock, event event.Event) {
 				mock.ExpectBegin()
 
-				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "user_id"=$1,"title"=$2,"description"=$3,"start_time"=$4,"end_time"=$5,"color"=$6,"created_at"=$7,"updated_at"=$8 WHERE "id" = $9`)).
-					WithArgs(event.UserID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.Color(), testutil.AnyTime{}, testutil.AnyTime{}, event.ID()).
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(event.ID(), uuid.New(), "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (id = $1 AND version = $2) AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $3`)).
+					WithArgs(event.ID(), event.Version()-1, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(event.ID()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(event.ID()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "user_id"=$1,"calendar_id"=$2,"title"=$3,"description"=$4,"start_time"=$5,"end_time"=$6,"all_day"=$7,"time_zone"=$8,"color"=$9,"recurrence"=$10,"ical_uid"=$11,"version"=$12,"created_at"=$13,"updated_at"=$14 WHERE version = $15 AND "events"."deleted_at" IS NULL AND "id" = $16`)).
+					WithArgs(event.UserID(), event.CalendarID(), event.Title(), event.Description(), testutil.AnyTime{}, testutil.AnyTime{}, event.AllDay(), event.TimeZone().String(), event.Color(), event.Recurrence().String(), event.ICalUID(), event.Version(), testutil.AnyTime{}, testutil.AnyTime{}, event.Version()-1, event.ID()).
 					WillReturnError(errors.New("update event error"))
 
 				mock.ExpectRollback()
@@ -155,19 +276,29 @@ func TestUpdate(t *testing.T) {
 			mockEvent := mocksevent.NewMockEvent(ctrl)
 			mockEvent.EXPECT().ID().Return(event.EventID{UUID: uuid.New()}).AnyTimes()
 			mockEvent.EXPECT().UserID().Return(user.UserID{UUID: uuid.New()}).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.CalendarID{UUID: uuid.New()}).AnyTimes()
 			mockEvent.EXPECT().Title().Return(event.Title("title")).AnyTimes()
 			mockEvent.EXPECT().Description().Return(event.Description("description")).AnyTimes()
 			mockEvent.EXPECT().StartTime().Return(time.Now()).AnyTimes()
 			mockEvent.EXPECT().EndTime().Return(time.Now()).AnyTimes()
 			mockEvent.EXPECT().CreatedAt().Return(time.Now()).AnyTimes()
 			mockEvent.EXPECT().UpdatedAt().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(false).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
 			mockEvent.EXPECT().Color().Return(event.Color("#FFFFFF")).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ICalUID().Return("fe8c2263-bbac-4bb9-a41d-b04f5afc4425@event-service").AnyTimes()
+			mockEvent.EXPECT().Version().Return(int64(2)).AnyTimes()
+			mockEvent.EXPECT().DeletedAt().Return(time.Time{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(false).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
 
 			tt.setup(mock, mockEvent)
 
 			repo := NewEventRepository(gormDB)
 
-			err = repo.Update(mockEvent)
+			err = repo.Update(mockEvent, mockEvent.Version()-1)
 			if tt.success && err != nil {
 				t.Errorf("expected no error, but got %v", err)
 			}
@@ -182,6 +313,62 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestKeepTriggerTimes(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	stored := start.Add(-10 * time.Minute)
+	recomputed := start.Add(7*24*time.Hour - 10*time.Minute)
+	before := EventModel{
+		StartTime:  start,
+		TimeZone:   "Asia/Tokyo",
+		Recurrence: "RRULE:FREQ=WEEKLY",
+		Reminders:  []ReminderModel{{Position: 0, OffsetMinutes: 10, TriggerTime: &stored}},
+	}
+	tests := []struct {
+		name     string
+		after    EventModel
+		expected time.Time
+	}{
+		{
+			name:     "schedule unchanged",
+			after:    EventModel{StartTime: start, TimeZone: "Asia/Tokyo", Recurrence: "RRULE:FREQ=WEEKLY"},
+			expected: stored,
+		},
+		{
+			name:     "start time moved",
+			after:    EventModel{StartTime: start.Add(time.Hour), TimeZone: "Asia/Tokyo", Recurrence: "RRULE:FREQ=WEEKLY"},
+			expected: recomputed,
+		},
+		{
+			name:     "recurrence changed",
+			after:    EventModel{StartTime: start, TimeZone: "Asia/Tokyo", Recurrence: "RRULE:FREQ=DAILY"},
+			expected: recomputed,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			triggerTime := recomputed
+			after := tt.after
+			after.Reminders = []ReminderModel{
+				{Position: 0, OffsetMinutes: 10, TriggerTime: &triggerTime},
+				{Position: 1, OffsetMinutes: 30, TriggerTime: nil},
+			}
+
+			keepTriggerTimes(before, &after)
+
+			if got := after.Reminders[0].TriggerTime; got == nil || !got.Equal(tt.expected) {
+				t.Errorf("TriggerTime = %v, want %v", got, tt.expected)
+			}
+			if got := after.Reminders[1].TriggerTime; got != nil {
+				t.Errorf("TriggerTime of a new reminder = %v, want nil", got)
+			}
+		})
+	}
+}
+
 func TestFindByID(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -195,11 +382,19 @@ func TestFindByID(t *testing.T) {
 			success: true,
 			id:      event.EventID{UUID: uuid.New()},
 			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
-				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "color", "created_at", "updated_at"}).
-					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), "#FFFFFF", time.Now(), time.Now())
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id = $1 ORDER BY "events"."id" LIMIT $2`)).
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "RRULE:FREQ=WEEKLY;BYDAY=MO", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id = $1 AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $2`)).
 					WithArgs(id, 1).
 					WillReturnRows(eventRows)
+				attendeeRows := sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}).
+					AddRow(id, 0, "", "guest@example.com", "optional", "accepted")
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(attendeeRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
 			},
 		},
 		{
@@ -207,7 +402,7 @@ func TestFindByID(t *testing.T) {
 			success: false,
 			id:      event.EventID{UUID: uuid.New()},
 			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id = $1 ORDER BY "events"."id" LIMIT $2`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id = $1 AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $2`)).
 					WithArgs(id, 1).
 					WillReturnError(gorm.ErrRecordNotFound)
 			},
@@ -217,7 +412,7 @@ func TestFindByID(t *testing.T) {
 			success: false,
 			id:      event.EventID{UUID: uuid.New()},
 			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id = $1 ORDER BY "events"."id" LIMIT $2`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id = $1 AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $2`)).
 					WithArgs(id, 1).
 					WillReturnError(errors.New("find by id error"))
 			},
@@ -260,6 +455,94 @@ func TestFindByID(t *testing.T) {
 	}
 }
 
+func TestFindByICalUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		userID  user.UserID
+		icalUID string
+		setup   func(mock sqlmock.Sqlmock, userID user.UserID, icalUID string)
+	}{
+		{
+			name:    "success find by ical uid",
+			success: true,
+			userID:  user.UserID{UUID: uuid.New()},
+			icalUID: "source-uid@example.com",
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID, icalUID string) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "ical_uid", "version", "created_at", "updated_at"}).
+					AddRow(uuid.New(), userID, "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "", icalUID, 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (user_id = $1 AND ical_uid = $2) AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $3`)).
+					WithArgs(userID, icalUID, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+			},
+		},
+		{
+			name:    "failure event not found",
+			success: false,
+			userID:  user.UserID{UUID: uuid.New()},
+			icalUID: "source-uid@example.com",
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID, icalUID string) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (user_id = $1 AND ical_uid = $2) AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $3`)).
+					WithArgs(userID, icalUID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name:    "failure find by ical uid error",
+			success: false,
+			userID:  user.UserID{UUID: uuid.New()},
+			icalUID: "source-uid@example.com",
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID, icalUID string) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (user_id = $1 AND ical_uid = $2) AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $3`)).
+					WithArgs(userID, icalUID, 1).
+					WillReturnError(errors.New("find by ical uid error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.userID, tt.icalUID)
+
+			repo := NewEventRepository(gormDB)
+
+			foundEvent, err := repo.FindByICalUID(tt.userID, tt.icalUID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && foundEvent.ICalUID() != tt.icalUID {
+				t.Errorf("ICalUID() = %v, want %v", foundEvent.ICalUID(), tt.icalUID)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
 func TestFindAllByUserID(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -273,11 +556,17 @@ func TestFindAllByUserID(t *testing.T) {
 			success: true,
 			userID:  user.UserID{UUID: uuid.New()},
 			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
-				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "color", "created_at", "updated_at"}).
-					AddRow(uuid.New(), userID, "title", "description", time.Now(), time.Now(), "#FFFFFF", time.Now(), time.Now())
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE user_id = $1 ORDER BY start_time asc, end_time asc`)).
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(uuid.New(), userID, "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "RRULE:FREQ=WEEKLY;BYDAY=MO", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE user_id = $1 AND "events"."deleted_at" IS NULL ORDER BY start_time asc, end_time asc`)).
 					WithArgs(userID).
 					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
 			},
 		},
 		{
@@ -285,7 +574,7 @@ func TestFindAllByUserID(t *testing.T) {
 			success: false,
 			userID:  user.UserID{UUID: uuid.New()},
 			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE user_id = $1 ORDER BY start_time asc, end_time asc`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE user_id = $1 AND "events"."deleted_at" IS NULL ORDER BY start_time asc, end_time asc`)).
 					WithArgs(userID).
 					WillReturnError(errors.New("find events error"))
 			},
@@ -328,40 +617,51 @@ func TestFindAllByUserID(t *testing.T) {
 	}
 }
 
-func TestDelete(t *testing.T) {
+func TestFindAllByCalendarIDs(t *testing.T) {
 	t.Parallel()
+	calendarIDs := []calendar.CalendarID{{UUID: uuid.New()}, {UUID: uuid.New()}}
+	attendeeID := user.UserID{UUID: uuid.New()}
 	tests := []struct {
-		name    string
-		success bool
-		id      event.EventID
-		setup   func(mock sqlmock.Sqlmock, id event.EventID)
+		name       string
+		success    bool
+		attendeeID user.UserID
+		setup      func(mock sqlmock.Sqlmock)
 	}{
 		{
-			name:    "success delete event",
+			name:    "success find all by calendar ids",
 			success: true,
-			id:      event.EventID{UUID: uuid.New()},
-			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
-				mock.ExpectBegin()
-
-				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "events" WHERE id = $1`)).
-					WithArgs(id).
-					WillReturnResult(sqlmock.NewResult(1, 1))
-
-				mock.ExpectCommit()
+			setup: func(mock sqlmock.Sqlmock) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(uuid.New(), uuid.New(), calendarIDs[0], "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE calendar_id IN ($1,$2) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, end_time asc`)).
+					WithArgs(calendarIDs[0], calendarIDs[1]).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
 			},
 		},
 		{
-			name:    "failure delete event error",
+			name:       "success find all by calendar ids with invited events",
+			success:    true,
+			attendeeID: attendeeID,
+			setup: func(mock sqlmock.Sqlmock) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"})
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (calendar_id IN ($1,$2) OR id IN (SELECT event_id FROM event_attendees WHERE user_id = $3)) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, end_time asc`)).
+					WithArgs(calendarIDs[0], calendarIDs[1], attendeeID.String()).
+					WillReturnRows(eventRows)
+			},
+		},
+		{
+			name:    "failure find all by calendar ids error",
 			success: false,
-			id:      event.EventID{UUID: uuid.New()},
-			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
-				mock.ExpectBegin()
-
-				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "events" WHERE id = $1`)).
-					WithArgs(id).
-					WillReturnError(errors.New("delete event error"))
-
-				mock.ExpectRollback()
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE calendar_id IN ($1,$2) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, end_time asc`)).
+					WithArgs(calendarIDs[0], calendarIDs[1]).
+					WillReturnError(errors.New("find all by calendar ids error"))
 			},
 		},
 	}
@@ -383,17 +683,1864 @@ func TestDelete(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			tt.setup(mock, tt.id)
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			_, err = repo.FindAllByCalendarIDs(calendarIDs, tt.attendeeID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindByCalendarIDsAfterID(t *testing.T) {
+	t.Parallel()
+	calendarIDs := []calendar.CalendarID{{UUID: uuid.New()}}
+	attendeeID := user.UserID{UUID: uuid.New()}
+	afterID := event.EventID{UUID: uuid.New()}
+	tests := []struct {
+		name          string
+		success       bool
+		afterID       *event.EventID
+		expectedCount int
+		setup         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:          "success find first page",
+			success:       true,
+			afterID:       nil,
+			expectedCount: 1,
+			setup: func(mock sqlmock.Sqlmock) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(uuid.New(), uuid.New(), calendarIDs[0], "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (calendar_id IN ($1) OR id IN (SELECT event_id FROM event_attendees WHERE user_id = $2)) AND "events"."deleted_at" IS NULL ORDER BY id asc LIMIT $3`)).
+					WithArgs(calendarIDs[0], attendeeID.String(), 10).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+			},
+		},
+		{
+			name:          "success find next page",
+			success:       true,
+			afterID:       &afterID,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id > $1 AND (calendar_id IN ($2) OR id IN (SELECT event_id FROM event_attendees WHERE user_id = $3)) AND "events"."deleted_at" IS NULL ORDER BY id asc LIMIT $4`)).
+					WithArgs(afterID, calendarIDs[0], attendeeID.String(), 10).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name:          "failure find by calendar ids after id error",
+			success:       false,
+			afterID:       nil,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (calendar_id IN ($1) OR id IN (SELECT event_id FROM event_attendees WHERE user_id = $2)) AND "events"."deleted_at" IS NULL ORDER BY id asc LIMIT $3`)).
+					WithArgs(calendarIDs[0], attendeeID.String(), 10).
+					WillReturnError(errors.New("find by calendar ids after id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			events, err := repo.FindByCalendarIDsAfterID(calendarIDs, attendeeID, tt.afterID, 10)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(events) != tt.expectedCount {
+				t.Errorf("len(events) = %v, want %v", len(events), tt.expectedCount)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindChangedByCalendarIDs(t *testing.T) {
+	t.Parallel()
+	calendarIDs := []calendar.CalendarID{{UUID: uuid.New()}, {UUID: uuid.New()}}
+	attendeeID := user.UserID{UUID: uuid.New()}
+	since := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name          string
+		success       bool
+		expectedCount int
+		setup         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:          "success find changed by calendar ids",
+			success:       true,
+			expectedCount: 2,
+			setup: func(mock sqlmock.Sqlmock) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at", "deleted_at"}).
+					AddRow(uuid.New(), uuid.New(), calendarIDs[0], "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "", 1, time.Now(), time.Now(), nil).
+					AddRow(uuid.New(), uuid.New(), calendarIDs[1], "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "", 1, time.Now(), time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (updated_at > $1 OR deleted_at > $2) AND (calendar_id IN ($3,$4) OR id IN (SELECT event_id FROM event_attendees WHERE user_id = $5)) ORDER BY updated_at asc, id asc`)).
+					WithArgs(since, since, calendarIDs[0], calendarIDs[1], attendeeID.String()).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" IN ($1,$2) ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" IN ($1,$2) ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+			},
+		},
+		{
+			name:          "failure find changed by calendar ids error",
+			success:       false,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (updated_at > $1 OR deleted_at > $2) AND (calendar_id IN ($3,$4) OR id IN (SELECT event_id FROM event_attendees WHERE user_id = $5)) ORDER BY updated_at asc, id asc`)).
+					WithArgs(since, since, calendarIDs[0], calendarIDs[1], attendeeID.String()).
+					WillReturnError(errors.New("find changed by calendar ids error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock)
 
 			repo := NewEventRepository(gormDB)
 
-			err = repo.Delete(tt.id)
+			events, err := repo.FindChangedByCalendarIDs(calendarIDs, attendeeID, since)
 			if tt.success && err != nil {
 				t.Errorf("expected no error, but got %v", err)
 			}
 			if !tt.success && err == nil {
 				t.Errorf("expected error, but got nil")
 			}
+			if len(events) != tt.expectedCount {
+				t.Errorf("len(events) = %v, want %v", len(events), tt.expectedCount)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindDepartedByCalendarIDs(t *testing.T) {
+	t.Parallel()
+	calendarIDs := []calendar.CalendarID{{UUID: uuid.New()}, {UUID: uuid.New()}}
+	attendeeID := user.UserID{UUID: uuid.New()}
+	since := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name          string
+		success       bool
+		expectedCount int
+		setup         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:          "success find departed by calendar ids",
+			success:       true,
+			expectedCount: 2,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT "event_id" FROM "outbox" WHERE occurred_at > $1 AND (before->>'calendar_id' IN ($2,$3) OR before->'attendees' @> $4)`)).
+					WithArgs(since, calendarIDs[0].String(), calendarIDs[1].String(), `[{"user_id":"`+attendeeID.String()+`"}]`).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id"}).AddRow(uuid.New().String()).AddRow(uuid.New().String()))
+			},
+		},
+		{
+			name:          "failure find departed by calendar ids error",
+			success:       false,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT "event_id" FROM "outbox" WHERE occurred_at > $1 AND (before->>'calendar_id' IN ($2,$3) OR before->'attendees' @> $4)`)).
+					WithArgs(since, calendarIDs[0].String(), calendarIDs[1].String(), `[{"user_id":"`+attendeeID.String()+`"}]`).
+					WillReturnError(errors.New("find departed by calendar ids error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			eventIDs, err := repo.FindDepartedByCalendarIDs(calendarIDs, attendeeID, since)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(eventIDs) != tt.expectedCount {
+				t.Errorf("len(eventIDs) = %v, want %v", len(eventIDs), tt.expectedCount)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindByCalendarIDsInRange(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	allDayFrom, allDayTo := event.AllDayWindow(from, to)
+	cursor := event.NewCursor(from, event.EventID{UUID: uuid.New()})
+	calendarIDs := []calendar.CalendarID{{UUID: uuid.New()}, {UUID: uuid.New()}}
+	tests := []struct {
+		name    string
+		success bool
+		after   *event.Cursor
+		setup   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:    "success find by calendar ids in range",
+			success: true,
+			after:   nil,
+			setup: func(mock sqlmock.Sqlmock) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(uuid.New(), uuid.New(), calendarIDs[0], "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (recurrence = '' AND ((all_day = false AND start_time < $1 AND end_time > $2) OR (all_day = true AND start_time < $3 AND end_time > $4))) AND calendar_id IN ($5,$6) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, id asc LIMIT $7`)).
+					WithArgs(to, from, allDayTo, allDayFrom, calendarIDs[0], calendarIDs[1], 11).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+			},
+		},
+		{
+			name:    "success find by calendar ids in range after cursor",
+			success: true,
+			after:   &cursor,
+			setup: func(mock sqlmock.Sqlmock) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"})
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (recurrence = '' AND ((all_day = false AND start_time < $1 AND end_time > $2) OR (all_day = true AND start_time < $3 AND end_time > $4))) AND (start_time, id) > ($5, $6) AND calendar_id IN ($7,$8) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, id asc LIMIT $9`)).
+					WithArgs(to, from, allDayTo, allDayFrom, cursor.StartTime(), cursor.ID(), calendarIDs[0], calendarIDs[1], 11).
+					WillReturnRows(eventRows)
+			},
+		},
+		{
+			name:    "failure find by calendar ids in range error",
+			success: false,
+			after:   nil,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (recurrence = '' AND ((all_day = false AND start_time < $1 AND end_time > $2) OR (all_day = true AND start_time < $3 AND end_time > $4))) AND calendar_id IN ($5,$6) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, id asc LIMIT $7`)).
+					WithArgs(to, from, allDayTo, allDayFrom, calendarIDs[0], calendarIDs[1], 11).
+					WillReturnError(errors.New("find by calendar ids in range error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			_, err = repo.FindByCalendarIDsInRange(calendarIDs, user.UserID{}, from, to, tt.after, 11)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindRecurringByCalendarIDs(t *testing.T) {
+	t.Parallel()
+	before := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	calendarID := calendar.CalendarID{UUID: uuid.New()}
+	tests := []struct {
+		name    string
+		success bool
+		setup   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:    "success find recurring by calendar ids",
+			success: true,
+			setup: func(mock sqlmock.Sqlmock) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(uuid.New(), uuid.New(), calendarID, "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "RRULE:FREQ=DAILY", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (recurrence <> '' AND start_time < $1) AND calendar_id IN ($2) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, id asc`)).
+					WithArgs(before, calendarID).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+			},
+		},
+		{
+			name:    "failure invalid recurrence",
+			success: false,
+			setup: func(mock sqlmock.Sqlmock) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(uuid.New(), uuid.New(), calendarID, "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "RRULE:FREQ=HOURLY", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (recurrence <> '' AND start_time < $1) AND calendar_id IN ($2) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, id asc`)).
+					WithArgs(before, calendarID).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+			},
+		},
+		{
+			name:    "failure find recurring by calendar ids error",
+			success: false,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (recurrence <> '' AND start_time < $1) AND calendar_id IN ($2) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, id asc`)).
+					WithArgs(before, calendarID).
+					WillReturnError(errors.New("find recurring by calendar ids error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			_, err = repo.FindRecurringByCalendarIDs([]calendar.CalendarID{calendarID}, user.UserID{}, before)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindByUserIDInRange(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC)
+	userID := user.UserID{UUID: uuid.New()}
+	tests := []struct {
+		name    string
+		success bool
+		setup   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:    "success find by user id in range",
+			success: true,
+			setup: func(mock sqlmock.Sqlmock) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(uuid.New(), userID, uuid.New(), "title", "description", from, to, false, "Asia/Tokyo", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE ((user_id = $1 OR id IN (SELECT event_id FROM event_attendees WHERE user_id = $2)) AND all_day = false AND ((recurrence = '' AND start_time < $3 AND end_time > $4) OR (recurrence <> '' AND start_time < $5))) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, id asc`)).
+					WithArgs(userID, userID.String(), to, from, to).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+			},
+		},
+		{
+			name:    "failure find by user id in range error",
+			success: false,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE ((user_id = $1 OR id IN (SELECT event_id FROM event_attendees WHERE user_id = $2)) AND all_day = false AND ((recurrence = '' AND start_time < $3 AND end_time > $4) OR (recurrence <> '' AND start_time < $5))) AND "events"."deleted_at" IS NULL ORDER BY start_time asc, id asc`)).
+					WithArgs(userID, userID.String(), to, from, to).
+					WillReturnError(errors.New("find by user id in range error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			_, err = repo.FindByUserIDInRange(userID, from, to)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		id      event.EventID
+		setup   func(mock sqlmock.Sqlmock, id event.EventID)
+	}{
+		{
+			name:    "success delete event",
+			success: true,
+			id:      event.EventID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
+				mock.ExpectBegin()
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (id = $1 AND version = $2) AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $3`)).
+					WithArgs(id, 1, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "deleted_at"=$1 WHERE (id = $2 AND version = $3) AND "events"."deleted_at" IS NULL`)).
+					WithArgs(testutil.AnyTime{}, id, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox" ("type","event_id","before","after","occurred_at","published_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+					WithArgs("EventDeleted", id, sqlmock.AnyArg(), []byte(nil), testutil.AnyTime{}, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "failure version conflict",
+			success: false,
+			id:      event.EventID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
+				mock.ExpectBegin()
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (id = $1 AND version = $2) AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $3`)).
+					WithArgs(id, 1, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "deleted_at"=$1 WHERE (id = $2 AND version = $3) AND "events"."deleted_at" IS NULL`)).
+					WithArgs(testutil.AnyTime{}, id, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "failure delete event error",
+			success: false,
+			id:      event.EventID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
+				mock.ExpectBegin()
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE (id = $1 AND version = $2) AND "events"."deleted_at" IS NULL ORDER BY "events"."id" LIMIT $3`)).
+					WithArgs(id, 1, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "deleted_at"=$1 WHERE (id = $2 AND version = $3) AND "events"."deleted_at" IS NULL`)).
+					WithArgs(testutil.AnyTime{}, id, 1).
+					WillReturnError(errors.New("delete event error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt.setup(mock, tt.id)
+
+			repo := NewEventRepository(gormDB)
+
+			err = repo.Delete(tt.id, 1)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestTrashCalendarEvents(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		success    bool
+		calendarID calendar.CalendarID
+		setup      func(mock sqlmock.Sqlmock, calendarID, defaultCalendarID calendar.CalendarID)
+	}{
+		{
+			name:       "success trash calendar events",
+			success:    true,
+			calendarID: calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, calendarID, defaultCalendarID calendar.CalendarID) {
+				id := uuid.New()
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), calendarID, "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE calendar_id = $1 AND "events"."deleted_at" IS NULL`)).
+					WithArgs(calendarID).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "deleted_at"=$1 WHERE calendar_id = $2 AND "events"."deleted_at" IS NULL`)).
+					WithArgs(testutil.AnyTime{}, calendarID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox" ("type","event_id","before","after","occurred_at","published_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+					WithArgs("EventDeleted", id, sqlmock.AnyArg(), []byte(nil), testutil.AnyTime{}, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "calendar_id"=$1 WHERE calendar_id = $2`)).
+					WithArgs(defaultCalendarID, calendarID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		{
+			name:       "success no events",
+			success:    true,
+			calendarID: calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, calendarID, defaultCalendarID calendar.CalendarID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE calendar_id = $1 AND "events"."deleted_at" IS NULL`)).
+					WithArgs(calendarID).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "calendar_id"=$1 WHERE calendar_id = $2`)).
+					WithArgs(defaultCalendarID, calendarID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name:       "failure delete events error",
+			success:    false,
+			calendarID: calendar.NewCalendarID(),
+			setup: func(mock sqlmock.Sqlmock, calendarID, defaultCalendarID calendar.CalendarID) {
+				id := uuid.New()
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), calendarID, "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE calendar_id = $1 AND "events"."deleted_at" IS NULL`)).
+					WithArgs(calendarID).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "deleted_at"=$1 WHERE calendar_id = $2 AND "events"."deleted_at" IS NULL`)).
+					WithArgs(testutil.AnyTime{}, calendarID).
+					WillReturnError(errors.New("delete events error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{SkipDefaultTransaction: true})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			defaultCalendarID := calendar.NewCalendarID()
+			tt.setup(mock, tt.calendarID, defaultCalendarID)
+
+			err = TrashCalendarEvents(gormDB, tt.calendarID, defaultCalendarID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindDeletedByID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		id      event.EventID
+		setup   func(mock sqlmock.Sqlmock, id event.EventID)
+	}{
+		{
+			name:    "success find deleted by id",
+			success: true,
+			id:      event.EventID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at", "deleted_at"}).
+					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "", 1, time.Now(), time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE deleted_at IS NOT NULL AND id = $1 ORDER BY "events"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+			},
+		},
+		{
+			name:    "failure event not found",
+			success: false,
+			id:      event.EventID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE deleted_at IS NOT NULL AND id = $1 ORDER BY "events"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.id)
+
+			repo := NewEventRepository(gormDB)
+
+			foundEvent, err := repo.FindDeletedByID(tt.id)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && !foundEvent.IsDeleted() {
+				t.Errorf("IsDeleted() = %v, want %v", foundEvent.IsDeleted(), true)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindDeletedByCalendarIDs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		success     bool
+		calendarIDs []calendar.CalendarID
+		setup       func(mock sqlmock.Sqlmock, calendarIDs []calendar.CalendarID)
+	}{
+		{
+			name:        "success find deleted by calendar ids",
+			success:     true,
+			calendarIDs: []calendar.CalendarID{calendar.NewCalendarID(), calendar.NewCalendarID()},
+			setup: func(mock sqlmock.Sqlmock, calendarIDs []calendar.CalendarID) {
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at", "deleted_at"}).
+					AddRow(uuid.New(), uuid.New(), "title", "description", time.Now(), time.Now(), false, "Asia/Tokyo", "#FFFFFF", "", 1, time.Now(), time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE calendar_id IN ($1,$2) AND deleted_at IS NOT NULL ORDER BY deleted_at desc, id asc`)).
+					WithArgs(calendarIDs[0], calendarIDs[1]).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+			},
+		},
+		{
+			name:        "failure find deleted by calendar ids error",
+			success:     false,
+			calendarIDs: []calendar.CalendarID{calendar.NewCalendarID()},
+			setup: func(mock sqlmock.Sqlmock, calendarIDs []calendar.CalendarID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE calendar_id IN ($1) AND deleted_at IS NOT NULL ORDER BY deleted_at desc, id asc`)).
+					WithArgs(calendarIDs[0]).
+					WillReturnError(errors.New("find deleted by calendar ids error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.calendarIDs)
+
+			repo := NewEventRepository(gormDB)
+
+			_, err = repo.FindDeletedByCalendarIDs(tt.calendarIDs)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestLatestDeletionByUserID(t *testing.T) {
+	t.Parallel()
+	query := `SELECT "deleted_at" FROM "events" WHERE user_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at desc LIMIT $2`
+	deletedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name              string
+		success           bool
+		userID            user.UserID
+		expectedDeletedAt time.Time
+		setup             func(mock sqlmock.Sqlmock, userID user.UserID)
+	}{
+		{
+			name:              "success latest deletion by user id",
+			success:           true,
+			userID:            user.UserID{UUID: uuid.New()},
+			expectedDeletedAt: deletedAt,
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(userID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}).AddRow(deletedAt))
+			},
+		},
+		{
+			name:              "success nothing in trash",
+			success:           true,
+			userID:            user.UserID{UUID: uuid.New()},
+			expectedDeletedAt: time.Time{},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(userID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"deleted_at"}))
+			},
+		},
+		{
+			name:              "failure latest deletion by user id error",
+			success:           false,
+			userID:            user.UserID{UUID: uuid.New()},
+			expectedDeletedAt: time.Time{},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(userID, 1).
+					WillReturnError(errors.New("latest deletion by user id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.userID)
+
+			repo := NewEventRepository(gormDB)
+
+			deletedAt, err := repo.LatestDeletionByUserID(tt.userID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if !deletedAt.Equal(tt.expectedDeletedAt) {
+				t.Errorf("LatestDeletionByUserID() = %v, want %v", deletedAt, tt.expectedDeletedAt)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		success     bool
+		id          event.EventID
+		setup       func(mock sqlmock.Sqlmock, id event.EventID)
+		expectedErr error
+	}{
+		{
+			name:    "success restore event",
+			success: true,
+			id:      event.EventID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
+				mock.ExpectBegin()
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE deleted_at IS NOT NULL AND id = $1 ORDER BY "events"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "deleted_at"=$1,"updated_at"=$2 WHERE id = $3 AND deleted_at IS NOT NULL`)).
+					WithArgs(nil, sqlmock.AnyArg(), id).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox" ("type","event_id","before","after","occurred_at","published_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+					WithArgs("EventUpdated", id, sqlmock.AnyArg(), sqlmock.AnyArg(), testutil.AnyTime{}, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "failure event not in trash",
+			success: false,
+			id:      event.EventID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
+				mock.ExpectBegin()
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE deleted_at IS NOT NULL AND id = $1 ORDER BY "events"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "deleted_at"=$1,"updated_at"=$2 WHERE id = $3 AND deleted_at IS NOT NULL`)).
+					WithArgs(nil, sqlmock.AnyArg(), id).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "failure restore event error",
+			success: false,
+			id:      event.EventID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
+				mock.ExpectBegin()
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE deleted_at IS NOT NULL AND id = $1 ORDER BY "events"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "deleted_at"=$1,"updated_at"=$2 WHERE id = $3 AND deleted_at IS NOT NULL`)).
+					WithArgs(nil, sqlmock.AnyArg(), id).
+					WillReturnError(errors.New("restore event error"))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "failure ical uid taken by a live event",
+			success: false,
+			id:      event.EventID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, id event.EventID) {
+				mock.ExpectBegin()
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "title", "description", time.Now(), time.Now(), false, "", "#FFFFFF", "", 1, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE deleted_at IS NOT NULL AND id = $1 ORDER BY "events"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "events" SET "deleted_at"=$1,"updated_at"=$2 WHERE id = $3 AND deleted_at IS NOT NULL`)).
+					WithArgs(nil, sqlmock.AnyArg(), id).
+					WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_events_user_id_ical_uid"})
+
+				mock.ExpectRollback()
+			},
+			expectedErr: event.ErrICalUIDConflict,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.id)
+
+			repo := NewEventRepository(gormDB)
+
+			err = repo.Restore(tt.id)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, but got %v", tt.expectedErr, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPurgeDeletedBefore(t *testing.T) {
+	t.Parallel()
+	before := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		success       bool
+		expectedCount int64
+		setup         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:          "success purge deleted before",
+			success:       true,
+			expectedCount: 3,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "events" WHERE deleted_at < $1`)).
+					WithArgs(before).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "failure purge deleted before error",
+			success:       false,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "events" WHERE deleted_at < $1`)).
+					WithArgs(before).
+					WillReturnError(errors.New("purge error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			count, err := repo.PurgeDeletedBefore(before)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if count != tt.expectedCount {
+				t.Errorf("PurgeDeletedBefore() = %v, want %v", count, tt.expectedCount)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestClaimDueReminders(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 1, 6, 9, 50, 0, 0, time.UTC)
+	startTime := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		success       bool
+		recurrence    string
+		expectedCount int
+		setup         func(mock sqlmock.Sqlmock, eventID event.EventID, recurrence string)
+	}{
+		{
+			name:          "success claim reminder of recurring event",
+			success:       true,
+			recurrence:    "RRULE:FREQ=DAILY;COUNT=2",
+			expectedCount: 1,
+			setup: func(mock sqlmock.Sqlmock, eventID event.EventID, recurrence string) {
+				mock.ExpectBegin()
+
+				reminderRows := sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}).
+					AddRow(eventID, 0, 10, "popup", now)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE trigger_time <= $1 AND event_id IN (SELECT id FROM events WHERE deleted_at IS NULL) ORDER BY trigger_time asc LIMIT $2 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(now, 100).
+					WillReturnRows(reminderRows)
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(eventID, uuid.New(), "title", "description", startTime, startTime.Add(time.Hour), false, "", "#FFFFFF", recurrence, 1, now, now)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id IN ($1) AND "events"."deleted_at" IS NULL`)).
+					WithArgs(eventID).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(eventID).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(eventID).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}).AddRow(eventID, 0, 10, "popup", now))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "event_reminders" SET "trigger_time"=$1 WHERE event_id = $2 AND position = $3`)).
+					WithArgs(now.Add(24*time.Hour), eventID, 0).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "success claim last reminder of event",
+			success:       true,
+			recurrence:    "",
+			expectedCount: 1,
+			setup: func(mock sqlmock.Sqlmock, eventID event.EventID, recurrence string) {
+				mock.ExpectBegin()
+
+				reminderRows := sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}).
+					AddRow(eventID, 0, 10, "popup", now)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE trigger_time <= $1 AND event_id IN (SELECT id FROM events WHERE deleted_at IS NULL) ORDER BY trigger_time asc LIMIT $2 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(now, 100).
+					WillReturnRows(reminderRows)
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(eventID, uuid.New(), "title", "description", startTime, startTime.Add(time.Hour), false, "", "#FFFFFF", recurrence, 1, now, now)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id IN ($1) AND "events"."deleted_at" IS NULL`)).
+					WithArgs(eventID).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(eventID).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(eventID).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}).AddRow(eventID, 0, 10, "popup", now))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "event_reminders" SET "trigger_time"=$1 WHERE event_id = $2 AND position = $3`)).
+					WithArgs(nil, eventID, 0).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "success no due reminders",
+			success:       true,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock, eventID event.EventID, recurrence string) {
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE trigger_time <= $1 AND event_id IN (SELECT id FROM events WHERE deleted_at IS NULL) ORDER BY trigger_time asc LIMIT $2 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(now, 100).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "failure claim due reminders error",
+			success:       false,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock, eventID event.EventID, recurrence string) {
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE trigger_time <= $1 AND event_id IN (SELECT id FROM events WHERE deleted_at IS NULL) ORDER BY trigger_time asc LIMIT $2 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(now, 100).
+					WillReturnError(errors.New("claim due reminders error"))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "failure reschedule reminder error",
+			success:       false,
+			recurrence:    "",
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock, eventID event.EventID, recurrence string) {
+				mock.ExpectBegin()
+
+				reminderRows := sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}).
+					AddRow(eventID, 0, 10, "popup", now)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE trigger_time <= $1 AND event_id IN (SELECT id FROM events WHERE deleted_at IS NULL) ORDER BY trigger_time asc LIMIT $2 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(now, 100).
+					WillReturnRows(reminderRows)
+
+				eventRows := sqlmock.NewRows([]string{"id", "user_id", "title", "description", "start_time", "end_time", "all_day", "time_zone", "color", "recurrence", "version", "created_at", "updated_at"}).
+					AddRow(eventID, uuid.New(), "title", "description", startTime, startTime.Add(time.Hour), false, "", "#FFFFFF", recurrence, 1, now, now)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "events" WHERE id IN ($1) AND "events"."deleted_at" IS NULL`)).
+					WithArgs(eventID).
+					WillReturnRows(eventRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_attendees" WHERE "event_attendees"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(eventID).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "user_id", "email", "role", "response_status"}))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "event_reminders" WHERE "event_reminders"."event_id" = $1 ORDER BY position asc`)).
+					WithArgs(eventID).
+					WillReturnRows(sqlmock.NewRows([]string{"event_id", "position", "offset_minutes", "channel", "trigger_time"}).AddRow(eventID, 0, 10, "popup", now))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "event_reminders" SET "trigger_time"=$1 WHERE event_id = $2 AND position = $3`)).
+					WithArgs(nil, eventID, 0).
+					WillReturnError(errors.New("reschedule reminder error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			eventID := event.EventID{UUID: uuid.New()}
+			tt.setup(mock, eventID, tt.recurrence)
+
+			repo := NewEventRepository(gormDB)
+
+			dueReminders, err := repo.ClaimDueReminders(now, 100)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(dueReminders) != tt.expectedCount {
+				t.Errorf("len(dueReminders) = %v, want %v", len(dueReminders), tt.expectedCount)
+			}
+			if tt.success && tt.expectedCount > 0 && !dueReminders[0].StartTime.Equal(startTime) {
+				t.Errorf("StartTime = %v, want %v", dueReminders[0].StartTime, startTime)
+			}
+			if tt.success && tt.expectedCount > 0 && dueReminders[0].Version != 1 {
+				t.Errorf("Version = %v, want %v", dueReminders[0].Version, 1)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestReleaseReminder(t *testing.T) {
+	t.Parallel()
+	triggerTime := time.Date(2025, 1, 6, 9, 50, 0, 0, time.UTC)
+	reminder, err := event.NewReminder(10*time.Minute, event.ReminderChannelPopup)
+	if err != nil {
+		t.Fatalf("failed to new reminder: %v", err)
+	}
+	tests := []struct {
+		name    string
+		success bool
+		setup   func(mock sqlmock.Sqlmock, eventID event.EventID)
+	}{
+		{
+			name:    "success release reminder",
+			success: true,
+			setup: func(mock sqlmock.Sqlmock, eventID event.EventID) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "event_reminders" SET "trigger_time"=$1 WHERE event_id = $2 AND offset_minutes = $3 AND channel = $4 AND event_id IN (SELECT id FROM events WHERE version = $5 AND deleted_at IS NULL)`)).
+					WithArgs(triggerTime, eventID, 10, "popup", 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "success event changed since claimed",
+			success: true,
+			setup: func(mock sqlmock.Sqlmock, eventID event.EventID) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "event_reminders" SET "trigger_time"=$1 WHERE event_id = $2 AND offset_minutes = $3 AND channel = $4 AND event_id IN (SELECT id FROM events WHERE version = $5 AND deleted_at IS NULL)`)).
+					WithArgs(triggerTime, eventID, 10, "popup", 3).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "failure release reminder error",
+			success: false,
+			setup: func(mock sqlmock.Sqlmock, eventID event.EventID) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "event_reminders" SET "trigger_time"=$1 WHERE event_id = $2 AND offset_minutes = $3 AND channel = $4 AND event_id IN (SELECT id FROM events WHERE version = $5 AND deleted_at IS NULL)`)).
+					WithArgs(triggerTime, eventID, 10, "popup", 3).
+					WillReturnError(errors.New("release error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			eventID := event.EventID{UUID: uuid.New()}
+			tt.setup(mock, eventID)
+
+			repo := NewEventRepository(gormDB)
+
+			err = repo.ReleaseReminder(event.DueReminder{EventID: eventID, Reminder: reminder, TriggerTime: triggerTime, Version: 3})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRelayChanges(t *testing.T) {
+	t.Parallel()
+	eventID := event.EventID{UUID: uuid.New()}
+	snapshot := []byte(`{"user_id":"fe8c2263-bbac-4bb9-a41d-b04f5afc4425","calendar_id":"8d3f4c2a-1b5e-4f6a-9c7d-0e1f2a3b4c5d","attendees":[]}`)
+	tests := []struct {
+		name              string
+		success           bool
+		publishErr        error
+		expectedPublished int
+		setup             func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:              "success relay changes",
+			success:           true,
+			expectedPublished: 2,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				outboxRows := sqlmock.NewRows([]string{"id", "type", "event_id", "before", "after", "occurred_at", "published_at"}).
+					AddRow(1, "EventCreated", eventID, nil, snapshot, time.Now(), nil).
+					AddRow(2, "EventDeleted", eventID, snapshot, nil, time.Now(), nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox" WHERE published_at IS NULL ORDER BY id asc LIMIT $1 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(100).
+					WillReturnRows(outboxRows)
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox" SET "published_at"=$1 WHERE id IN ($2,$3)`)).
+					WithArgs(testutil.AnyTime{}, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:              "success no unpublished changes",
+			success:           true,
+			expectedPublished: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox" WHERE published_at IS NULL ORDER BY id asc LIMIT $1 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(100).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "type", "event_id", "before", "after", "occurred_at", "published_at"}))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:              "failure publish error",
+			success:           false,
+			publishErr:        errors.New("publish error"),
+			expectedPublished: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				outboxRows := sqlmock.NewRows([]string{"id", "type", "event_id", "before", "after", "occurred_at", "published_at"}).
+					AddRow(1, "EventCreated", eventID, nil, snapshot, time.Now(), nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox" WHERE published_at IS NULL ORDER BY id asc LIMIT $1 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(100).
+					WillReturnRows(outboxRows)
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:              "failure find unpublished changes error",
+			success:           false,
+			expectedPublished: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox" WHERE published_at IS NULL ORDER BY id asc LIMIT $1 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(100).
+					WillReturnError(errors.New("find unpublished changes error"))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:              "failure mark published error",
+			success:           false,
+			expectedPublished: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				outboxRows := sqlmock.NewRows([]string{"id", "type", "event_id", "before", "after", "occurred_at", "published_at"}).
+					AddRow(1, "EventCreated", eventID, nil, snapshot, time.Now(), nil)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox" WHERE published_at IS NULL ORDER BY id asc LIMIT $1 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(100).
+					WillReturnRows(outboxRows)
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox" SET "published_at"=$1 WHERE id IN ($2)`)).
+					WithArgs(testutil.AnyTime{}, 1).
+					WillReturnError(errors.New("mark published error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			published, err := repo.RelayChanges(100, func(change event.Change) error {
+				if change.EventID != eventID {
+					t.Errorf("EventID = %v, want %v", change.EventID, eventID)
+				}
+				return tt.publishErr
+			})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if published != tt.expectedPublished {
+				t.Errorf("RelayChanges() = %v, want %v", published, tt.expectedPublished)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindChangesAfter(t *testing.T) {
+	t.Parallel()
+	eventID := event.EventID{UUID: uuid.New()}
+	after := event.ChangePosition{TxID: 700, ChangeID: 3}
+	snapshot := []byte(`{"user_id":"fe8c2263-bbac-4bb9-a41d-b04f5afc4425","calendar_id":"8d3f4c2a-1b5e-4f6a-9c7d-0e1f2a3b4c5d","attendees":[{"user_id":"0b1c6f8e-8a0f-4d3e-9b9e-2f1f3c4d5e6f"},{"user_id":""}]}`)
+	trashed := []byte(`{"user_id":"fe8c2263-bbac-4bb9-a41d-b04f5afc4425","calendar_id":"8d3f4c2a-1b5e-4f6a-9c7d-0e1f2a3b4c5d","attendees":[],"deleted_at":"2025-01-01T00:00:00Z"}`)
+	query := `SELECT * FROM "outbox" WHERE (tx_id, id) > ($1, $2) AND tx_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint ORDER BY tx_id asc, id asc LIMIT $3`
+	tests := []struct {
+		name          string
+		success       bool
+		expectedCount int
+		setup         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:          "success find changes after",
+			success:       true,
+			expectedCount: 3,
+			setup: func(mock sqlmock.Sqlmock) {
+				outboxRows := sqlmock.NewRows([]string{"id", "tx_id", "type", "event_id", "before", "after", "occurred_at", "published_at"}).
+					AddRow(4, 700, "EventCreated", eventID, nil, snapshot, time.Now(), nil).
+					AddRow(2, 701, "EventUpdated", eventID, trashed, snapshot, time.Now(), nil).
+					AddRow(5, 701, "EventDeleted", eventID, snapshot, nil, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(after.TxID, after.ChangeID, 100).
+					WillReturnRows(outboxRows)
+			},
+		},
+		{
+			name:          "failure invalid snapshot",
+			success:       false,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				outboxRows := sqlmock.NewRows([]string{"id", "tx_id", "type", "event_id", "before", "after", "occurred_at", "published_at"}).
+					AddRow(4, 700, "EventCreated", eventID, nil, []byte(`{"user_id":"abc"}`), time.Now(), nil)
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(after.TxID, after.ChangeID, 100).
+					WillReturnRows(outboxRows)
+			},
+		},
+		{
+			name:          "failure find changes error",
+			success:       false,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(after.TxID, after.ChangeID, 100).
+					WillReturnError(errors.New("find changes error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			changes, err := repo.FindChangesAfter(after, 100)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(changes) != tt.expectedCount {
+				t.Errorf("len(changes) = %v, want %v", len(changes), tt.expectedCount)
+			}
+			if tt.success {
+				if changes[0].BeforeAudience != nil || changes[0].AfterAudience == nil || len(changes[0].AfterAudience.AttendeeIDs) != 1 {
+					t.Errorf("changes[0] audiences = %v, %v", changes[0].BeforeAudience, changes[0].AfterAudience)
+				}
+				if changes[1].BeforeAudience != nil {
+					t.Errorf("changes[1].BeforeAudience = %v, want nil", changes[1].BeforeAudience)
+				}
+				if changes[2].Position() != (event.ChangePosition{TxID: 701, ChangeID: 5}) {
+					t.Errorf("changes[2].Position() = %v, want {701 5}", changes[2].Position())
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestLatestChangePosition(t *testing.T) {
+	t.Parallel()
+	query := `SELECT "tx_id","id" FROM "outbox" WHERE tx_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint ORDER BY tx_id desc, id desc LIMIT $1`
+	tests := []struct {
+		name             string
+		success          bool
+		expectedPosition event.ChangePosition
+		setup            func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:             "success latest change position",
+			success:          true,
+			expectedPosition: event.ChangePosition{TxID: 701, ChangeID: 7},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"tx_id", "id"}).AddRow(701, 7))
+			},
+		},
+		{
+			name:             "success no changes",
+			success:          true,
+			expectedPosition: event.ChangePosition{},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"tx_id", "id"}))
+			},
+		},
+		{
+			name:             "failure latest change position error",
+			success:          false,
+			expectedPosition: event.ChangePosition{},
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(1).
+					WillReturnError(errors.New("latest change position error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			position, err := repo.LatestChangePosition()
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if position != tt.expectedPosition {
+				t.Errorf("LatestChangePosition() = %v, want %v", position, tt.expectedPosition)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPurgePublishedChangesBefore(t *testing.T) {
+	t.Parallel()
+	before := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		success       bool
+		expectedCount int64
+		setup         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:          "success purge published changes before",
+			success:       true,
+			expectedCount: 5,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "outbox" WHERE published_at IS NOT NULL AND occurred_at < $1`)).
+					WithArgs(before).
+					WillReturnResult(sqlmock.NewResult(0, 5))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "failure purge published changes before error",
+			success:       false,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "outbox" WHERE published_at IS NOT NULL AND occurred_at < $1`)).
+					WithArgs(before).
+					WillReturnError(errors.New("purge error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock)
+
+			repo := NewEventRepository(gormDB)
+
+			count, err := repo.PurgePublishedChangesBefore(before)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if count != tt.expectedCount {
+				t.Errorf("PurgePublishedChangesBefore() = %v, want %v", count, tt.expectedCount)
+			}
 
 			if err := mock.ExpectationsWereMet(); err != nil {
 				t.Errorf("there were unfulfilled expectations: %s", err)
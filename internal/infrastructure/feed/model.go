@@ -0,0 +1,34 @@
+package feed
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/feed"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+type FeedModel struct {
+	UserID    user.UserID
+	TokenHash string
+	CreatedAt time.Time
+}
+
+func (FeedModel) TableName() string {
+	return "feeds"
+}
+
+func newFeedModel(f feed.Feed) FeedModel {
+	return FeedModel{
+		UserID:    f.UserID(),
+		TokenHash: f.TokenHash(),
+		CreatedAt: f.CreatedAt(),
+	}
+}
+
+func (m FeedModel) toFeed() feed.Feed {
+	return feed.NewFeed(
+		m.UserID,
+		m.TokenHash,
+		m.CreatedAt,
+	)
+}
@@ -0,0 +1,48 @@
+package feed
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/qkitzero/event-service/internal/domain/feed"
+)
+
+type feedRepository struct {
+	db *gorm.DB
+}
+
+func NewFeedRepository(db *gorm.DB) feed.FeedRepository {
+	return &feedRepository{db: db}
+}
+
+// Save creates the user's feed or replaces its token, which revokes the
+// previous one.
+func (r *feedRepository) Save(f feed.Feed) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		feedModel := newFeedModel(f)
+
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"token_hash", "created_at"}),
+		}).Create(&feedModel).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *feedRepository) FindByTokenHash(tokenHash string) (feed.Feed, error) {
+	var feedModel FeedModel
+	err := r.db.First(&feedModel, "token_hash = ?", tokenHash).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, feed.ErrFeedNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return feedModel.toFeed(), nil
+}
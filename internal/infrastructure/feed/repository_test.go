@@ -0,0 +1,163 @@
+package feed
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/qkitzero/event-service/internal/domain/feed"
+	"github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/testutil"
+)
+
+func TestSave(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		feed    feed.Feed
+		setup   func(mock sqlmock.Sqlmock, f feed.Feed)
+	}{
+		{
+			name:    "success save feed",
+			success: true,
+			feed:    feed.NewFeed(user.UserID{UUID: uuid.New()}, feed.Token("token").Hash(), time.Now()),
+			setup: func(mock sqlmock.Sqlmock, f feed.Feed) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "feeds" ("user_id","token_hash","created_at") VALUES ($1,$2,$3) ON CONFLICT ("user_id") DO UPDATE SET "token_hash"="excluded"."token_hash","created_at"="excluded"."created_at"`)).
+					WithArgs(f.UserID(), f.TokenHash(), testutil.AnyTime{}).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "failure save feed error",
+			success: false,
+			feed:    feed.NewFeed(user.UserID{UUID: uuid.New()}, feed.Token("token").Hash(), time.Now()),
+			setup: func(mock sqlmock.Sqlmock, f feed.Feed) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "feeds" ("user_id","token_hash","created_at") VALUES ($1,$2,$3) ON CONFLICT ("user_id") DO UPDATE SET "token_hash"="excluded"."token_hash","created_at"="excluded"."created_at"`)).
+					WithArgs(f.UserID(), f.TokenHash(), testutil.AnyTime{}).
+					WillReturnError(errors.New("save feed error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.feed)
+
+			repo := NewFeedRepository(gormDB)
+
+			err = repo.Save(tt.feed)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindByTokenHash(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		success   bool
+		tokenHash string
+		setup     func(mock sqlmock.Sqlmock, tokenHash string)
+	}{
+		{
+			name:      "success find by token hash",
+			success:   true,
+			tokenHash: feed.Token("token").Hash(),
+			setup: func(mock sqlmock.Sqlmock, tokenHash string) {
+				feedRows := sqlmock.NewRows([]string{"user_id", "token_hash", "created_at"}).
+					AddRow(uuid.New(), tokenHash, time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "feeds" WHERE token_hash = $1 ORDER BY "feeds"."user_id" LIMIT $2`)).
+					WithArgs(tokenHash, 1).
+					WillReturnRows(feedRows)
+			},
+		},
+		{
+			name:      "failure feed not found",
+			success:   false,
+			tokenHash: feed.Token("token").Hash(),
+			setup: func(mock sqlmock.Sqlmock, tokenHash string) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "feeds" WHERE token_hash = $1 ORDER BY "feeds"."user_id" LIMIT $2`)).
+					WithArgs(tokenHash, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name:      "failure find by token hash error",
+			success:   false,
+			tokenHash: feed.Token("token").Hash(),
+			setup: func(mock sqlmock.Sqlmock, tokenHash string) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "feeds" WHERE token_hash = $1 ORDER BY "feeds"."user_id" LIMIT $2`)).
+					WithArgs(tokenHash, 1).
+					WillReturnError(errors.New("find by token hash error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.tokenHash)
+
+			repo := NewFeedRepository(gormDB)
+
+			_, err = repo.FindByTokenHash(tt.tokenHash)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package notifier
+
+import (
+	"context"
+	"log"
+
+	appevent "github.com/qkitzero/event-service/internal/application/event"
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+type logNotifier struct{}
+
+func NewLogNotifier() appevent.Notifier {
+	return &logNotifier{}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, reminder event.DueReminder) error {
+	log.Printf("reminder: event %s %q for user %s starts at %s (%s)", reminder.EventID, reminder.Title, reminder.UserID, reminder.StartTime, reminder.Reminder.Channel())
+	return nil
+}
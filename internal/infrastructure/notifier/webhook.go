@@ -0,0 +1,65 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	appevent "github.com/qkitzero/event-service/internal/application/event"
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string, client *http.Client) appevent.Notifier {
+	return &webhookNotifier{url: url, client: client}
+}
+
+type reminderPayload struct {
+	EventID       string    `json:"event_id"`
+	UserID        string    `json:"user_id"`
+	Title         string    `json:"title"`
+	StartTime     time.Time `json:"start_time"`
+	OffsetMinutes int       `json:"offset_minutes"`
+	Channel       string    `json:"channel"`
+}
+
+// Notify posts the reminder as JSON and fails unless the endpoint answers
+// with a 2xx status.
+func (n *webhookNotifier) Notify(ctx context.Context, reminder event.DueReminder) error {
+	body, err := json.Marshal(reminderPayload{
+		EventID:       reminder.EventID.String(),
+		UserID:        reminder.UserID.String(),
+		Title:         reminder.Title.String(),
+		StartTime:     reminder.StartTime,
+		OffsetMinutes: int(reminder.Reminder.Offset() / time.Minute),
+		Channel:       reminder.Reminder.Channel().String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/user"
+)
+
+func TestWebhookNotify(t *testing.T) {
+	t.Parallel()
+	reminder, err := event.NewReminder(10*time.Minute, event.ReminderChannelEmail)
+	if err != nil {
+		t.Fatalf("failed to new reminder: %v", err)
+	}
+	dueReminder := event.DueReminder{
+		EventID:     event.NewEventID(),
+		UserID:      user.UserID{UUID: uuid.New()},
+		Title:       event.Title("title"),
+		StartTime:   time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+		Reminder:    reminder,
+		TriggerTime: time.Date(2025, 1, 6, 9, 50, 0, 0, time.UTC),
+	}
+	tests := []struct {
+		name       string
+		success    bool
+		statusCode int
+	}{
+		{"success notify", true, http.StatusOK},
+		{"success notify no content", true, http.StatusNoContent},
+		{"failure server error", false, http.StatusInternalServerError},
+		{"failure redirect", false, http.StatusNotModified},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var payload reminderPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Errorf("failed to decode payload: %v", err)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			notifier := NewWebhookNotifier(server.URL, server.Client())
+
+			err := notifier.Notify(context.Background(), dueReminder)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if payload.EventID != dueReminder.EventID.String() || payload.OffsetMinutes != 10 || payload.Channel != "email" {
+				t.Errorf("payload = %+v, want reminder of event %s", payload, dueReminder.EventID)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+// FilePublisher appends each change to a file as a line of JSON. A change
+// that is published again is appended again, so readers skip lines whose
+// id they have already seen.
+type FilePublisher struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFilePublisher(path string) *FilePublisher {
+	return &FilePublisher{path: path}
+}
+
+type changeRecord struct {
+	ID         int64           `json:"id"`
+	Type       string          `json:"type"`
+	EventID    string          `json:"event_id"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+func (p *FilePublisher) Publish(ctx context.Context, change event.Change) error {
+	line, err := json.Marshal(changeRecord{
+		ID:         change.ID,
+		Type:       change.Type.String(),
+		EventID:    change.EventID.String(),
+		Before:     change.Before,
+		After:      change.After,
+		OccurredAt: change.OccurredAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	f, err := os.OpenFile(p.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
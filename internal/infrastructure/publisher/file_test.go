@@ -0,0 +1,85 @@
+package publisher
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+func TestFilePublish(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		path    func(dir string) string
+		changes []event.Change
+	}{
+		{
+			name:    "success publish changes",
+			success: true,
+			path:    func(dir string) string { return filepath.Join(dir, "outbox.jsonl") },
+			changes: []event.Change{
+				{ID: 1, Type: event.ChangeTypeCreated, EventID: event.NewEventID(), After: []byte(`{"title":"title"}`), OccurredAt: time.Now()},
+				{ID: 2, Type: event.ChangeTypeDeleted, EventID: event.NewEventID(), Before: []byte(`{"title":"title"}`), OccurredAt: time.Now()},
+			},
+		},
+		{
+			name:    "failure missing directory",
+			success: false,
+			path:    func(dir string) string { return filepath.Join(dir, "missing", "outbox.jsonl") },
+			changes: []event.Change{{ID: 1, Type: event.ChangeTypeCreated, EventID: event.NewEventID()}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := tt.path(t.TempDir())
+			filePublisher := NewFilePublisher(path)
+
+			for _, change := range tt.changes {
+				err := filePublisher.Publish(context.Background(), change)
+				if tt.success && err != nil {
+					t.Errorf("expected no error, but got %v", err)
+				}
+				if !tt.success && err == nil {
+					t.Errorf("expected error, but got nil")
+				}
+			}
+			if !tt.success {
+				return
+			}
+
+			f, err := os.Open(path)
+			if err != nil {
+				t.Fatalf("failed to open file: %v", err)
+			}
+			defer f.Close()
+
+			var records []changeRecord
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				var record changeRecord
+				if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+					t.Fatalf("failed to unmarshal record: %v", err)
+				}
+				records = append(records, record)
+			}
+			if len(records) != len(tt.changes) {
+				t.Fatalf("len(records) = %v, want %v", len(records), len(tt.changes))
+			}
+			for i, record := range records {
+				if record.ID != tt.changes[i].ID || record.Type != tt.changes[i].Type.String() || record.EventID != tt.changes[i].EventID.String() {
+					t.Errorf("records[%d] = %+v, want change %+v", i, record, tt.changes[i])
+				}
+			}
+		})
+	}
+}
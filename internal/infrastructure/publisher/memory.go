@@ -0,0 +1,34 @@
+package publisher
+
+import (
+	"context"
+	"sync"
+
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+// MemoryPublisher keeps the changes published to it, for use in tests.
+type MemoryPublisher struct {
+	mu      sync.Mutex
+	changes []event.Change
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, change event.Change) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.changes = append(p.changes, change)
+	return nil
+}
+
+// Changes returns the changes published so far in the order they arrived.
+func (p *MemoryPublisher) Changes() []event.Change {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]event.Change(nil), p.changes...)
+}
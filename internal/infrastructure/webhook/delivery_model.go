@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+type DeliveryModel struct {
+	ID            webhook.DeliveryID
+	WebhookID     webhook.WebhookID
+	ChangeID      int64
+	EventType     webhook.EventType
+	Payload       []byte
+	Status        webhook.DeliveryStatus
+	NextAttemptAt *time.Time
+	CreatedAt     time.Time
+	Attempts      []AttemptModel `gorm:"foreignKey:DeliveryID"`
+}
+
+func (DeliveryModel) TableName() string {
+	return "webhook_deliveries"
+}
+
+type AttemptModel struct {
+	DeliveryID  webhook.DeliveryID
+	Position    int
+	StatusCode  int
+	Error       string
+	AttemptedAt time.Time
+}
+
+func (AttemptModel) TableName() string {
+	return "webhook_delivery_attempts"
+}
+
+func newDeliveryModel(d webhook.Delivery) DeliveryModel {
+	var nextAttemptAt *time.Time
+	if t := d.NextAttemptAt(); !t.IsZero() {
+		nextAttemptAt = &t
+	}
+
+	attemptModels := make([]AttemptModel, 0, len(d.Attempts()))
+	for i, a := range d.Attempts() {
+		attemptModels = append(attemptModels, AttemptModel{
+			DeliveryID:  d.ID(),
+			Position:    i,
+			StatusCode:  a.StatusCode(),
+			Error:       a.Error(),
+			AttemptedAt: a.AttemptedAt(),
+		})
+	}
+
+	return DeliveryModel{
+		ID:            d.ID(),
+		WebhookID:     d.WebhookID(),
+		ChangeID:      d.ChangeID(),
+		EventType:     d.EventType(),
+		Payload:       d.Payload(),
+		Status:        d.Status(),
+		NextAttemptAt: nextAttemptAt,
+		CreatedAt:     d.CreatedAt(),
+		Attempts:      attemptModels,
+	}
+}
+
+func (m DeliveryModel) toDelivery() webhook.Delivery {
+	var nextAttemptAt time.Time
+	if m.NextAttemptAt != nil {
+		nextAttemptAt = *m.NextAttemptAt
+	}
+
+	var attempts []webhook.Attempt
+	for _, a := range m.Attempts {
+		attempts = append(attempts, webhook.NewAttempt(a.StatusCode, a.Error, a.AttemptedAt))
+	}
+
+	return webhook.NewDelivery(
+		m.ID,
+		m.WebhookID,
+		m.ChangeID,
+		m.EventType,
+		m.Payload,
+		m.Status,
+		attempts,
+		nextAttemptAt,
+		m.CreatedAt,
+	)
+}
+
+func toDeliveries(deliveryModels []DeliveryModel) []webhook.Delivery {
+	var deliveries []webhook.Delivery
+	for _, deliveryModel := range deliveryModels {
+		deliveries = append(deliveries, deliveryModel.toDelivery())
+	}
+
+	return deliveries
+}
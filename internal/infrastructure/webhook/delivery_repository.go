@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+type deliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewDeliveryRepository(db *gorm.DB) webhook.DeliveryRepository {
+	return &deliveryRepository{db: db}
+}
+
+func (r *deliveryRepository) CreateAll(deliveries []webhook.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		deliveryModels := make([]DeliveryModel, 0, len(deliveries))
+		for _, d := range deliveries {
+			deliveryModels = append(deliveryModels, newDeliveryModel(d))
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "webhook_id"}, {Name: "change_id"}},
+			DoNothing: true,
+		}).Omit(clause.Associations).Create(&deliveryModels).Error
+	})
+}
+
+// Update saves the status of a delivery and the attempts made since it was
+// last saved. Attempts are never changed once recorded.
+func (r *deliveryRepository) Update(d webhook.Delivery) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		deliveryModel := newDeliveryModel(d)
+
+		result := tx.Model(&deliveryModel).Select("status", "next_attempt_at").Updates(&deliveryModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return webhook.ErrDeliveryNotFound
+		}
+
+		if len(deliveryModel.Attempts) == 0 {
+			return nil
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "delivery_id"}, {Name: "position"}},
+			DoNothing: true,
+		}).Create(&deliveryModel.Attempts).Error
+	})
+}
+
+func (r *deliveryRepository) FindAllByWebhookID(webhookID webhook.WebhookID, limit int) ([]webhook.Delivery, error) {
+	var deliveryModels []DeliveryModel
+	if err := r.db.Scopes(withAttempts).Where("webhook_id = ?", webhookID).Order("created_at desc").Limit(limit).Find(&deliveryModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toDeliveries(deliveryModels), nil
+}
+
+func (r *deliveryRepository) ClaimDue(now time.Time, lease time.Duration, limit int) ([]webhook.Delivery, error) {
+	var deliveries []webhook.Delivery
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var deliveryModels []DeliveryModel
+		if err := tx.Scopes(withAttempts).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ? AND webhook_id IN (SELECT id FROM webhooks WHERE enabled)", webhook.DeliveryStatusPending, now).
+			Order("next_attempt_at asc").Limit(limit).Find(&deliveryModels).Error; err != nil {
+			return err
+		}
+		if len(deliveryModels) == 0 {
+			return nil
+		}
+
+		ids := make([]webhook.DeliveryID, 0, len(deliveryModels))
+		for _, deliveryModel := range deliveryModels {
+			ids = append(ids, deliveryModel.ID)
+		}
+
+		leasedUntil := now.Add(lease)
+		if err := tx.Model(&DeliveryModel{}).Where("id IN ?", ids).Update("next_attempt_at", leasedUntil).Error; err != nil {
+			return err
+		}
+
+		for _, deliveryModel := range deliveryModels {
+			deliveryModel.NextAttemptAt = &leasedUntil
+			deliveries = append(deliveries, deliveryModel.toDelivery())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// withAttempts loads the attempts of the deliveries found.
+func withAttempts(db *gorm.DB) *gorm.DB {
+	return db.Preload("Attempts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	})
+}
@@ -0,0 +1,381 @@
+package webhook
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+func newTestDelivery(attempts ...webhook.Attempt) webhook.Delivery {
+	return webhook.NewDelivery(webhook.NewDeliveryID(), webhook.NewWebhookID(), 1, webhook.EventTypeCreated, []byte(`{}`), webhook.DeliveryStatusPending, attempts, time.Now(), time.Now())
+}
+
+func TestCreateAllDeliveries(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		success    bool
+		deliveries []webhook.Delivery
+		setup      func(mock sqlmock.Sqlmock, deliveries []webhook.Delivery)
+	}{
+		{
+			name:       "success create all deliveries",
+			success:    true,
+			deliveries: []webhook.Delivery{newTestDelivery(), newTestDelivery()},
+			setup: func(mock sqlmock.Sqlmock, deliveries []webhook.Delivery) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "webhook_deliveries" ("id","webhook_id","change_id","event_type","payload","status","next_attempt_at","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8),($9,$10,$11,$12,$13,$14,$15,$16) ON CONFLICT ("webhook_id","change_id") DO NOTHING`)).
+					WillReturnResult(sqlmock.NewResult(2, 2))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:       "success no deliveries",
+			success:    true,
+			deliveries: nil,
+			setup:      func(mock sqlmock.Sqlmock, deliveries []webhook.Delivery) {},
+		},
+		{
+			name:       "failure create all deliveries error",
+			success:    false,
+			deliveries: []webhook.Delivery{newTestDelivery()},
+			setup: func(mock sqlmock.Sqlmock, deliveries []webhook.Delivery) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "webhook_deliveries" ("id","webhook_id","change_id","event_type","payload","status","next_attempt_at","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) ON CONFLICT ("webhook_id","change_id") DO NOTHING`)).
+					WillReturnError(errors.New("create all deliveries error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.deliveries)
+
+			repo := NewDeliveryRepository(gormDB)
+
+			err = repo.CreateAll(tt.deliveries)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestUpdateDelivery(t *testing.T) {
+	t.Parallel()
+	attemptedAt := time.Now()
+	tests := []struct {
+		name     string
+		success  bool
+		delivery webhook.Delivery
+		setup    func(mock sqlmock.Sqlmock, d webhook.Delivery)
+	}{
+		{
+			name:     "success update delivery",
+			success:  true,
+			delivery: newTestDelivery(webhook.NewAttempt(500, "", attemptedAt), webhook.NewAttempt(200, "", attemptedAt)),
+			setup: func(mock sqlmock.Sqlmock, d webhook.Delivery) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "status"=$1,"next_attempt_at"=$2 WHERE "id" = $3`)).
+					WithArgs(d.Status(), d.NextAttemptAt(), d.ID()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "webhook_delivery_attempts" ("delivery_id","position","status_code","error","attempted_at") VALUES ($1,$2,$3,$4,$5),($6,$7,$8,$9,$10) ON CONFLICT ("delivery_id","position") DO NOTHING`)).
+					WithArgs(d.ID(), 0, 500, "", attemptedAt, d.ID(), 1, 200, "", attemptedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:     "failure delivery not found",
+			success:  false,
+			delivery: newTestDelivery(webhook.NewAttempt(200, "", attemptedAt)),
+			setup: func(mock sqlmock.Sqlmock, d webhook.Delivery) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "status"=$1,"next_attempt_at"=$2 WHERE "id" = $3`)).
+					WithArgs(d.Status(), d.NextAttemptAt(), d.ID()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:     "failure create attempts error",
+			success:  false,
+			delivery: newTestDelivery(webhook.NewAttempt(0, "timeout", attemptedAt)),
+			setup: func(mock sqlmock.Sqlmock, d webhook.Delivery) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "status"=$1,"next_attempt_at"=$2 WHERE "id" = $3`)).
+					WithArgs(d.Status(), d.NextAttemptAt(), d.ID()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "webhook_delivery_attempts" ("delivery_id","position","status_code","error","attempted_at") VALUES ($1,$2,$3,$4,$5) ON CONFLICT ("delivery_id","position") DO NOTHING`)).
+					WithArgs(d.ID(), 0, 0, "timeout", attemptedAt).
+					WillReturnError(errors.New("create attempts error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.delivery)
+
+			repo := NewDeliveryRepository(gormDB)
+
+			err = repo.Update(tt.delivery)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindAllByWebhookID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		success   bool
+		webhookID webhook.WebhookID
+		setup     func(mock sqlmock.Sqlmock, webhookID webhook.WebhookID)
+	}{
+		{
+			name:      "success find all by webhook id",
+			success:   true,
+			webhookID: webhook.NewWebhookID(),
+			setup: func(mock sqlmock.Sqlmock, webhookID webhook.WebhookID) {
+				deliveryID := webhook.NewDeliveryID()
+				deliveryRows := sqlmock.NewRows([]string{"id", "webhook_id", "change_id", "event_type", "payload", "status", "next_attempt_at", "created_at"}).
+					AddRow(deliveryID, webhookID, 1, "event.created", []byte(`{}`), "succeeded", nil, time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE webhook_id = $1 ORDER BY created_at desc LIMIT $2`)).
+					WithArgs(webhookID, 50).
+					WillReturnRows(deliveryRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_delivery_attempts" WHERE "webhook_delivery_attempts"."delivery_id" = $1 ORDER BY position asc`)).
+					WithArgs(deliveryID).
+					WillReturnRows(sqlmock.NewRows([]string{"delivery_id", "position", "status_code", "error", "attempted_at"}).AddRow(deliveryID, 0, 200, "", time.Now()))
+			},
+		},
+		{
+			name:      "failure find all by webhook id error",
+			success:   false,
+			webhookID: webhook.NewWebhookID(),
+			setup: func(mock sqlmock.Sqlmock, webhookID webhook.WebhookID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE webhook_id = $1 ORDER BY created_at desc LIMIT $2`)).
+					WithArgs(webhookID, 50).
+					WillReturnError(errors.New("find all by webhook id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.webhookID)
+
+			repo := NewDeliveryRepository(gormDB)
+
+			_, err = repo.FindAllByWebhookID(tt.webhookID, 50)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestClaimDue(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	lease := 5 * time.Minute
+	tests := []struct {
+		name          string
+		success       bool
+		expectedCount int
+		setup         func(mock sqlmock.Sqlmock, deliveryID webhook.DeliveryID)
+	}{
+		{
+			name:          "success claim due deliveries",
+			success:       true,
+			expectedCount: 1,
+			setup: func(mock sqlmock.Sqlmock, deliveryID webhook.DeliveryID) {
+				mock.ExpectBegin()
+
+				deliveryRows := sqlmock.NewRows([]string{"id", "webhook_id", "change_id", "event_type", "payload", "status", "next_attempt_at", "created_at"}).
+					AddRow(deliveryID, webhook.NewWebhookID(), 1, "event.created", []byte(`{}`), "pending", now, now)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE status = $1 AND next_attempt_at <= $2 AND webhook_id IN (SELECT id FROM webhooks WHERE enabled) ORDER BY next_attempt_at asc LIMIT $3 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(webhook.DeliveryStatusPending, now, 100).
+					WillReturnRows(deliveryRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_delivery_attempts" WHERE "webhook_delivery_attempts"."delivery_id" = $1 ORDER BY position asc`)).
+					WithArgs(deliveryID).
+					WillReturnRows(sqlmock.NewRows([]string{"delivery_id", "position", "status_code", "error", "attempted_at"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "next_attempt_at"=$1 WHERE id IN ($2)`)).
+					WithArgs(now.Add(lease), deliveryID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "success no due deliveries",
+			success:       true,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock, deliveryID webhook.DeliveryID) {
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE status = $1 AND next_attempt_at <= $2 AND webhook_id IN (SELECT id FROM webhooks WHERE enabled) ORDER BY next_attempt_at asc LIMIT $3 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(webhook.DeliveryStatusPending, now, 100).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "webhook_id", "change_id", "event_type", "payload", "status", "next_attempt_at", "created_at"}))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:          "failure claim due error",
+			success:       false,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock, deliveryID webhook.DeliveryID) {
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE status = $1 AND next_attempt_at <= $2 AND webhook_id IN (SELECT id FROM webhooks WHERE enabled) ORDER BY next_attempt_at asc LIMIT $3 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(webhook.DeliveryStatusPending, now, 100).
+					WillReturnError(errors.New("claim due error"))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:          "failure lease error",
+			success:       false,
+			expectedCount: 0,
+			setup: func(mock sqlmock.Sqlmock, deliveryID webhook.DeliveryID) {
+				mock.ExpectBegin()
+
+				deliveryRows := sqlmock.NewRows([]string{"id", "webhook_id", "change_id", "event_type", "payload", "status", "next_attempt_at", "created_at"}).
+					AddRow(deliveryID, webhook.NewWebhookID(), 1, "event.created", []byte(`{}`), "pending", now, now)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE status = $1 AND next_attempt_at <= $2 AND webhook_id IN (SELECT id FROM webhooks WHERE enabled) ORDER BY next_attempt_at asc LIMIT $3 FOR UPDATE SKIP LOCKED`)).
+					WithArgs(webhook.DeliveryStatusPending, now, 100).
+					WillReturnRows(deliveryRows)
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_delivery_attempts" WHERE "webhook_delivery_attempts"."delivery_id" = $1 ORDER BY position asc`)).
+					WithArgs(deliveryID).
+					WillReturnRows(sqlmock.NewRows([]string{"delivery_id", "position", "status_code", "error", "attempted_at"}))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "next_attempt_at"=$1 WHERE id IN ($2)`)).
+					WithArgs(now.Add(lease), deliveryID).
+					WillReturnError(errors.New("lease error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, webhook.NewDeliveryID())
+
+			repo := NewDeliveryRepository(gormDB)
+
+			deliveries, err := repo.ClaimDue(now, lease, 100)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(deliveries) != tt.expectedCount {
+				t.Errorf("len(deliveries) = %v, want %v", len(deliveries), tt.expectedCount)
+			}
+			for _, d := range deliveries {
+				if !d.NextAttemptAt().Equal(now.Add(lease)) {
+					t.Errorf("NextAttemptAt() = %v, want %v", d.NextAttemptAt(), now.Add(lease))
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
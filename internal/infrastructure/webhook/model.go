@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"strings"
+	"time"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+type WebhookModel struct {
+	ID                  webhook.WebhookID
+	UserID              user.UserID
+	URL                 webhook.URL
+	EventTypes          string
+	Secret              webhook.Secret
+	Enabled             bool
+	ConsecutiveFailures int
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (WebhookModel) TableName() string {
+	return "webhooks"
+}
+
+func newWebhookModel(w webhook.Webhook) WebhookModel {
+	eventTypes := make([]string, 0, len(w.EventTypes()))
+	for _, t := range w.EventTypes() {
+		eventTypes = append(eventTypes, t.String())
+	}
+
+	return WebhookModel{
+		ID:                  w.ID(),
+		UserID:              w.UserID(),
+		URL:                 w.URL(),
+		EventTypes:          strings.Join(eventTypes, ","),
+		Secret:              w.Secret(),
+		Enabled:             w.Enabled(),
+		ConsecutiveFailures: w.ConsecutiveFailures(),
+		CreatedAt:           w.CreatedAt(),
+		UpdatedAt:           w.UpdatedAt(),
+	}
+}
+
+func (m WebhookModel) toWebhook() (webhook.Webhook, error) {
+	var eventTypes []string
+	if m.EventTypes != "" {
+		eventTypes = strings.Split(m.EventTypes, ",")
+	}
+
+	newEventTypes, err := webhook.NewEventTypes(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook.NewWebhook(
+		m.ID,
+		m.UserID,
+		m.URL,
+		newEventTypes,
+		m.Secret,
+		m.Enabled,
+		m.ConsecutiveFailures,
+		m.CreatedAt,
+		m.UpdatedAt,
+	), nil
+}
+
+func toWebhooks(webhookModels []WebhookModel) ([]webhook.Webhook, error) {
+	var webhooks []webhook.Webhook
+	for _, webhookModel := range webhookModels {
+		w, err := webhookModel.toWebhook()
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) webhook.WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+func (r *webhookRepository) Create(w webhook.Webhook) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		webhookModel := newWebhookModel(w)
+
+		if err := tx.Create(&webhookModel).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Update saves a webhook and, when it is disabled, cancels its pending
+// deliveries, which would otherwise be sent late if it is enabled again.
+func (r *webhookRepository) Update(w webhook.Webhook) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		webhookModel := newWebhookModel(w)
+
+		result := tx.Model(&webhookModel).Select("url", "event_types", "enabled", "consecutive_failures", "updated_at").Updates(&webhookModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return webhook.ErrWebhookNotFound
+		}
+
+		if w.Enabled() {
+			return nil
+		}
+
+		return tx.Model(&DeliveryModel{}).Where("webhook_id = ? AND status = ?", w.ID(), webhook.DeliveryStatusPending).
+			Updates(map[string]any{"status": webhook.DeliveryStatusCancelled, "next_attempt_at": nil}).Error
+	})
+}
+
+// Delete removes a webhook. Its deliveries are removed by the ON DELETE
+// CASCADE foreign key on webhook_deliveries.webhook_id.
+func (r *webhookRepository) Delete(id webhook.WebhookID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&WebhookModel{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return webhook.ErrWebhookNotFound
+		}
+		return nil
+	})
+}
+
+func (r *webhookRepository) FindByID(id webhook.WebhookID) (webhook.Webhook, error) {
+	var webhookModel WebhookModel
+	err := r.db.First(&webhookModel, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, webhook.ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookModel.toWebhook()
+}
+
+func (r *webhookRepository) FindAllByUserID(userID user.UserID) ([]webhook.Webhook, error) {
+	var webhookModels []WebhookModel
+	if err := r.db.Where("user_id = ?", userID).Order("created_at asc").Find(&webhookModels).Error; err != nil {
+		return nil, err
+	}
+
+	return toWebhooks(webhookModels)
+}
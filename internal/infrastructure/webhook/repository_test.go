@@ -0,0 +1,451 @@
+package webhook
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+	"github.com/qkitzero/event-service/testutil"
+)
+
+func newTestWebhook() webhook.Webhook {
+	return webhook.NewWebhook(webhook.NewWebhookID(), user.UserID{UUID: uuid.New()}, webhook.URL("https://example.com/hooks"), []webhook.EventType{webhook.EventTypeCreated, webhook.EventTypeDeleted}, webhook.Secret("secret"), true, 0, time.Now(), time.Now())
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		webhook webhook.Webhook
+		setup   func(mock sqlmock.Sqlmock, w webhook.Webhook)
+	}{
+		{
+			name:    "success create webhook",
+			success: true,
+			webhook: newTestWebhook(),
+			setup: func(mock sqlmock.Sqlmock, w webhook.Webhook) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "webhooks" ("id","user_id","url","event_types","secret","enabled","consecutive_failures","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`)).
+					WithArgs(w.ID(), w.UserID(), w.URL(), "event.created,event.deleted", w.Secret(), true, 0, testutil.AnyTime{}, testutil.AnyTime{}).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "failure create webhook error",
+			success: false,
+			webhook: newTestWebhook(),
+			setup: func(mock sqlmock.Sqlmock, w webhook.Webhook) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "webhooks" ("id","user_id","url","event_types","secret","enabled","consecutive_failures","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`)).
+					WithArgs(w.ID(), w.UserID(), w.URL(), "event.created,event.deleted", w.Secret(), true, 0, testutil.AnyTime{}, testutil.AnyTime{}).
+					WillReturnError(errors.New("create webhook error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.webhook)
+
+			repo := NewWebhookRepository(gormDB)
+
+			err = repo.Create(tt.webhook)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		webhook webhook.Webhook
+		setup   func(mock sqlmock.Sqlmock, w webhook.Webhook)
+	}{
+		{
+			name:    "success update webhook",
+			success: true,
+			webhook: newTestWebhook(),
+			setup: func(mock sqlmock.Sqlmock, w webhook.Webhook) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhooks" SET "url"=$1,"event_types"=$2,"enabled"=$3,"consecutive_failures"=$4,"updated_at"=$5 WHERE "id" = $6`)).
+					WithArgs(w.URL(), "event.created,event.deleted", true, 0, testutil.AnyTime{}, w.ID()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "success disable webhook",
+			success: true,
+			webhook: webhook.NewWebhook(webhook.NewWebhookID(), user.UserID{UUID: uuid.New()}, webhook.URL("https://example.com/hooks"), []webhook.EventType{webhook.EventTypeCreated, webhook.EventTypeDeleted}, webhook.Secret("secret"), false, webhook.MaxConsecutiveFailures, time.Now(), time.Now()),
+			setup: func(mock sqlmock.Sqlmock, w webhook.Webhook) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhooks" SET "url"=$1,"event_types"=$2,"enabled"=$3,"consecutive_failures"=$4,"updated_at"=$5 WHERE "id" = $6`)).
+					WithArgs(w.URL(), "event.created,event.deleted", false, webhook.MaxConsecutiveFailures, testutil.AnyTime{}, w.ID()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "next_attempt_at"=$1,"status"=$2 WHERE webhook_id = $3 AND status = $4`)).
+					WithArgs(nil, webhook.DeliveryStatusCancelled, w.ID(), webhook.DeliveryStatusPending).
+					WillReturnResult(sqlmock.NewResult(2, 2))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "failure cancel deliveries error",
+			success: false,
+			webhook: webhook.NewWebhook(webhook.NewWebhookID(), user.UserID{UUID: uuid.New()}, webhook.URL("https://example.com/hooks"), []webhook.EventType{webhook.EventTypeCreated, webhook.EventTypeDeleted}, webhook.Secret("secret"), false, 0, time.Now(), time.Now()),
+			setup: func(mock sqlmock.Sqlmock, w webhook.Webhook) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhooks" SET "url"=$1,"event_types"=$2,"enabled"=$3,"consecutive_failures"=$4,"updated_at"=$5 WHERE "id" = $6`)).
+					WithArgs(w.URL(), "event.created,event.deleted", false, 0, testutil.AnyTime{}, w.ID()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "next_attempt_at"=$1,"status"=$2 WHERE webhook_id = $3 AND status = $4`)).
+					WithArgs(nil, webhook.DeliveryStatusCancelled, w.ID(), webhook.DeliveryStatusPending).
+					WillReturnError(errors.New("cancel deliveries error"))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "failure webhook not found",
+			success: false,
+			webhook: newTestWebhook(),
+			setup: func(mock sqlmock.Sqlmock, w webhook.Webhook) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhooks" SET "url"=$1,"event_types"=$2,"enabled"=$3,"consecutive_failures"=$4,"updated_at"=$5 WHERE "id" = $6`)).
+					WithArgs(w.URL(), "event.created,event.deleted", true, 0, testutil.AnyTime{}, w.ID()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "failure update webhook error",
+			success: false,
+			webhook: newTestWebhook(),
+			setup: func(mock sqlmock.Sqlmock, w webhook.Webhook) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhooks" SET "url"=$1,"event_types"=$2,"enabled"=$3,"consecutive_failures"=$4,"updated_at"=$5 WHERE "id" = $6`)).
+					WithArgs(w.URL(), "event.created,event.deleted", true, 0, testutil.AnyTime{}, w.ID()).
+					WillReturnError(errors.New("update webhook error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.webhook)
+
+			repo := NewWebhookRepository(gormDB)
+
+			err = repo.Update(tt.webhook)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		id      webhook.WebhookID
+		setup   func(mock sqlmock.Sqlmock, id webhook.WebhookID)
+	}{
+		{
+			name:    "success delete webhook",
+			success: true,
+			id:      webhook.NewWebhookID(),
+			setup: func(mock sqlmock.Sqlmock, id webhook.WebhookID) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhooks" WHERE id = $1`)).
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:    "failure webhook not found",
+			success: false,
+			id:      webhook.NewWebhookID(),
+			setup: func(mock sqlmock.Sqlmock, id webhook.WebhookID) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhooks" WHERE id = $1`)).
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:    "failure delete webhook error",
+			success: false,
+			id:      webhook.NewWebhookID(),
+			setup: func(mock sqlmock.Sqlmock, id webhook.WebhookID) {
+				mock.ExpectBegin()
+
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "webhooks" WHERE id = $1`)).
+					WithArgs(id).
+					WillReturnError(errors.New("delete webhook error"))
+
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.id)
+
+			repo := NewWebhookRepository(gormDB)
+
+			err = repo.Delete(tt.id)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindByID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		id      webhook.WebhookID
+		setup   func(mock sqlmock.Sqlmock, id webhook.WebhookID)
+	}{
+		{
+			name:    "success find by id",
+			success: true,
+			id:      webhook.NewWebhookID(),
+			setup: func(mock sqlmock.Sqlmock, id webhook.WebhookID) {
+				webhookRows := sqlmock.NewRows([]string{"id", "user_id", "url", "event_types", "secret", "enabled", "consecutive_failures", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "https://example.com/hooks", "event.created", "secret", true, 0, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE id = $1 ORDER BY "webhooks"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnRows(webhookRows)
+			},
+		},
+		{
+			name:    "failure webhook not found",
+			success: false,
+			id:      webhook.NewWebhookID(),
+			setup: func(mock sqlmock.Sqlmock, id webhook.WebhookID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE id = $1 ORDER BY "webhooks"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name:    "failure invalid event types",
+			success: false,
+			id:      webhook.NewWebhookID(),
+			setup: func(mock sqlmock.Sqlmock, id webhook.WebhookID) {
+				webhookRows := sqlmock.NewRows([]string{"id", "user_id", "url", "event_types", "secret", "enabled", "consecutive_failures", "created_at", "updated_at"}).
+					AddRow(id, uuid.New(), "https://example.com/hooks", "event.moved", "secret", true, 0, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE id = $1 ORDER BY "webhooks"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnRows(webhookRows)
+			},
+		},
+		{
+			name:    "failure find by id error",
+			success: false,
+			id:      webhook.NewWebhookID(),
+			setup: func(mock sqlmock.Sqlmock, id webhook.WebhookID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE id = $1 ORDER BY "webhooks"."id" LIMIT $2`)).
+					WithArgs(id, 1).
+					WillReturnError(errors.New("find by id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.id)
+
+			repo := NewWebhookRepository(gormDB)
+
+			_, err = repo.FindByID(tt.id)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestFindAllByUserID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		success bool
+		userID  user.UserID
+		setup   func(mock sqlmock.Sqlmock, userID user.UserID)
+	}{
+		{
+			name:    "success find all by user id",
+			success: true,
+			userID:  user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				webhookRows := sqlmock.NewRows([]string{"id", "user_id", "url", "event_types", "secret", "enabled", "consecutive_failures", "created_at", "updated_at"}).
+					AddRow(uuid.New(), userID, "https://example.com/hooks", "", "secret", true, 0, time.Now(), time.Now()).
+					AddRow(uuid.New(), userID, "https://example.com/other", "event.deleted", "secret", false, 10, time.Now(), time.Now())
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE user_id = $1 ORDER BY created_at asc`)).
+					WithArgs(userID).
+					WillReturnRows(webhookRows)
+			},
+		},
+		{
+			name:    "failure find all by user id error",
+			success: false,
+			userID:  user.UserID{UUID: uuid.New()},
+			setup: func(mock sqlmock.Sqlmock, userID user.UserID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE user_id = $1 ORDER BY created_at asc`)).
+					WithArgs(userID).
+					WillReturnError(errors.New("find all by user id error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Errorf("failed to new sqlmock: %s", err)
+			}
+
+			gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+			if err != nil {
+				t.Errorf("failed to open gorm: %s", err)
+			}
+
+			tt.setup(mock, tt.userID)
+
+			repo := NewWebhookRepository(gormDB)
+
+			_, err = repo.FindAllByUserID(tt.userID)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
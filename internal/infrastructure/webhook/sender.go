@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	appwebhook "github.com/qkitzero/event-service/internal/application/webhook"
+)
+
+var errBlockedAddress = errors.New("webhook address is not publicly routable")
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which is not
+// reachable from the internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// NewHTTPClient returns a client for sending deliveries. It never follows
+// redirects and, unless allowPrivate, refuses to connect to loopback,
+// private, link-local and other non-public addresses, so that webhooks
+// cannot be pointed at internal services. The address is checked after the
+// host is resolved, which also covers names that resolve to such addresses.
+func NewHTTPClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !isPublic(addrPort.Addr()) {
+				return errBlockedAddress
+			}
+			return nil
+		}
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 2,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func isPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+type httpSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(client *http.Client) appwebhook.Sender {
+	return &httpSender{client: client}
+}
+
+// Send posts the body with the headers receivers use to verify and
+// deduplicate deliveries: the signature of the timestamp and body, the
+// timestamp itself, and the delivery ID.
+func (s *httpSender) Send(ctx context.Context, req appwebhook.Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL.String(), bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("X-Webhook-ID", req.DeliveryID.String())
+	httpReq.Header.Set("X-Webhook-Event", req.EventType.String())
+	httpReq.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(req.Timestamp.Unix(), 10))
+	httpReq.Header.Set("X-Webhook-Signature", req.Signature)
+
+	res, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	return res.StatusCode, nil
+}
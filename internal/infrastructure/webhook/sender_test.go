@@ -0,0 +1,163 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	appwebhook "github.com/qkitzero/event-service/internal/application/webhook"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+	body := []byte(`{"id":"1"}`)
+	timestamp := time.Unix(1700000000, 0)
+	tests := []struct {
+		name       string
+		success    bool
+		statusCode int
+		closed     bool
+	}{
+		{"success send", true, http.StatusOK, false},
+		{"success send server error", true, http.StatusInternalServerError, false},
+		{"failure unreachable", false, 0, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := appwebhook.Request{
+				DeliveryID: webhook.NewDeliveryID(),
+				EventType:  webhook.EventTypeCreated,
+				Timestamp:  timestamp,
+				Signature:  webhook.Secret("secret").Sign(timestamp, body),
+				Body:       body,
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("Method = %v, want %v", r.Method, http.MethodPost)
+				}
+				if got := r.Header.Get("X-Webhook-Signature"); got != req.Signature {
+					t.Errorf("X-Webhook-Signature = %v, want %v", got, req.Signature)
+				}
+				if got := r.Header.Get("X-Webhook-Timestamp"); got != "1700000000" {
+					t.Errorf("X-Webhook-Timestamp = %v, want %v", got, "1700000000")
+				}
+				if got := r.Header.Get("X-Webhook-ID"); got != req.DeliveryID.String() {
+					t.Errorf("X-Webhook-ID = %v, want %v", got, req.DeliveryID.String())
+				}
+				if got := r.Header.Get("X-Webhook-Event"); got != "event.created" {
+					t.Errorf("X-Webhook-Event = %v, want %v", got, "event.created")
+				}
+				if got, _ := io.ReadAll(r.Body); string(got) != string(body) {
+					t.Errorf("body = %s, want %s", got, body)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+			req.URL = webhook.URL(server.URL)
+			if tt.closed {
+				server.Close()
+			}
+
+			sender := NewHTTPSender(server.Client())
+
+			statusCode, err := sender.Send(context.Background(), req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if statusCode != tt.statusCode {
+				t.Errorf("Send() = %v, want %v", statusCode, tt.statusCode)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		success      bool
+		allowPrivate bool
+		redirect     bool
+		statusCode   int
+	}{
+		{"success private address allowed", true, true, false, http.StatusOK},
+		{"success redirect not followed", true, true, true, http.StatusFound},
+		{"failure private address", false, false, false, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/redirected" {
+					t.Errorf("expected the redirect not to be followed")
+				}
+				if tt.redirect {
+					http.Redirect(w, r, "/redirected", http.StatusFound)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			sender := NewHTTPSender(NewHTTPClient(time.Second, tt.allowPrivate))
+
+			statusCode, err := sender.Send(context.Background(), appwebhook.Request{URL: webhook.URL(server.URL)})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && !errors.Is(err, errBlockedAddress) {
+				t.Errorf("expected %v, but got %v", errBlockedAddress, err)
+			}
+			if statusCode != tt.statusCode {
+				t.Errorf("Send() = %v, want %v", statusCode, tt.statusCode)
+			}
+		})
+	}
+}
+
+func TestIsPublic(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		addr     string
+		expected bool
+	}{
+		{"public ipv4", "93.184.216.34", true},
+		{"public ipv6", "2606:2800:220:1:248:1893:25c8:1946", true},
+		{"loopback", "127.0.0.1", false},
+		{"ipv6 loopback", "::1", false},
+		{"private", "10.0.0.1", false},
+		{"private 172", "172.16.0.1", false},
+		{"private 192", "192.168.1.1", false},
+		{"link-local metadata", "169.254.169.254", false},
+		{"ipv6 link-local", "fe80::1", false},
+		{"ipv6 unique local", "fd00::1", false},
+		{"ipv4-mapped loopback", "::ffff:127.0.0.1", false},
+		{"shared address space", "100.64.0.1", false},
+		{"unspecified", "0.0.0.0", false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isPublic(netip.MustParseAddr(tt.addr)); got != tt.expected {
+				t.Errorf("isPublic(%s) = %v, want %v", tt.addr, got, tt.expected)
+			}
+		})
+	}
+}
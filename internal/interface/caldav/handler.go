@@ -0,0 +1,510 @@
+package caldav
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	appevent "github.com/qkitzero/event-service/internal/application/event"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+)
+
+const (
+	// WellKnownPath is where clients discover the server (RFC 6764).
+	WellKnownPath = "/.well-known/caldav"
+
+	rootPath     = "/caldav/"
+	calendarPath = rootPath + "events/"
+
+	resourceSuffix      = ".ics"
+	calendarContentType = "text/calendar; charset=utf-8"
+	xmlContentType      = "application/xml; charset=utf-8"
+	maxBodySize         = 1 << 20
+)
+
+var badRequestErrors = []error{
+	event.ErrInvalidTitle,
+	event.ErrInvalidDescription,
+	event.ErrInvalidTimeRange,
+	event.ErrDurationTooLong,
+	event.ErrInvalidDate,
+	event.ErrInvalidAllDayRange,
+	event.ErrInvalidTimeZone,
+	event.ErrInvalidColor,
+	event.ErrInvalidRecurrence,
+	event.ErrInvalidICalendar,
+	event.ErrInvalidTimeWindow,
+}
+
+// Handler serves the events in the caller's own calendars as a single CalDAV
+// calendar collection (RFC 4791). Resources are named after the iCalendar UID of their event, so
+// that objects created by clients keep the URL they were stored at.
+type Handler struct {
+	eventUsecase  appevent.EventUsecase
+	authenticator auth.Authenticator
+}
+
+func NewHandler(eventUsecase appevent.EventUsecase, authenticator auth.Authenticator) *Handler {
+	return &Handler{
+		eventUsecase:  eventUsecase,
+		authenticator: authenticator,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == WellKnownPath {
+		http.Redirect(w, r, rootPath, http.StatusMovedPermanently)
+		return
+	}
+
+	if r.Method == http.MethodOptions {
+		w.Header().Set("DAV", "1, 3, calendar-access")
+		w.Header().Set("Allow", "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	ctx, err := h.authenticate(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	r = r.WithContext(ctx)
+
+	path := r.URL.EscapedPath()
+	switch {
+	case path == rootPath || path+"/" == rootPath:
+		switch r.Method {
+		case "PROPFIND":
+			h.propfindRoot(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	case path == calendarPath || path+"/" == calendarPath:
+		switch r.Method {
+		case "PROPFIND":
+			h.propfindCalendar(w, r)
+		case "REPORT":
+			h.report(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	default:
+		icalUID, ok := resourceUID(path)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			h.get(w, r, icalUID)
+		case http.MethodPut:
+			h.put(w, r, icalUID)
+		case http.MethodDelete:
+			h.delete(w, r, icalUID)
+		case "PROPFIND":
+			h.propfindResource(w, r, icalUID)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// authenticate verifies the request's credentials with the same
+// authenticator as the gRPC server. CalDAV clients generally only support
+// Basic authentication, so its password is accepted as the bearer token.
+func (h *Handler) authenticate(r *http.Request) (context.Context, error) {
+	authorization := r.Header.Get("Authorization")
+	if _, password, ok := r.BasicAuth(); ok {
+		authorization = "Bearer " + password
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", authorization))
+	principal, err := h.authenticator.Authenticate(ctx)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() != codes.Unauthenticated && st.Code() != codes.InvalidArgument {
+			return nil, err
+		}
+		return nil, auth.ErrUnauthenticated
+	}
+
+	return auth.NewContext(r.Context(), principal), nil
+}
+
+func (h *Handler) propfindRoot(w http.ResponseWriter, r *http.Request) {
+	names, err := readPropfind(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	responses := []response{newResponse(rootPath, rootProps(), names)}
+	if depth(r) != "0" {
+		ctag, err := h.ctag(r.Context())
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		responses = append(responses, newResponse(calendarPath, calendarProps(ctag), names))
+	}
+
+	writeMultistatus(w, responses)
+}
+
+func (h *Handler) propfindCalendar(w http.ResponseWriter, r *http.Request) {
+	names, err := readPropfind(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	events, err := h.eventUsecase.ListICalendarEvents(r.Context(), nil, nil)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	responses := []response{newResponse(calendarPath, calendarProps(ctagOf(events)), names)}
+	if depth(r) != "0" {
+		for _, e := range events {
+			responses = append(responses, newResponse(resourcePath(e), resourceProps(e, false), names))
+		}
+	}
+
+	writeMultistatus(w, responses)
+}
+
+func (h *Handler) propfindResource(w http.ResponseWriter, r *http.Request, icalUID string) {
+	names, err := readPropfind(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	e, err := h.eventUsecase.GetEventByICalUID(r.Context(), icalUID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeMultistatus(w, []response{newResponse(resourcePath(e), resourceProps(e, false), names)})
+}
+
+func (h *Handler) report(w http.ResponseWriter, r *http.Request) {
+	body, err := readBody(w, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	req, err := parseReport(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var names propNames
+	if req.Prop != nil {
+		names = *req.Prop
+	}
+
+	switch req.XMLName {
+	case reportCalendarQuery:
+		h.calendarQuery(w, r, req.Filter, names)
+	case reportCalendarMultiget:
+		h.calendarMultiget(w, r, req.Hrefs, names)
+	default:
+		http.Error(w, "unsupported report", http.StatusForbidden)
+	}
+}
+
+// calendarQuery returns the events matching a filter (RFC 4791 section 7.8).
+// Recurring events match when any of their occurrences overlaps the
+// time-range.
+func (h *Handler) calendarQuery(w http.ResponseWriter, r *http.Request, f *filter, names propNames) {
+	from, to, ok, err := f.eventWindow()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var responses []response
+	if ok {
+		events, err := h.eventUsecase.ListICalendarEvents(r.Context(), from, to)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		for _, e := range events {
+			responses = append(responses, newResponse(resourcePath(e), resourceProps(e, true), names))
+		}
+	}
+
+	writeMultistatus(w, responses)
+}
+
+func (h *Handler) calendarMultiget(w http.ResponseWriter, r *http.Request, hrefs []string, names propNames) {
+	responses := make([]response, 0, len(hrefs))
+	for _, href := range hrefs {
+		u, err := url.Parse(strings.TrimSpace(href))
+		if err != nil {
+			responses = append(responses, response{href: href, status: http.StatusNotFound})
+			continue
+		}
+
+		icalUID, ok := resourceUID(u.EscapedPath())
+		if !ok {
+			responses = append(responses, response{href: href, status: http.StatusNotFound})
+			continue
+		}
+
+		e, err := h.eventUsecase.GetEventByICalUID(r.Context(), icalUID)
+		if errors.Is(err, event.ErrEventNotFound) {
+			responses = append(responses, response{href: href, status: http.StatusNotFound})
+			continue
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		responses = append(responses, newResponse(href, resourceProps(e, true), names))
+	}
+
+	writeMultistatus(w, responses)
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request, icalUID string) {
+	e, err := h.eventUsecase.GetEventByICalUID(r.Context(), icalUID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", calendarContentType)
+	w.Header().Set("ETag", e.ETag())
+	w.Header().Set("Last-Modified", e.UpdatedAt().UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(event.MarshalICalendar([]event.Event{e}))
+}
+
+// put creates or replaces a resource. If-Match is checked against the event
+// version, and "If-None-Match: *" only allows creating a new resource.
+func (h *Handler) put(w http.ResponseWriter, r *http.Request, icalUID string) {
+	body, err := readBody(w, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "*" || r.Header.Get("If-None-Match") == "*" {
+		_, err := h.eventUsecase.GetEventByICalUID(r.Context(), icalUID)
+		if err != nil && !errors.Is(err, event.ErrEventNotFound) {
+			writeError(w, err)
+			return
+		}
+		exists := err == nil
+		if (ifMatch == "*" && !exists) || (r.Header.Get("If-None-Match") == "*" && exists) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		if ifMatch == "*" {
+			ifMatch = ""
+		}
+	}
+
+	e, created, err := h.eventUsecase.SaveICalendarEvent(r.Context(), icalUID, string(body), ifMatch)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", e.ETag())
+	if created {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request, icalUID string) {
+	e, err := h.eventUsecase.GetEventByICalUID(r.Context(), icalUID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "*" {
+		ifMatch = ""
+	}
+
+	if err := h.eventUsecase.DeleteEvent(r.Context(), e.ID().String(), ifMatch); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ctag(ctx context.Context) (string, error) {
+	events, err := h.eventUsecase.ListICalendarEvents(ctx, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	return ctagOf(events), nil
+}
+
+// ctagOf derives the collection tag clients poll to detect changes, which
+// changes whenever an event is created, updated or removed.
+func ctagOf(events []event.Event) string {
+	h := sha256.New()
+	for _, e := range events {
+		h.Write([]byte(e.ICalUID()))
+		h.Write([]byte(e.ETag()))
+	}
+	return strconv.Quote(hex.EncodeToString(h.Sum(nil))[:32])
+}
+
+func rootProps() []property {
+	return []property{
+		{propResourceType, "<d:collection/><d:principal/>"},
+		{propCurrentUserPrincipal, hrefElement(rootPath)},
+		{propPrincipalURL, hrefElement(rootPath)},
+		{propCalendarHomeSet, hrefElement(rootPath)},
+	}
+}
+
+func calendarProps(ctag string) []property {
+	return []property{
+		{propResourceType, "<d:collection/><c:calendar/>"},
+		{propDisplayName, "Events"},
+		{propCurrentUserPrincipal, hrefElement(rootPath)},
+		{propCurrentUserPrivilegeSet, "<d:privilege><d:read/></d:privilege><d:privilege><d:write/></d:privilege>"},
+		{propSupportedComponentSet, `<c:comp name="VEVENT"/>`},
+		{propSupportedReportSet, "<d:supported-report><d:report><c:calendar-query/></d:report></d:supported-report><d:supported-report><d:report><c:calendar-multiget/></d:report></d:supported-report>"},
+		{propGetCTag, escape(ctag)},
+	}
+}
+
+func resourceProps(e event.Event, withData bool) []property {
+	props := []property{
+		{propResourceType, ""},
+		{propGetETag, escape(e.ETag())},
+		{propGetContentType, calendarContentType + "; component=vevent"},
+		{propGetLastModified, e.UpdatedAt().UTC().Format(http.TimeFormat)},
+	}
+	if withData {
+		props = append(props, property{propCalendarData, escape(string(event.MarshalICalendar([]event.Event{e})))})
+	}
+	return props
+}
+
+func newResponse(href string, available []property, names propNames) response {
+	found, missing := selectProps(available, names)
+	return response{href: href, props: found, missing: missing}
+}
+
+func resourcePath(e event.Event) string {
+	return calendarPath + url.PathEscape(e.ICalUID()) + resourceSuffix
+}
+
+// resourceUID returns the iCalendar UID named by an escaped resource path.
+func resourceUID(path string) (string, bool) {
+	name, ok := strings.CutPrefix(path, calendarPath)
+	if !ok {
+		return "", false
+	}
+
+	name, ok = strings.CutSuffix(name, resourceSuffix)
+	if !ok || name == "" || strings.Contains(name, "/") {
+		return "", false
+	}
+
+	icalUID, err := url.PathUnescape(name)
+	if err != nil {
+		return "", false
+	}
+
+	return icalUID, true
+}
+
+// depth returns the Depth header, treating infinity as 1 since the collection
+// hierarchy is only two levels deep.
+func depth(r *http.Request) string {
+	if r.Header.Get("Depth") == "0" {
+		return "0"
+	}
+	return "1"
+}
+
+func readPropfind(r *http.Request) (propNames, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return nil, err
+	}
+	return parsePropfind(body)
+}
+
+func readBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	return io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+}
+
+func writeMultistatus(w http.ResponseWriter, responses []response) {
+	w.Header().Set("Content-Type", xmlContentType)
+	w.WriteHeader(http.StatusMultiStatus)
+	_, _ = w.Write(marshalMultistatus(responses))
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		w.Header().Set("WWW-Authenticate", `Basic realm="event-service"`)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	case errors.Is(err, event.ErrEventNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, event.ErrPermissionDenied), errors.Is(err, event.ErrNotOrganizer), errors.Is(err, calendar.ErrPermissionDenied):
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case errors.Is(err, event.ErrETagMismatch), errors.Is(err, event.ErrVersionConflict), errors.Is(err, event.ErrInvalidETag):
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	case errors.As(err, &maxBytesErr):
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	for _, badRequestErr := range badRequestErrors {
+		if errors.Is(err, badRequestErr) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return
+	}
+
+	log.Printf("caldav: %v", err)
+
+	http.Error(w, "internal error", http.StatusInternalServerError)
+}
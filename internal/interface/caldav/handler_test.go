@@ -0,0 +1,223 @@
+package caldav
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	appevent "github.com/qkitzero/event-service/internal/application/event"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/user"
+	mocksauth "github.com/qkitzero/event-service/mocks/application/auth"
+	mocksappcalendar "github.com/qkitzero/event-service/mocks/application/calendar"
+	mocksappevent "github.com/qkitzero/event-service/mocks/application/event"
+	mocksuser "github.com/qkitzero/event-service/mocks/application/user"
+	mockscalendar "github.com/qkitzero/event-service/mocks/domain/calendar"
+	mocksevent "github.com/qkitzero/event-service/mocks/domain/event"
+)
+
+func newTestEvent(icalUID string, start time.Time) event.Event {
+	return event.NewEvent(event.NewEventID(), user.UserID{UUID: uuid.New()}, calendar.CalendarID{}, event.Title("title"), event.Description("description"), start, start.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, icalUID, 2, start, start, time.Time{})
+}
+
+func timestampAt(t time.Time) gomock.Matcher {
+	return gomock.Cond(func(ts *timestamppb.Timestamp) bool {
+		return ts != nil && ts.AsTime().Equal(t)
+	})
+}
+
+func TestServeHTTP(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	e := newTestEvent("a@example.com", start)
+	queryStart, queryEnd := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	calendarData := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\nSUMMARY:title\r\nDESCRIPTION:description\r\nDTSTART:20250106T090000Z\r\nDTEND:20250106T100000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	calendarQuery := `<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><d:prop><d:getetag/><c:calendar-data/></d:prop><c:filter><c:comp-filter name="VCALENDAR"><c:comp-filter name="VEVENT"><c:time-range start="20250101T000000Z" end="20250201T000000Z"/></c:comp-filter></c:comp-filter></c:filter></c:calendar-query>`
+	todoQuery := `<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><d:prop><d:getetag/></d:prop><c:filter><c:comp-filter name="VCALENDAR"><c:comp-filter name="VTODO"/></c:comp-filter></c:filter></c:calendar-query>`
+	multiget := `<c:calendar-multiget xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav"><d:prop><d:getetag/></d:prop><d:href>/caldav/events/a@example.com.ics</d:href><d:href>/caldav/events/missing.ics</d:href></c:calendar-multiget>`
+	propfind := `<d:propfind xmlns:d="DAV:"><d:prop><d:getetag/><d:owner/></d:prop></d:propfind>`
+	tests := []struct {
+		name             string
+		success          bool
+		method           string
+		path             string
+		header           http.Header
+		body             string
+		verifyTokenErr   error
+		events           []event.Event
+		getEventErr      error
+		saveErr          error
+		created          bool
+		deleteErr        error
+		expectedStatus   int
+		expectedContains []string
+		unexpected       []string
+	}{
+		{"success options", true, http.MethodOptions, "/caldav/", nil, "", errors.New("unauthenticated"), nil, nil, nil, false, nil, http.StatusOK, nil, nil},
+		{"success well-known redirect", true, http.MethodGet, WellKnownPath, nil, "", errors.New("unauthenticated"), nil, nil, nil, false, nil, http.StatusMovedPermanently, nil, nil},
+		{"success propfind root", true, "PROPFIND", "/caldav/", http.Header{"Depth": {"1"}}, "", nil, []event.Event{e}, nil, nil, false, nil, http.StatusMultiStatus, []string{"<d:href>/caldav/</d:href>", "<c:calendar-home-set>", "<d:href>/caldav/events/</d:href>", "<cs:getctag>"}, nil},
+		{"success propfind calendar", true, "PROPFIND", "/caldav/events/", http.Header{"Depth": {"1"}}, propfind, nil, []event.Event{e}, nil, nil, false, nil, http.StatusMultiStatus, []string{"<d:href>/caldav/events/a@example.com.ics</d:href>", "<d:getetag>&#34;2&#34;</d:getetag>", "<d:owner/>", "HTTP/1.1 404 Not Found"}, nil},
+		{"success propfind calendar depth 0", true, "PROPFIND", "/caldav/events", http.Header{"Depth": {"0"}}, "", nil, []event.Event{e}, nil, nil, false, nil, http.StatusMultiStatus, []string{"<c:calendar/>"}, []string{"a@example.com.ics"}},
+		{"success propfind resource", true, "PROPFIND", "/caldav/events/a@example.com.ics", nil, propfind, nil, nil, nil, nil, false, nil, http.StatusMultiStatus, []string{"<d:getetag>&#34;2&#34;</d:getetag>"}, nil},
+		{"success calendar query", true, "REPORT", "/caldav/events/", nil, calendarQuery, nil, []event.Event{e}, nil, nil, false, nil, http.StatusMultiStatus, []string{"a@example.com.ics", "<c:calendar-data>BEGIN:VCALENDAR"}, []string{"b@example.com.ics"}},
+		{"success calendar query for todos", true, "REPORT", "/caldav/events/", nil, todoQuery, nil, []event.Event{e}, nil, nil, false, nil, http.StatusMultiStatus, nil, []string{"a@example.com.ics"}},
+		{"success calendar multiget", true, "REPORT", "/caldav/events/", nil, multiget, nil, nil, nil, nil, false, nil, http.StatusMultiStatus, []string{"a@example.com.ics", "<d:href>/caldav/events/missing.ics</d:href><d:status>HTTP/1.1 404 Not Found</d:status>"}, nil},
+		{"success get", true, http.MethodGet, "/caldav/events/a@example.com.ics", nil, "", nil, nil, nil, nil, false, nil, http.StatusOK, []string{"UID:a@example.com"}, nil},
+		{"success put create", true, http.MethodPut, "/caldav/events/a@example.com.ics", http.Header{"If-None-Match": {"*"}}, calendarData, nil, nil, event.ErrEventNotFound, nil, true, nil, http.StatusCreated, nil, nil},
+		{"success put update", true, http.MethodPut, "/caldav/events/a@example.com.ics", http.Header{"If-Match": {`"2"`}}, calendarData, nil, nil, nil, nil, false, nil, http.StatusNoContent, nil, nil},
+		{"success delete", true, http.MethodDelete, "/caldav/events/a@example.com.ics", http.Header{"If-Match": {`"2"`}}, "", nil, nil, nil, nil, false, nil, http.StatusNoContent, nil, nil},
+		{"failure unauthenticated", false, "PROPFIND", "/caldav/", nil, "", errors.New("unauthenticated"), nil, nil, nil, false, nil, http.StatusUnauthorized, nil, nil},
+		{"failure unknown path", false, http.MethodGet, "/caldav/other", nil, "", nil, nil, nil, nil, false, nil, http.StatusNotFound, nil, nil},
+		{"failure unsupported report", false, "REPORT", "/caldav/events/", nil, `<d:sync-collection xmlns:d="DAV:"/>`, nil, nil, nil, nil, false, nil, http.StatusForbidden, nil, nil},
+		{"failure invalid time range", false, "REPORT", "/caldav/events/", nil, strings.Replace(calendarQuery, "20250101T000000Z", "2025-01-01", 1), nil, nil, nil, nil, false, nil, http.StatusBadRequest, nil, nil},
+		{"failure get not found", false, http.MethodGet, "/caldav/events/a@example.com.ics", nil, "", nil, nil, event.ErrEventNotFound, nil, false, nil, http.StatusNotFound, nil, nil},
+		{"failure put existing with if-none-match", false, http.MethodPut, "/caldav/events/a@example.com.ics", http.Header{"If-None-Match": {"*"}}, calendarData, nil, nil, nil, nil, false, nil, http.StatusPreconditionFailed, nil, nil},
+		{"failure put etag mismatch", false, http.MethodPut, "/caldav/events/a@example.com.ics", http.Header{"If-Match": {`"1"`}}, calendarData, nil, nil, nil, event.ErrETagMismatch, false, nil, http.StatusPreconditionFailed, nil, nil},
+		{"failure put invalid data", false, http.MethodPut, "/caldav/events/a@example.com.ics", nil, "invalid", nil, nil, nil, event.ErrInvalidICalendar, false, nil, http.StatusBadRequest, nil, nil},
+		{"failure delete etag mismatch", false, http.MethodDelete, "/caldav/events/a@example.com.ics", http.Header{"If-Match": {`"1"`}}, "", nil, nil, nil, nil, false, event.ErrETagMismatch, http.StatusPreconditionFailed, nil, nil},
+		{"failure delete error", false, http.MethodDelete, "/caldav/events/a@example.com.ics", nil, "", nil, nil, nil, nil, false, errors.New("delete error"), http.StatusInternalServerError, nil, nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuthService := mocksauth.NewMockAuthService(ctrl)
+			mockAuthService.EXPECT().VerifyToken(gomock.Any()).Return("google-oauth2|000000000000000000000", tt.verifyTokenErr).AnyTimes()
+			mockUserService := mocksuser.NewMockUserService(ctrl)
+			mockUserService.EXPECT().GetUser(gomock.Any()).Return("6d322c66-bf4d-427a-970c-874f3745f653", nil).AnyTimes()
+
+			var foundEvent event.Event
+			if tt.getEventErr == nil {
+				foundEvent = e
+			}
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			mockEventUsecase.EXPECT().ListICalendarEvents(gomock.Any(), nil, nil).Return(tt.events, nil).AnyTimes()
+			mockEventUsecase.EXPECT().ListICalendarEvents(gomock.Any(), timestampAt(queryStart), timestampAt(queryEnd)).Return(tt.events, nil).AnyTimes()
+			mockEventUsecase.EXPECT().GetEventByICalUID(gomock.Any(), "a@example.com").Return(foundEvent, tt.getEventErr).AnyTimes()
+			mockEventUsecase.EXPECT().GetEventByICalUID(gomock.Any(), "missing").Return(nil, event.ErrEventNotFound).AnyTimes()
+			mockEventUsecase.EXPECT().SaveICalendarEvent(gomock.Any(), "a@example.com", tt.body, tt.header.Get("If-Match")).Return(e, tt.created, tt.saveErr).AnyTimes()
+			mockEventUsecase.EXPECT().DeleteEvent(gomock.Any(), e.ID().String(), tt.header.Get("If-Match")).Return(tt.deleteErr).AnyTimes()
+
+			handler := NewHandler(mockEventUsecase, auth.NewAuthenticator(mockAuthService, mockUserService))
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			for key, values := range tt.header {
+				req.Header[key] = values
+			}
+			req.SetBasicAuth("user", "token")
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if tt.success && rec.Code >= http.StatusBadRequest {
+				t.Errorf("expected no error, but got status %d", rec.Code)
+			}
+			if !tt.success && rec.Code < http.StatusBadRequest {
+				t.Errorf("expected error, but got status %d", rec.Code)
+			}
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.expectedStatus)
+			}
+			for _, s := range tt.expectedContains {
+				if !strings.Contains(rec.Body.String(), s) {
+					t.Errorf("body does not contain %q:\n%s", s, rec.Body.String())
+				}
+			}
+			for _, s := range tt.unexpected {
+				if strings.Contains(rec.Body.String(), s) {
+					t.Errorf("body contains %q:\n%s", s, rec.Body.String())
+				}
+			}
+		})
+	}
+}
+
+// TestPutWithoutDescription saves through the event usecase, since clients
+// such as Apple Calendar, Thunderbird and DAVx5 leave DESCRIPTION out.
+func TestPutWithoutDescription(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := user.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}
+	mockAuthService := mocksauth.NewMockAuthService(ctrl)
+	mockAuthService.EXPECT().VerifyToken(gomock.Any()).Return("google-oauth2|000000000000000000000", nil).AnyTimes()
+	mockUserService := mocksuser.NewMockUserService(ctrl)
+	mockUserService.EXPECT().GetUser(gomock.Any()).Return(userID.String(), nil).AnyTimes()
+
+	defaultCalendar := calendar.NewCalendar(calendar.NewCalendarID(), userID, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now())
+	mockCalendarRepository := mockscalendar.NewMockCalendarRepository(ctrl)
+	mockCalendarRepository.EXPECT().FindDefaultByUserID(userID).Return(defaultCalendar, nil).AnyTimes()
+	mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+	mockPolicy.EXPECT().AccessibleCalendarIDs(userID, calendar.AccessLevelRead).Return([]calendar.CalendarID{defaultCalendar.ID()}, nil).AnyTimes()
+	mockEventRepository := mocksevent.NewMockEventRepository(ctrl)
+	mockEventRepository.EXPECT().FindByICalUID(userID, "a@example.com").Return(nil, event.ErrEventNotFound).AnyTimes()
+	mockEventRepository.EXPECT().Create(gomock.Any()).DoAndReturn(func(e event.Event) error {
+		if e.Description() != "" {
+			t.Errorf("Description() = %q, want empty", e.Description())
+		}
+		return nil
+	}).Times(1)
+
+	eventUsecase := appevent.NewEventUsecase(mockEventRepository, mockCalendarRepository, mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+	handler := NewHandler(eventUsecase, auth.NewAuthenticator(mockAuthService, mockUserService))
+
+	body := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\nSUMMARY:title\r\nDTSTART:20250106T090000Z\r\nDTEND:20250106T100000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	req := httptest.NewRequest(http.MethodPut, "/caldav/events/a@example.com.ics", strings.NewReader(body))
+	req.Header.Set("If-None-Match", "*")
+	req.SetBasicAuth("user", "token")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Errorf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+}
+
+func TestResourceUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		success  bool
+		path     string
+		expected string
+	}{
+		{"success resource", true, "/caldav/events/a@example.com.ics", "a@example.com"},
+		{"success escaped resource", true, "/caldav/events/a%2Fb.ics", "a/b"},
+		{"failure calendar", false, "/caldav/events/", ""},
+		{"failure without suffix", false, "/caldav/events/a", ""},
+		{"failure nested", false, "/caldav/events/a/b.ics", ""},
+		{"failure other collection", false, "/caldav/a.ics", ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			icalUID, ok := resourceUID(tt.path)
+			if tt.success && !ok {
+				t.Errorf("expected no error, but got false")
+			}
+			if !tt.success && ok {
+				t.Errorf("expected error, but got %q", icalUID)
+			}
+			if icalUID != tt.expected {
+				t.Errorf("resourceUID() = %q, want %q", icalUID, tt.expected)
+			}
+		})
+	}
+}
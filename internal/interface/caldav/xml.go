@@ -0,0 +1,264 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	nsDAV            = "DAV:"
+	nsCalDAV         = "urn:ietf:params:xml:ns:caldav"
+	nsCalendarServer = "http://calendarserver.org/ns/"
+
+	timeRangeLayout = "20060102T150405Z"
+
+	componentVCalendar = "VCALENDAR"
+	componentVEvent    = "VEVENT"
+)
+
+var prefixes = map[string]string{
+	nsDAV:            "d",
+	nsCalDAV:         "c",
+	nsCalendarServer: "cs",
+}
+
+var (
+	propResourceType            = xml.Name{Space: nsDAV, Local: "resourcetype"}
+	propDisplayName             = xml.Name{Space: nsDAV, Local: "displayname"}
+	propCurrentUserPrincipal    = xml.Name{Space: nsDAV, Local: "current-user-principal"}
+	propPrincipalURL            = xml.Name{Space: nsDAV, Local: "principal-URL"}
+	propCurrentUserPrivilegeSet = xml.Name{Space: nsDAV, Local: "current-user-privilege-set"}
+	propSupportedReportSet      = xml.Name{Space: nsDAV, Local: "supported-report-set"}
+	propGetETag                 = xml.Name{Space: nsDAV, Local: "getetag"}
+	propGetContentType          = xml.Name{Space: nsDAV, Local: "getcontenttype"}
+	propGetLastModified         = xml.Name{Space: nsDAV, Local: "getlastmodified"}
+	propCalendarHomeSet         = xml.Name{Space: nsCalDAV, Local: "calendar-home-set"}
+	propSupportedComponentSet   = xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"}
+	propCalendarData            = xml.Name{Space: nsCalDAV, Local: "calendar-data"}
+	propGetCTag                 = xml.Name{Space: nsCalendarServer, Local: "getctag"}
+	reportCalendarQuery         = xml.Name{Space: nsCalDAV, Local: "calendar-query"}
+	reportCalendarMultiget      = xml.Name{Space: nsCalDAV, Local: "calendar-multiget"}
+)
+
+// propNames collects the names of the children of a DAV:prop element.
+type propNames []xml.Name
+
+func (p *propNames) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			*p = append(*p, t.Name)
+			if err := d.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+type propfindRequest struct {
+	XMLName xml.Name   `xml:"DAV: propfind"`
+	AllProp *struct{}  `xml:"DAV: allprop"`
+	Prop    *propNames `xml:"DAV: prop"`
+}
+
+type reportRequest struct {
+	XMLName xml.Name
+	Prop    *propNames `xml:"DAV: prop"`
+	Hrefs   []string   `xml:"DAV: href"`
+	Filter  *filter    `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+type filter struct {
+	CompFilter compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+type compFilter struct {
+	Name        string       `xml:"name,attr"`
+	TimeRange   *timeRange   `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	CompFilters []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+type timeRange struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+// parsePropfind returns the requested properties, or nil when every property
+// is requested. An empty body is equivalent to allprop (RFC 4918 section 9.1).
+func parsePropfind(body []byte) (propNames, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, nil
+	}
+
+	var req propfindRequest
+	if err := xml.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+	if req.AllProp != nil || req.Prop == nil {
+		return nil, nil
+	}
+
+	return *req.Prop, nil
+}
+
+func parseReport(body []byte) (reportRequest, error) {
+	var req reportRequest
+	if err := xml.Unmarshal(body, &req); err != nil {
+		return reportRequest{}, err
+	}
+	return req, nil
+}
+
+// eventWindow returns the time range a calendar-query filter selects VEVENTs
+// in, which is nil when the filter has no time-range. ok is false when the
+// filter cannot match any VEVENT, such as a filter on VTODO components.
+func (f *filter) eventWindow() (from, to *timestamppb.Timestamp, ok bool, err error) {
+	if f == nil {
+		return nil, nil, true, nil
+	}
+	if !strings.EqualFold(f.CompFilter.Name, componentVCalendar) {
+		return nil, nil, false, nil
+	}
+
+	for _, cf := range f.CompFilter.CompFilters {
+		if !strings.EqualFold(cf.Name, componentVEvent) {
+			return nil, nil, false, nil
+		}
+		if cf.TimeRange == nil {
+			continue
+		}
+
+		start, end := time.Time{}, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+		if cf.TimeRange.Start != "" {
+			if start, err = time.Parse(timeRangeLayout, cf.TimeRange.Start); err != nil {
+				return nil, nil, false, err
+			}
+		}
+		if cf.TimeRange.End != "" {
+			if end, err = time.Parse(timeRangeLayout, cf.TimeRange.End); err != nil {
+				return nil, nil, false, err
+			}
+		}
+		from, to = timestamppb.New(start), timestamppb.New(end)
+	}
+
+	return from, to, true, nil
+}
+
+// response is a single DAV:response of a multistatus body. Property values are
+// XML fragments that are written verbatim.
+type response struct {
+	href    string
+	status  int
+	props   []property
+	missing []xml.Name
+}
+
+type property struct {
+	name  xml.Name
+	value string
+}
+
+// selectProps splits the available properties into those found and those
+// missing. A nil names selects every available property.
+func selectProps(available []property, names propNames) ([]property, []xml.Name) {
+	if names == nil {
+		return available, nil
+	}
+
+	var found []property
+	var missing []xml.Name
+	for _, name := range names {
+		matched := false
+		for _, p := range available {
+			if p.name == name {
+				found = append(found, p)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			missing = append(missing, name)
+		}
+	}
+
+	return found, missing
+}
+
+func marshalMultistatus(responses []response) []byte {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	b.WriteString(`<d:multistatus xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav" xmlns:cs="http://calendarserver.org/ns/">`)
+	for _, r := range responses {
+		b.WriteString("<d:response><d:href>")
+		b.WriteString(escape(r.href))
+		b.WriteString("</d:href>")
+		if r.status != 0 {
+			writeStatus(&b, r.status)
+		}
+		if len(r.props) > 0 {
+			b.WriteString("<d:propstat><d:prop>")
+			for _, p := range r.props {
+				writeElement(&b, p.name, p.value)
+			}
+			b.WriteString("</d:prop>")
+			writeStatus(&b, http.StatusOK)
+			b.WriteString("</d:propstat>")
+		}
+		if len(r.missing) > 0 {
+			b.WriteString("<d:propstat><d:prop>")
+			for _, name := range r.missing {
+				writeElement(&b, name, "")
+			}
+			b.WriteString("</d:prop>")
+			writeStatus(&b, http.StatusNotFound)
+			b.WriteString("</d:propstat>")
+		}
+		b.WriteString("</d:response>")
+	}
+	b.WriteString("</d:multistatus>")
+
+	return b.Bytes()
+}
+
+func writeStatus(b *bytes.Buffer, code int) {
+	fmt.Fprintf(b, "<d:status>HTTP/1.1 %d %s</d:status>", code, http.StatusText(code))
+}
+
+func writeElement(b *bytes.Buffer, name xml.Name, value string) {
+	tag := name.Local
+	attr := ""
+	if prefix, ok := prefixes[name.Space]; ok {
+		tag = prefix + ":" + name.Local
+	} else if name.Space != "" {
+		attr = ` xmlns="` + escape(name.Space) + `"`
+	}
+
+	if value == "" {
+		b.WriteString("<" + tag + attr + "/>")
+		return
+	}
+	b.WriteString("<" + tag + attr + ">" + value + "</" + tag + ">")
+}
+
+func hrefElement(href string) string {
+	return "<d:href>" + escape(href) + "</d:href>"
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,137 @@
+package calendar
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	calendarv1 "github.com/qkitzero/event-service/gen/go/calendar/v1"
+	appcalendar "github.com/qkitzero/event-service/internal/application/calendar"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+)
+
+type CalendarHandler struct {
+	calendarv1.UnimplementedCalendarServiceServer
+	calendarUsecase appcalendar.CalendarUsecase
+}
+
+func NewCalendarHandler(calendarUsecase appcalendar.CalendarUsecase) *CalendarHandler {
+	return &CalendarHandler{
+		calendarUsecase: calendarUsecase,
+	}
+}
+
+func (h *CalendarHandler) CreateCalendar(ctx context.Context, req *calendarv1.CreateCalendarRequest) (*calendarv1.CreateCalendarResponse, error) {
+	calendar, err := h.calendarUsecase.CreateCalendar(ctx, req.GetName(), req.GetColor(), req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+
+	return &calendarv1.CreateCalendarResponse{
+		Calendar: toCalendarProto(calendar),
+	}, nil
+}
+
+func (h *CalendarHandler) GetCalendar(ctx context.Context, req *calendarv1.GetCalendarRequest) (*calendarv1.GetCalendarResponse, error) {
+	calendar, err := h.calendarUsecase.GetCalendar(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &calendarv1.GetCalendarResponse{
+		Calendar: toCalendarProto(calendar),
+	}, nil
+}
+
+func (h *CalendarHandler) ListCalendars(ctx context.Context, req *calendarv1.ListCalendarsRequest) (*calendarv1.ListCalendarsResponse, error) {
+	calendars, err := h.calendarUsecase.ListCalendars(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbCalendars []*calendarv1.Calendar
+	for _, calendar := range calendars {
+		pbCalendars = append(pbCalendars, toCalendarProto(calendar))
+	}
+
+	return &calendarv1.ListCalendarsResponse{
+		Calendars: pbCalendars,
+	}, nil
+}
+
+func (h *CalendarHandler) UpdateCalendar(ctx context.Context, req *calendarv1.UpdateCalendarRequest) (*calendarv1.UpdateCalendarResponse, error) {
+	calendar, err := h.calendarUsecase.UpdateCalendar(ctx, req.GetCalendar().GetId(), req.GetCalendar().GetName(), req.GetCalendar().GetColor(), req.GetCalendar().GetTimeZone(), req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	return &calendarv1.UpdateCalendarResponse{
+		Calendar: toCalendarProto(calendar),
+	}, nil
+}
+
+func (h *CalendarHandler) DeleteCalendar(ctx context.Context, req *calendarv1.DeleteCalendarRequest) (*calendarv1.DeleteCalendarResponse, error) {
+	if err := h.calendarUsecase.DeleteCalendar(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &calendarv1.DeleteCalendarResponse{}, nil
+}
+
+func (h *CalendarHandler) ShareCalendar(ctx context.Context, req *calendarv1.ShareCalendarRequest) (*calendarv1.ShareCalendarResponse, error) {
+	share, err := h.calendarUsecase.ShareCalendar(ctx, req.GetCalendarId(), req.GetUserId(), req.GetAccessLevel())
+	if err != nil {
+		return nil, err
+	}
+
+	return &calendarv1.ShareCalendarResponse{
+		Share: toShareProto(share),
+	}, nil
+}
+
+func (h *CalendarHandler) RevokeShare(ctx context.Context, req *calendarv1.RevokeShareRequest) (*calendarv1.RevokeShareResponse, error) {
+	if err := h.calendarUsecase.RevokeShare(ctx, req.GetCalendarId(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &calendarv1.RevokeShareResponse{}, nil
+}
+
+func (h *CalendarHandler) ListShares(ctx context.Context, req *calendarv1.ListSharesRequest) (*calendarv1.ListSharesResponse, error) {
+	shares, err := h.calendarUsecase.ListShares(ctx, req.GetCalendarId())
+	if err != nil {
+		return nil, err
+	}
+
+	var pbShares []*calendarv1.Share
+	for _, share := range shares {
+		pbShares = append(pbShares, toShareProto(share))
+	}
+
+	return &calendarv1.ListSharesResponse{
+		Shares: pbShares,
+	}, nil
+}
+
+func toCalendarProto(c calendar.Calendar) *calendarv1.Calendar {
+	return &calendarv1.Calendar{
+		Id:         c.ID().String(),
+		Name:       c.Name().String(),
+		Color:      c.Color().String(),
+		TimeZone:   c.TimeZone().String(),
+		IsDefault:  c.IsDefault(),
+		CreateTime: timestamppb.New(c.CreatedAt()),
+		UpdateTime: timestamppb.New(c.UpdatedAt()),
+		UserId:     c.UserID().String(),
+	}
+}
+
+func toShareProto(s calendar.Share) *calendarv1.Share {
+	return &calendarv1.Share{
+		CalendarId:  s.CalendarID().String(),
+		UserId:      s.UserID().String(),
+		AccessLevel: s.AccessLevel().String(),
+		CreateTime:  timestamppb.New(s.CreatedAt()),
+		UpdateTime:  timestamppb.New(s.UpdatedAt()),
+	}
+}
@@ -0,0 +1,386 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	calendarv1 "github.com/qkitzero/event-service/gen/go/calendar/v1"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	mocksappcalendar "github.com/qkitzero/event-service/mocks/application/calendar"
+	mockscalendar "github.com/qkitzero/event-service/mocks/domain/calendar"
+)
+
+func newMockCalendar(ctrl *gomock.Controller, name string) *mockscalendar.MockCalendar {
+	mockCalendar := mockscalendar.NewMockCalendar(ctrl)
+	mockCalendar.EXPECT().ID().Return(calendar.NewCalendarID()).AnyTimes()
+	mockCalendar.EXPECT().UserID().Return(domainuser.UserID{UUID: uuid.New()}).AnyTimes()
+	mockCalendar.EXPECT().Name().Return(calendar.Name(name)).AnyTimes()
+	mockCalendar.EXPECT().Color().Return(calendar.Color("#FFFFFF")).AnyTimes()
+	mockCalendar.EXPECT().TimeZone().Return(calendar.TimeZone("UTC")).AnyTimes()
+	mockCalendar.EXPECT().IsDefault().Return(false).AnyTimes()
+	mockCalendar.EXPECT().CreatedAt().Return(time.Now()).AnyTimes()
+	mockCalendar.EXPECT().UpdatedAt().Return(time.Now()).AnyTimes()
+	return mockCalendar
+}
+
+func newMockShare(ctrl *gomock.Controller, accessLevel calendar.AccessLevel) *mockscalendar.MockShare {
+	mockShare := mockscalendar.NewMockShare(ctrl)
+	mockShare.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+	mockShare.EXPECT().UserID().Return(domainuser.UserID{UUID: uuid.New()}).AnyTimes()
+	mockShare.EXPECT().AccessLevel().Return(accessLevel).AnyTimes()
+	mockShare.EXPECT().CreatedAt().Return(time.Now()).AnyTimes()
+	mockShare.EXPECT().UpdatedAt().Return(time.Now()).AnyTimes()
+	return mockShare
+}
+
+func TestCreateCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		success           bool
+		ctx               context.Context
+		calendarName      string
+		color             *string
+		timeZone          string
+		createCalendarErr error
+	}{
+		{"success create calendar", true, context.Background(), "Work", func(s string) *string { return &s }("#FF0000"), "Asia/Tokyo", nil},
+		{"success create calendar without color", true, context.Background(), "Work", nil, "", nil},
+		{"failure create calendar error", false, context.Background(), "Work", nil, "", fmt.Errorf("create calendar error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			color := ""
+			if tt.color != nil {
+				color = *tt.color
+			}
+
+			mockCalendarUsecase := mocksappcalendar.NewMockCalendarUsecase(ctrl)
+			mockCalendar := newMockCalendar(ctrl, tt.calendarName)
+			mockCalendarUsecase.EXPECT().CreateCalendar(tt.ctx, tt.calendarName, color, tt.timeZone).Return(mockCalendar, tt.createCalendarErr).AnyTimes()
+
+			calendarHandler := NewCalendarHandler(mockCalendarUsecase)
+
+			req := &calendarv1.CreateCalendarRequest{
+				Name:     tt.calendarName,
+				Color:    tt.color,
+				TimeZone: tt.timeZone,
+			}
+
+			res, err := calendarHandler.CreateCalendar(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && res.GetCalendar().GetName() != tt.calendarName {
+				t.Errorf("Name = %v, want %v", res.GetCalendar().GetName(), tt.calendarName)
+			}
+		})
+	}
+}
+
+func TestGetCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		success        bool
+		ctx            context.Context
+		id             string
+		getCalendarErr error
+	}{
+		{"success get calendar", true, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", nil},
+		{"failure get calendar error", false, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", fmt.Errorf("get calendar error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendarUsecase := mocksappcalendar.NewMockCalendarUsecase(ctrl)
+			mockCalendar := newMockCalendar(ctrl, "Work")
+			mockCalendarUsecase.EXPECT().GetCalendar(tt.ctx, tt.id).Return(mockCalendar, tt.getCalendarErr).AnyTimes()
+
+			calendarHandler := NewCalendarHandler(mockCalendarUsecase)
+
+			_, err := calendarHandler.GetCalendar(tt.ctx, &calendarv1.GetCalendarRequest{Id: tt.id})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestListCalendars(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		listCalendarsErr error
+	}{
+		{"success list calendars", true, context.Background(), nil},
+		{"failure list calendars error", false, context.Background(), fmt.Errorf("list calendars error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendarUsecase := mocksappcalendar.NewMockCalendarUsecase(ctrl)
+			calendars := []calendar.Calendar{newMockCalendar(ctrl, "Default"), newMockCalendar(ctrl, "Work")}
+			mockCalendarUsecase.EXPECT().ListCalendars(tt.ctx).Return(calendars, tt.listCalendarsErr).AnyTimes()
+
+			calendarHandler := NewCalendarHandler(mockCalendarUsecase)
+
+			res, err := calendarHandler.ListCalendars(tt.ctx, &calendarv1.ListCalendarsRequest{})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(res.GetCalendars()) != len(calendars) {
+				t.Errorf("len(Calendars) = %v, want %v", len(res.GetCalendars()), len(calendars))
+			}
+		})
+	}
+}
+
+func TestUpdateCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		success           bool
+		ctx               context.Context
+		id                string
+		calendarName      string
+		color             string
+		timeZone          string
+		updateMask        []string
+		updateCalendarErr error
+	}{
+		{"success update calendar", true, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "#FF0000", "Asia/Tokyo", nil, nil},
+		{"success update calendar with update mask", true, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "", "", []string{"name"}, nil},
+		{"failure update calendar error", false, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "Work", "", "", nil, fmt.Errorf("update calendar error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendarUsecase := mocksappcalendar.NewMockCalendarUsecase(ctrl)
+			mockCalendar := newMockCalendar(ctrl, tt.calendarName)
+			mockCalendarUsecase.EXPECT().UpdateCalendar(tt.ctx, tt.id, tt.calendarName, tt.color, tt.timeZone, tt.updateMask).Return(mockCalendar, tt.updateCalendarErr).AnyTimes()
+
+			calendarHandler := NewCalendarHandler(mockCalendarUsecase)
+
+			req := &calendarv1.UpdateCalendarRequest{
+				Calendar: &calendarv1.Calendar{
+					Id:       tt.id,
+					Name:     tt.calendarName,
+					Color:    tt.color,
+					TimeZone: tt.timeZone,
+				},
+			}
+			if tt.updateMask != nil {
+				req.UpdateMask = &fieldmaskpb.FieldMask{Paths: tt.updateMask}
+			}
+
+			_, err := calendarHandler.UpdateCalendar(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestDeleteCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		success           bool
+		ctx               context.Context
+		id                string
+		deleteCalendarErr error
+	}{
+		{"success delete calendar", true, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", nil},
+		{"failure delete calendar error", false, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", fmt.Errorf("delete calendar error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendarUsecase := mocksappcalendar.NewMockCalendarUsecase(ctrl)
+			mockCalendarUsecase.EXPECT().DeleteCalendar(tt.ctx, tt.id).Return(tt.deleteCalendarErr).AnyTimes()
+
+			calendarHandler := NewCalendarHandler(mockCalendarUsecase)
+
+			_, err := calendarHandler.DeleteCalendar(tt.ctx, &calendarv1.DeleteCalendarRequest{Id: tt.id})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestShareCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		calendarID       string
+		userID           string
+		accessLevel      string
+		shareCalendarErr error
+	}{
+		{"success share calendar", true, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", "read", nil},
+		{"failure share calendar error", false, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", "read", fmt.Errorf("share calendar error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendarUsecase := mocksappcalendar.NewMockCalendarUsecase(ctrl)
+			mockShare := newMockShare(ctrl, calendar.AccessLevel(tt.accessLevel))
+			mockCalendarUsecase.EXPECT().ShareCalendar(tt.ctx, tt.calendarID, tt.userID, tt.accessLevel).Return(mockShare, tt.shareCalendarErr).AnyTimes()
+
+			calendarHandler := NewCalendarHandler(mockCalendarUsecase)
+
+			req := &calendarv1.ShareCalendarRequest{
+				CalendarId:  tt.calendarID,
+				UserId:      tt.userID,
+				AccessLevel: tt.accessLevel,
+			}
+
+			res, err := calendarHandler.ShareCalendar(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && res.GetShare().GetAccessLevel() != tt.accessLevel {
+				t.Errorf("AccessLevel = %v, want %v", res.GetShare().GetAccessLevel(), tt.accessLevel)
+			}
+		})
+	}
+}
+
+func TestRevokeShare(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		success        bool
+		ctx            context.Context
+		calendarID     string
+		userID         string
+		revokeShareErr error
+	}{
+		{"success revoke share", true, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", nil},
+		{"failure revoke share error", false, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", "00000000-0000-0000-0000-000000000001", fmt.Errorf("revoke share error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendarUsecase := mocksappcalendar.NewMockCalendarUsecase(ctrl)
+			mockCalendarUsecase.EXPECT().RevokeShare(tt.ctx, tt.calendarID, tt.userID).Return(tt.revokeShareErr).AnyTimes()
+
+			calendarHandler := NewCalendarHandler(mockCalendarUsecase)
+
+			_, err := calendarHandler.RevokeShare(tt.ctx, &calendarv1.RevokeShareRequest{CalendarId: tt.calendarID, UserId: tt.userID})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestListShares(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		success       bool
+		ctx           context.Context
+		calendarID    string
+		listSharesErr error
+	}{
+		{"success list shares", true, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", nil},
+		{"failure list shares error", false, context.Background(), "0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e", fmt.Errorf("list shares error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendarUsecase := mocksappcalendar.NewMockCalendarUsecase(ctrl)
+			shares := []calendar.Share{newMockShare(ctrl, calendar.AccessLevelRead), newMockShare(ctrl, calendar.AccessLevelWrite)}
+			mockCalendarUsecase.EXPECT().ListShares(tt.ctx, tt.calendarID).Return(shares, tt.listSharesErr).AnyTimes()
+
+			calendarHandler := NewCalendarHandler(mockCalendarUsecase)
+
+			res, err := calendarHandler.ListShares(tt.ctx, &calendarv1.ListSharesRequest{CalendarId: tt.calendarID})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(res.GetShares()) != len(shares) {
+				t.Errorf("len(Shares) = %v, want %v", len(res.GetShares()), len(shares))
+			}
+		})
+	}
+}
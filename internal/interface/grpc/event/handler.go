@@ -2,56 +2,85 @@ package event
 
 import (
 	"context"
+	"time"
 
 	eventv1 "github.com/qkitzero/event-service/gen/go/event/v1"
 	appevent "github.com/qkitzero/event-service/internal/application/event"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/user"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/genproto/googleapis/type/date"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type EventHandler struct {
 	eventv1.UnimplementedEventServiceServer
 	eventUsecase appevent.EventUsecase
+	eventWatcher appevent.EventWatcher
 }
 
-func NewEventHandler(eventUsecase appevent.EventUsecase) *EventHandler {
+func NewEventHandler(eventUsecase appevent.EventUsecase, eventWatcher appevent.EventWatcher) *EventHandler {
 	return &EventHandler{
 		eventUsecase: eventUsecase,
+		eventWatcher: eventWatcher,
 	}
 }
 
 func (h *EventHandler) CreateEvent(ctx context.Context, req *eventv1.CreateEventRequest) (*eventv1.CreateEventResponse, error) {
-	event, err := h.eventUsecase.CreateEvent(ctx, req.GetTitle(), req.GetDescription(), req.GetStartTime(), req.GetEndTime(), req.GetColor())
+	event, conflicts, err := h.eventUsecase.CreateEvent(ctx, appevent.CreateEventInput{
+		CalendarID:    req.GetCalendarId(),
+		Title:         req.GetTitle(),
+		Description:   req.GetDescription(),
+		StartTime:     req.GetStartTime(),
+		EndTime:       req.GetEndTime(),
+		AllDay:        req.GetAllDay(),
+		StartDate:     req.GetStartDate(),
+		EndDate:       req.GetEndDate(),
+		TimeZone:      req.GetTimeZone(),
+		Color:         req.GetColor(),
+		Recurrence:    req.GetRecurrence(),
+		Invitees:      toInvitees(req.GetAttendees()),
+		Reminders:     toReminders(req.GetReminders()),
+		ConflictCheck: toConflictCheck(req.GetCheckConflicts(), req.GetRejectOnConflict()),
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &eventv1.CreateEventResponse{
-		Event: &eventv1.Event{
-			Id:          event.ID().String(),
-			Title:       event.Title().String(),
-			Description: event.Description().String(),
-			StartTime:   timestamppb.New(event.StartTime()),
-			EndTime:     timestamppb.New(event.EndTime()),
-			Color:       event.Color().String(),
-		},
+		Event:     toEventProto(event),
+		Conflicts: toEventProtos(conflicts),
 	}, nil
 }
 
 func (h *EventHandler) UpdateEvent(ctx context.Context, req *eventv1.UpdateEventRequest) (*eventv1.UpdateEventResponse, error) {
-	event, err := h.eventUsecase.UpdateEvent(ctx, req.GetEvent().GetId(), req.GetEvent().GetTitle(), req.GetEvent().GetDescription(), req.GetEvent().GetStartTime(), req.GetEvent().GetEndTime(), req.GetEvent().GetColor())
+	e := req.GetEvent()
+	event, conflicts, err := h.eventUsecase.UpdateEvent(ctx, appevent.UpdateEventInput{
+		EventID:       e.GetId(),
+		CalendarID:    e.GetCalendarId(),
+		Title:         e.GetTitle(),
+		Description:   e.GetDescription(),
+		StartTime:     e.GetStartTime(),
+		EndTime:       e.GetEndTime(),
+		AllDay:        e.GetAllDay(),
+		StartDate:     e.GetStartDate(),
+		EndDate:       e.GetEndDate(),
+		TimeZone:      e.GetTimeZone(),
+		Color:         e.GetColor(),
+		Recurrence:    e.GetRecurrence(),
+		Invitees:      toInvitees(e.GetAttendees()),
+		Reminders:     toReminders(e.GetReminders()),
+		UpdateMask:    req.GetUpdateMask().GetPaths(),
+		ETag:          e.GetEtag(),
+		ConflictCheck: toConflictCheck(req.GetCheckConflicts(), req.GetRejectOnConflict()),
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &eventv1.UpdateEventResponse{
-		Event: &eventv1.Event{
-			Id:          event.ID().String(),
-			Title:       event.Title().String(),
-			Description: event.Description().String(),
-			StartTime:   timestamppb.New(event.StartTime()),
-			EndTime:     timestamppb.New(event.EndTime()),
-			Color:       event.Color().String(),
-		},
+		Event:     toEventProto(event),
+		Conflicts: toEventProtos(conflicts),
 	}, nil
 }
 
@@ -62,45 +91,303 @@ func (h *EventHandler) GetEvent(ctx context.Context, req *eventv1.GetEventReques
 	}
 
 	return &eventv1.GetEventResponse{
-		Event: &eventv1.Event{
-			Id:          event.ID().String(),
-			Title:       event.Title().String(),
-			Description: event.Description().String(),
-			StartTime:   timestamppb.New(event.StartTime()),
-			EndTime:     timestamppb.New(event.EndTime()),
-			Color:       event.Color().String(),
-		},
+		Event: toEventProto(event),
 	}, nil
 }
 
 func (h *EventHandler) ListEvents(ctx context.Context, req *eventv1.ListEventsRequest) (*eventv1.ListEventsResponse, error) {
-	events, err := h.eventUsecase.ListEvents(ctx)
+	events, nextPageToken, err := h.eventUsecase.ListEvents(ctx, req.GetCalendarIds(), req.GetStartTime(), req.GetEndTime(), req.GetPageSize(), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 
 	var pbEvents []*eventv1.Event
 	for _, event := range events {
-		pbEvent := &eventv1.Event{
-			Id:          event.ID().String(),
-			Title:       event.Title().String(),
-			Description: event.Description().String(),
-			StartTime:   timestamppb.New(event.StartTime()),
-			EndTime:     timestamppb.New(event.EndTime()),
-			Color:       event.Color().String(),
-		}
-		pbEvents = append(pbEvents, pbEvent)
+		pbEvents = append(pbEvents, toEventProto(event))
 	}
 
 	return &eventv1.ListEventsResponse{
-		Events: pbEvents,
+		Events:        pbEvents,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (h *EventHandler) DeleteEvent(ctx context.Context, req *eventv1.DeleteEventRequest) (*eventv1.DeleteEventResponse, error) {
-	if err := h.eventUsecase.DeleteEvent(ctx, req.GetId()); err != nil {
+	if err := h.eventUsecase.DeleteEvent(ctx, req.GetId(), req.GetEtag()); err != nil {
 		return nil, err
 	}
 
 	return &eventv1.DeleteEventResponse{}, nil
 }
+
+func (h *EventHandler) ListDeletedEvents(ctx context.Context, req *eventv1.ListDeletedEventsRequest) (*eventv1.ListDeletedEventsResponse, error) {
+	events, err := h.eventUsecase.ListDeletedEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbEvents []*eventv1.Event
+	for _, event := range events {
+		pbEvents = append(pbEvents, toEventProto(event))
+	}
+
+	return &eventv1.ListDeletedEventsResponse{
+		Events: pbEvents,
+	}, nil
+}
+
+func (h *EventHandler) RestoreEvent(ctx context.Context, req *eventv1.RestoreEventRequest) (*eventv1.RestoreEventResponse, error) {
+	event, err := h.eventUsecase.RestoreEvent(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventv1.RestoreEventResponse{
+		Event: toEventProto(event),
+	}, nil
+}
+
+func (h *EventHandler) RespondToEvent(ctx context.Context, req *eventv1.RespondToEventRequest) (*eventv1.RespondToEventResponse, error) {
+	event, err := h.eventUsecase.RespondToEvent(ctx, req.GetId(), req.GetResponseStatus())
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventv1.RespondToEventResponse{
+		Event: toEventProto(event),
+	}, nil
+}
+
+func (h *EventHandler) ExportEvents(ctx context.Context, req *eventv1.ExportEventsRequest) (*httpbody.HttpBody, error) {
+	data, err := h.eventUsecase.ExportEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "text/calendar; charset=utf-8",
+		Data:        data,
+	}, nil
+}
+
+func (h *EventHandler) ImportEvents(ctx context.Context, req *eventv1.ImportEventsRequest) (*eventv1.ImportEventsResponse, error) {
+	results, err := h.eventUsecase.ImportEvents(ctx, req.GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	pbResults := make([]*eventv1.ImportEventsResult, 0, len(results))
+	for _, result := range results {
+		pbResult := &eventv1.ImportEventsResult{
+			Uid:     result.UID,
+			Created: result.Created,
+		}
+		if result.Err != nil {
+			pbResult.Error = result.Err.Error()
+		} else {
+			pbResult.Event = toEventProto(result.Event)
+		}
+		pbResults = append(pbResults, pbResult)
+	}
+
+	return &eventv1.ImportEventsResponse{
+		Results: pbResults,
+	}, nil
+}
+
+func (h *EventHandler) QueryFreeBusy(ctx context.Context, req *eventv1.QueryFreeBusyRequest) (*eventv1.QueryFreeBusyResponse, error) {
+	results, err := h.eventUsecase.QueryFreeBusy(ctx, req.GetUserIds(), req.GetStartTime(), req.GetEndTime())
+	if err != nil {
+		return nil, err
+	}
+
+	pbUsers := make([]*eventv1.FreeBusy, 0, len(results))
+	for _, result := range results {
+		pbUser := &eventv1.FreeBusy{
+			UserId: result.UserID,
+		}
+		if result.Err != nil {
+			pbUser.Error = result.Err.Error()
+		}
+		for _, interval := range result.Busy {
+			pbUser.Busy = append(pbUser.Busy, &eventv1.TimeInterval{
+				StartTime: timestamppb.New(interval.Start),
+				EndTime:   timestamppb.New(interval.End),
+			})
+		}
+		pbUsers = append(pbUsers, pbUser)
+	}
+
+	return &eventv1.QueryFreeBusyResponse{
+		Users: pbUsers,
+	}, nil
+}
+
+func (h *EventHandler) CheckConflicts(ctx context.Context, req *eventv1.CheckConflictsRequest) (*eventv1.CheckConflictsResponse, error) {
+	conflicts, err := h.eventUsecase.CheckConflicts(ctx, req.GetStartTime(), req.GetEndTime(), req.GetExcludeEventId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventv1.CheckConflictsResponse{
+		Conflicts: toEventProtos(conflicts),
+	}, nil
+}
+
+func (h *EventHandler) SuggestMeetingTimes(ctx context.Context, req *eventv1.SuggestMeetingTimesRequest) (*eventv1.SuggestMeetingTimesResponse, error) {
+	constraints := req.GetConstraints()
+	slots, err := h.eventUsecase.SuggestMeetingTimes(ctx, req.GetUserIds(), req.GetDurationMinutes(), req.GetStartTime(), req.GetEndTime(), appevent.SlotConstraints{
+		WorkingHoursStart: constraints.GetWorkingHoursStart(),
+		WorkingHoursEnd:   constraints.GetWorkingHoursEnd(),
+		TimeZone:          constraints.GetTimeZone(),
+		BufferMinutes:     constraints.GetBufferMinutes(),
+		EarliestStartTime: constraints.GetEarliestStartTime(),
+		LatestEndTime:     constraints.GetLatestEndTime(),
+	}, req.GetMaxResults())
+	if err != nil {
+		return nil, err
+	}
+
+	pbSlots := make([]*eventv1.TimeInterval, 0, len(slots))
+	for _, slot := range slots {
+		pbSlots = append(pbSlots, &eventv1.TimeInterval{
+			StartTime: timestamppb.New(slot.Start),
+			EndTime:   timestamppb.New(slot.End),
+		})
+	}
+
+	return &eventv1.SuggestMeetingTimesResponse{
+		Slots: pbSlots,
+	}, nil
+}
+
+func (h *EventHandler) SyncEvents(ctx context.Context, req *eventv1.SyncEventsRequest) (*eventv1.SyncEventsResponse, error) {
+	events, deletedIDs, nextPageToken, nextSyncToken, err := h.eventUsecase.SyncEvents(ctx, req.GetSyncToken(), req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	deletedEventIDs := make([]string, 0, len(deletedIDs))
+	for _, id := range deletedIDs {
+		deletedEventIDs = append(deletedEventIDs, id.String())
+	}
+
+	return &eventv1.SyncEventsResponse{
+		Events:          toEventProtos(events),
+		DeletedEventIds: deletedEventIDs,
+		NextSyncToken:   nextSyncToken,
+		NextPageToken:   nextPageToken,
+	}, nil
+}
+
+func (h *EventHandler) WatchEvents(req *eventv1.WatchEventsRequest, stream eventv1.EventService_WatchEventsServer) error {
+	return h.eventWatcher.WatchEvents(stream.Context(), req.GetResumeToken(), func(n appevent.Notification) error {
+		return stream.Send(&eventv1.WatchEventsResponse{
+			Type:        toChangeTypeProto(n.Type),
+			Event:       toEventProto(n.Event),
+			ChangeTime:  timestamppb.New(n.OccurredAt),
+			ResumeToken: n.ResumeToken,
+		})
+	})
+}
+
+func toEventProto(e event.Event) *eventv1.Event {
+	pbEvent := &eventv1.Event{
+		Id:          e.ID().String(),
+		Title:       e.Title().String(),
+		Description: e.Description().String(),
+		StartTime:   timestamppb.New(e.StartTime()),
+		EndTime:     timestamppb.New(e.EndTime()),
+		Color:       e.Color().String(),
+		Recurrence:  e.Recurrence().Lines(),
+		Etag:        e.ETag(),
+		AllDay:      e.AllDay(),
+		TimeZone:    e.TimeZone().String(),
+		CalendarId:  e.CalendarID().String(),
+	}
+	if e.AllDay() {
+		pbEvent.StartDate = toDateProto(e.StartTime())
+		pbEvent.EndDate = toDateProto(e.EndTime())
+	}
+	if e.IsDeleted() {
+		pbEvent.DeleteTime = timestamppb.New(e.DeletedAt())
+	}
+	for _, a := range e.Attendees() {
+		pbEvent.Attendees = append(pbEvent.Attendees, toAttendeeProto(a))
+	}
+	for _, r := range e.Reminders() {
+		pbEvent.Reminders = append(pbEvent.Reminders, &eventv1.Reminder{
+			OffsetMinutes: int32(r.Offset() / time.Minute),
+			Channel:       r.Channel().String(),
+		})
+	}
+
+	return pbEvent
+}
+
+func toEventProtos(events []event.Event) []*eventv1.Event {
+	var pbEvents []*eventv1.Event
+	for _, e := range events {
+		pbEvents = append(pbEvents, toEventProto(e))
+	}
+
+	return pbEvents
+}
+
+func toAttendeeProto(a event.Attendee) *eventv1.Attendee {
+	pbAttendee := &eventv1.Attendee{
+		Email:          a.Email(),
+		Role:           a.Role().String(),
+		ResponseStatus: a.ResponseStatus().String(),
+	}
+	if a.UserID() != (user.UserID{}) {
+		pbAttendee.UserId = a.UserID().String()
+	}
+
+	return pbAttendee
+}
+
+func toInvitees(pbAttendees []*eventv1.Attendee) []appevent.Invitee {
+	var invitees []appevent.Invitee
+	for _, a := range pbAttendees {
+		invitees = append(invitees, appevent.Invitee{UserID: a.GetUserId(), Email: a.GetEmail(), Role: a.GetRole()})
+	}
+
+	return invitees
+}
+
+func toReminders(pbReminders []*eventv1.Reminder) []appevent.Reminder {
+	var reminders []appevent.Reminder
+	for _, r := range pbReminders {
+		reminders = append(reminders, appevent.Reminder{OffsetMinutes: r.GetOffsetMinutes(), Channel: r.GetChannel()})
+	}
+
+	return reminders
+}
+
+func toConflictCheck(checkConflicts, rejectOnConflict bool) appevent.ConflictCheck {
+	switch {
+	case rejectOnConflict:
+		return appevent.ConflictCheckReject
+	case checkConflicts:
+		return appevent.ConflictCheckReport
+	}
+
+	return appevent.ConflictCheckNone
+}
+
+func toChangeTypeProto(t event.ChangeType) string {
+	switch t {
+	case event.ChangeTypeCreated:
+		return "created"
+	case event.ChangeTypeUpdated:
+		return "updated"
+	case event.ChangeTypeDeleted:
+		return "deleted"
+	}
+	return ""
+}
+
+func toDateProto(t time.Time) *date.Date {
+	return &date.Date{Year: int32(t.Year()), Month: int32(t.Month()), Day: int32(t.Day())}
+}
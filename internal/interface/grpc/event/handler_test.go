@@ -3,33 +3,76 @@ package event
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	eventv1 "github.com/qkitzero/event-service/gen/go/event/v1"
+	"github.com/qkitzero/event-service/internal/application/auth"
+	appevent "github.com/qkitzero/event-service/internal/application/event"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
 	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/user"
+	mocksappcalendar "github.com/qkitzero/event-service/mocks/application/calendar"
 	mocksappevent "github.com/qkitzero/event-service/mocks/application/event"
+	mockscalendar "github.com/qkitzero/event-service/mocks/domain/calendar"
 	mocksevent "github.com/qkitzero/event-service/mocks/domain/event"
 )
 
 func TestCreateEvent(t *testing.T) {
 	t.Parallel()
+	attendee, err := event.NewAttendee(user.UserID{}, "guest@example.com", event.AttendeeRoleOptional, event.ResponseStatusNeedsAction)
+	if err != nil {
+		t.Fatalf("failed to new attendee: %v", err)
+	}
+	reminder, err := event.NewReminder(10*time.Minute, event.ReminderChannelEmail)
+	if err != nil {
+		t.Fatalf("failed to new reminder: %v", err)
+	}
 	tests := []struct {
-		name           string
-		success        bool
-		ctx            context.Context
-		title          string
-		description    string
-		startTime      *timestamppb.Timestamp
-		endTime        *timestamppb.Timestamp
-		color          *string
-		createEventErr error
+		name              string
+		success           bool
+		ctx               context.Context
+		title             string
+		description       string
+		startTime         *timestamppb.Timestamp
+		endTime           *timestamppb.Timestamp
+		allDay            bool
+		startDate         *date.Date
+		endDate           *date.Date
+		timeZone          string
+		color             *string
+		recurrence        []string
+		pbAttendees       []*eventv1.Attendee
+		invitees          []appevent.Invitee
+		attendees         []event.Attendee
+		pbReminders       []*eventv1.Reminder
+		reminders         []appevent.Reminder
+		domainReminders   []event.Reminder
+		createEventErr    error
+		checkConflicts    bool
+		rejectOnConflict  bool
+		conflictCheck     appevent.ConflictCheck
+		expectedConflicts int
 	}{
-		{"success create event", true, context.Background(), "title", "description", timestamppb.Now(), timestamppb.Now(), func(s string) *string { return &s }("#FFFFFF"), nil},
-		{"failure create event error", false, context.Background(), "title", "description", timestamppb.Now(), timestamppb.Now(), func(s string) *string { return &s }("#FFFFFF"), fmt.Errorf("create event error")},
+		{"success create event", true, context.Background(), "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "Asia/Tokyo", func(s string) *string { return &s }("#FFFFFF"), []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}, nil, nil, nil, nil, nil, nil, nil, false, false, appevent.ConflictCheckNone, 0},
+		{"success create all-day event", true, context.Background(), "title", "description", nil, nil, true, &date.Date{Year: 2025, Month: 1, Day: 6}, &date.Date{Year: 2025, Month: 1, Day: 8}, "", func(s string) *string { return &s }("#FFFFFF"), nil, nil, nil, nil, nil, nil, nil, nil, false, false, appevent.ConflictCheckNone, 0},
+		{"success create event with attendees", true, context.Background(), "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "", func(s string) *string { return &s }("#FFFFFF"), nil, []*eventv1.Attendee{{Email: "guest@example.com", Role: "optional"}}, []appevent.Invitee{{Email: "guest@example.com", Role: "optional"}}, []event.Attendee{attendee}, nil, nil, nil, nil, false, false, appevent.ConflictCheckNone, 0},
+		{"success create event with reminders", true, context.Background(), "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "", func(s string) *string { return &s }("#FFFFFF"), nil, nil, nil, nil, []*eventv1.Reminder{{OffsetMinutes: 10, Channel: "email"}}, []appevent.Reminder{{OffsetMinutes: 10, Channel: "email"}}, []event.Reminder{reminder}, nil, false, false, appevent.ConflictCheckNone, 0},
+		{"success create event reporting conflicts", true, context.Background(), "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "Asia/Tokyo", func(s string) *string { return &s }("#FFFFFF"), []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}, nil, nil, nil, nil, nil, nil, nil, true, false, appevent.ConflictCheckReport, 1},
+		{"success create event rejecting conflicts", true, context.Background(), "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "Asia/Tokyo", func(s string) *string { return &s }("#FFFFFF"), []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}, nil, nil, nil, nil, nil, nil, nil, true, true, appevent.ConflictCheckReject, 0},
+		{"failure create event error", false, context.Background(), "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "", func(s string) *string { return &s }("#FFFFFF"), nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("create event error"), false, false, appevent.ConflictCheckNone, 0},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -41,50 +84,122 @@ func TestCreateEvent(t *testing.T) {
 
 			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
 			mockEvent := mocksevent.NewMockEvent(ctrl)
-			mockEventUsecase.EXPECT().CreateEvent(tt.ctx, tt.title, tt.description, tt.startTime, tt.endTime, *tt.color).Return(mockEvent, tt.createEventErr).AnyTimes()
+			var conflicts []event.Event
+			if tt.expectedConflicts > 0 {
+				conflicts = []event.Event{mockEvent}
+			}
+			mockEventUsecase.EXPECT().CreateEvent(tt.ctx, appevent.CreateEventInput{
+				Title:         tt.title,
+				Description:   tt.description,
+				StartTime:     tt.startTime,
+				EndTime:       tt.endTime,
+				AllDay:        tt.allDay,
+				StartDate:     tt.startDate,
+				EndDate:       tt.endDate,
+				TimeZone:      tt.timeZone,
+				Color:         *tt.color,
+				Recurrence:    tt.recurrence,
+				Invitees:      tt.invitees,
+				Reminders:     tt.reminders,
+				ConflictCheck: tt.conflictCheck,
+			}).Return(mockEvent, conflicts, tt.createEventErr).AnyTimes()
 			mockEvent.EXPECT().ID().Return(event.NewEventID()).AnyTimes()
 			mockEvent.EXPECT().Title().Return(event.Title(tt.title)).AnyTimes()
 			mockEvent.EXPECT().Description().Return(event.Description(tt.description)).AnyTimes()
-			mockEvent.EXPECT().StartTime().Return(tt.startTime.AsTime()).AnyTimes()
-			mockEvent.EXPECT().EndTime().Return(tt.endTime.AsTime()).AnyTimes()
+			if tt.allDay {
+				mockEvent.EXPECT().StartTime().Return(time.Date(int(tt.startDate.Year), time.Month(tt.startDate.Month), int(tt.startDate.Day), 0, 0, 0, 0, time.UTC)).AnyTimes()
+				mockEvent.EXPECT().EndTime().Return(time.Date(int(tt.endDate.Year), time.Month(tt.endDate.Month), int(tt.endDate.Day), 0, 0, 0, 0, time.UTC)).AnyTimes()
+			} else {
+				mockEvent.EXPECT().StartTime().Return(tt.startTime.AsTime()).AnyTimes()
+				mockEvent.EXPECT().EndTime().Return(tt.endTime.AsTime()).AnyTimes()
+			}
+			mockEvent.EXPECT().AllDay().Return(tt.allDay).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(false).AnyTimes()
 			mockEvent.EXPECT().Color().Return(event.Color(*tt.color)).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ETag().Return(event.NewETag(1)).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(tt.attendees).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(tt.domainReminders).AnyTimes()
 
-			eventHandler := NewEventHandler(mockEventUsecase)
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
 
 			req := &eventv1.CreateEventRequest{
-				Title:       tt.title,
-				Description: tt.description,
-				StartTime:   tt.startTime,
-				EndTime:     tt.endTime,
-				Color:       tt.color,
+				Title:            tt.title,
+				Description:      tt.description,
+				StartTime:        tt.startTime,
+				EndTime:          tt.endTime,
+				AllDay:           tt.allDay,
+				StartDate:        tt.startDate,
+				EndDate:          tt.endDate,
+				TimeZone:         tt.timeZone,
+				Color:            tt.color,
+				Recurrence:       tt.recurrence,
+				Attendees:        tt.pbAttendees,
+				Reminders:        tt.pbReminders,
+				CheckConflicts:   tt.checkConflicts,
+				RejectOnConflict: tt.rejectOnConflict,
 			}
 
-			_, err := eventHandler.CreateEvent(tt.ctx, req)
+			res, err := eventHandler.CreateEvent(tt.ctx, req)
 			if tt.success && err != nil {
 				t.Errorf("expected no error, but got %v", err)
 			}
 			if !tt.success && err == nil {
 				t.Errorf("expected error, but got nil")
 			}
+			if tt.success && len(res.GetEvent().GetAttendees()) != len(tt.attendees) {
+				t.Errorf("len(Attendees) = %v, want %v", len(res.GetEvent().GetAttendees()), len(tt.attendees))
+			}
+			if tt.success && len(res.GetEvent().GetReminders()) != len(tt.domainReminders) {
+				t.Errorf("len(Reminders) = %v, want %v", len(res.GetEvent().GetReminders()), len(tt.domainReminders))
+			}
+			if tt.success && tt.allDay && !proto.Equal(res.GetEvent().GetEndDate(), tt.endDate) {
+				t.Errorf("EndDate = %v, want %v", res.GetEvent().GetEndDate(), tt.endDate)
+			}
+			if tt.success && len(res.GetConflicts()) != tt.expectedConflicts {
+				t.Errorf("len(Conflicts) = %v, want %v", len(res.GetConflicts()), tt.expectedConflicts)
+			}
 		})
 	}
 }
 func TestUpdateEvent(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name           string
-		success        bool
-		ctx            context.Context
-		title          string
-		id             string
-		description    string
-		startTime      *timestamppb.Timestamp
-		endTime        *timestamppb.Timestamp
-		color          string
-		updateEventErr error
+		name              string
+		success           bool
+		ctx               context.Context
+		title             string
+		id                string
+		description       string
+		startTime         *timestamppb.Timestamp
+		endTime           *timestamppb.Timestamp
+		allDay            bool
+		startDate         *date.Date
+		endDate           *date.Date
+		timeZone          string
+		color             string
+		recurrence        []string
+		pbAttendees       []*eventv1.Attendee
+		invitees          []appevent.Invitee
+		pbReminders       []*eventv1.Reminder
+		reminders         []appevent.Reminder
+		updateMask        []string
+		etag              string
+		updateEventErr    error
+		checkConflicts    bool
+		rejectOnConflict  bool
+		conflictCheck     appevent.ConflictCheck
+		expectedConflicts int
 	}{
-		{"success update event", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", nil},
-		{"failure update event error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), "#FFFFFF", fmt.Errorf("update event error")},
+		{"success update event", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "Asia/Tokyo", "#FFFFFF", []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}, nil, nil, nil, nil, nil, "", nil, false, false, appevent.ConflictCheckNone, 0},
+		{"success update event with update mask", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, nil, nil, []string{"title"}, "", nil, false, false, appevent.ConflictCheckNone, 0},
+		{"success update event attendees", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", "", nil, nil, false, nil, nil, "", "", nil, []*eventv1.Attendee{{UserId: "00000000-0000-0000-0000-000000000001"}}, []appevent.Invitee{{UserID: "00000000-0000-0000-0000-000000000001"}}, nil, nil, []string{"attendees"}, "", nil, false, false, appevent.ConflictCheckNone, 0},
+		{"success update event reminders", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", "", nil, nil, false, nil, nil, "", "", nil, nil, nil, []*eventv1.Reminder{{OffsetMinutes: 30}}, []appevent.Reminder{{OffsetMinutes: 30}}, []string{"reminders"}, "", nil, false, false, appevent.ConflictCheckNone, 0},
+		{"success update event reporting conflicts", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "Asia/Tokyo", "#FFFFFF", []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}, nil, nil, nil, nil, nil, "", nil, true, false, appevent.ConflictCheckReport, 1},
+		{"success update event rejecting conflicts", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "Asia/Tokyo", "#FFFFFF", []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}, nil, nil, nil, nil, nil, "", nil, true, true, appevent.ConflictCheckReject, 0},
+		{"failure update event error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "title", "description", timestamppb.Now(), timestamppb.Now(), false, nil, nil, "", "#FFFFFF", nil, nil, nil, nil, nil, nil, "", fmt.Errorf("update event error"), false, false, appevent.ConflictCheckNone, 0},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -96,15 +211,44 @@ func TestUpdateEvent(t *testing.T) {
 
 			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
 			mockEvent := mocksevent.NewMockEvent(ctrl)
-			mockEventUsecase.EXPECT().UpdateEvent(tt.ctx, tt.id, tt.title, tt.description, tt.startTime, tt.endTime, tt.color).Return(mockEvent, tt.updateEventErr).AnyTimes()
+			var conflicts []event.Event
+			if tt.expectedConflicts > 0 {
+				conflicts = []event.Event{mockEvent}
+			}
+			mockEventUsecase.EXPECT().UpdateEvent(tt.ctx, appevent.UpdateEventInput{
+				EventID:       tt.id,
+				Title:         tt.title,
+				Description:   tt.description,
+				StartTime:     tt.startTime,
+				EndTime:       tt.endTime,
+				AllDay:        tt.allDay,
+				StartDate:     tt.startDate,
+				EndDate:       tt.endDate,
+				TimeZone:      tt.timeZone,
+				Color:         tt.color,
+				Recurrence:    tt.recurrence,
+				Invitees:      tt.invitees,
+				Reminders:     tt.reminders,
+				UpdateMask:    tt.updateMask,
+				ETag:          tt.etag,
+				ConflictCheck: tt.conflictCheck,
+			}).Return(mockEvent, conflicts, tt.updateEventErr).AnyTimes()
 			mockEvent.EXPECT().ID().Return(event.NewEventID()).AnyTimes()
 			mockEvent.EXPECT().Title().Return(event.Title(tt.title)).AnyTimes()
 			mockEvent.EXPECT().Description().Return(event.Description(tt.description)).AnyTimes()
 			mockEvent.EXPECT().StartTime().Return(tt.startTime.AsTime()).AnyTimes()
 			mockEvent.EXPECT().EndTime().Return(tt.endTime.AsTime()).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(tt.allDay).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(false).AnyTimes()
 			mockEvent.EXPECT().Color().Return(event.Color(tt.color)).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ETag().Return(event.NewETag(1)).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
 
-			eventHandler := NewEventHandler(mockEventUsecase)
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
 
 			req := &eventv1.UpdateEventRequest{
 				Event: &eventv1.Event{
@@ -113,17 +257,31 @@ func TestUpdateEvent(t *testing.T) {
 					Description: tt.description,
 					StartTime:   tt.startTime,
 					EndTime:     tt.endTime,
+					AllDay:      tt.allDay,
+					StartDate:   tt.startDate,
+					EndDate:     tt.endDate,
+					TimeZone:    tt.timeZone,
 					Color:       tt.color,
+					Recurrence:  tt.recurrence,
+					Attendees:   tt.pbAttendees,
+					Reminders:   tt.pbReminders,
+					Etag:        tt.etag,
 				},
+				UpdateMask:       &fieldmaskpb.FieldMask{Paths: tt.updateMask},
+				CheckConflicts:   tt.checkConflicts,
+				RejectOnConflict: tt.rejectOnConflict,
 			}
 
-			_, err := eventHandler.UpdateEvent(tt.ctx, req)
+			res, err := eventHandler.UpdateEvent(tt.ctx, req)
 			if tt.success && err != nil {
 				t.Errorf("expected no error, but got %v", err)
 			}
 			if !tt.success && err == nil {
 				t.Errorf("expected error, but got nil")
 			}
+			if tt.success && len(res.GetConflicts()) != tt.expectedConflicts {
+				t.Errorf("len(Conflicts) = %v, want %v", len(res.GetConflicts()), tt.expectedConflicts)
+			}
 		})
 	}
 }
@@ -156,9 +314,17 @@ func TestGetEvent(t *testing.T) {
 			mockEvent.EXPECT().Description().Return(event.Description("description")).AnyTimes()
 			mockEvent.EXPECT().StartTime().Return(time.Now()).AnyTimes()
 			mockEvent.EXPECT().EndTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(false).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(false).AnyTimes()
 			mockEvent.EXPECT().Color().Return(event.Color("#FFFFFF")).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ETag().Return(event.NewETag(1)).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
 
-			eventHandler := NewEventHandler(mockEventUsecase)
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
 
 			req := &eventv1.GetEventRequest{
 				Id: tt.id,
@@ -181,10 +347,16 @@ func TestListEvents(t *testing.T) {
 		name          string
 		success       bool
 		ctx           context.Context
+		calendarIDs   []string
+		startTime     *timestamppb.Timestamp
+		endTime       *timestamppb.Timestamp
+		pageSize      int32
+		pageToken     string
 		listEventsErr error
 	}{
-		{"success list events", true, context.Background(), nil},
-		{"failure list events error", false, context.Background(), fmt.Errorf("list events error")},
+		{"success list events", true, context.Background(), nil, timestamppb.Now(), timestamppb.New(time.Now().Add(time.Hour)), 10, "", nil},
+		{"success list events in calendars", true, context.Background(), []string{"0b1e4d3c-5f6a-4b7c-8d9e-0f1a2b3c4d5e"}, nil, nil, 0, "", nil},
+		{"failure list events error", false, context.Background(), nil, nil, nil, 0, "", fmt.Errorf("list events error")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -196,17 +368,31 @@ func TestListEvents(t *testing.T) {
 
 			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
 			mockEvent := mocksevent.NewMockEvent(ctrl)
-			mockEventUsecase.EXPECT().ListEvents(tt.ctx).Return([]event.Event{mockEvent}, tt.listEventsErr).AnyTimes()
+			mockEventUsecase.EXPECT().ListEvents(tt.ctx, tt.calendarIDs, tt.startTime, tt.endTime, tt.pageSize, tt.pageToken).Return([]event.Event{mockEvent}, "", tt.listEventsErr).AnyTimes()
 			mockEvent.EXPECT().ID().Return(event.NewEventID()).AnyTimes()
 			mockEvent.EXPECT().Title().Return(event.Title("title")).AnyTimes()
 			mockEvent.EXPECT().Description().Return(event.Description("description")).AnyTimes()
 			mockEvent.EXPECT().StartTime().Return(time.Now()).AnyTimes()
 			mockEvent.EXPECT().EndTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(false).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(false).AnyTimes()
 			mockEvent.EXPECT().Color().Return(event.Color("#FFFFFF")).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ETag().Return(event.NewETag(1)).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
 
-			eventHandler := NewEventHandler(mockEventUsecase)
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
 
-			req := &eventv1.ListEventsRequest{}
+			req := &eventv1.ListEventsRequest{
+				CalendarIds: tt.calendarIDs,
+				StartTime:   tt.startTime,
+				EndTime:     tt.endTime,
+				PageSize:    tt.pageSize,
+				PageToken:   tt.pageToken,
+			}
 
 			_, err := eventHandler.ListEvents(tt.ctx, req)
 			if tt.success && err != nil {
@@ -226,10 +412,11 @@ func TestDeleteEvent(t *testing.T) {
 		success        bool
 		ctx            context.Context
 		id             string
+		etag           string
 		deleteEventErr error
 	}{
-		{"success delete event", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil},
-		{"failure delete event error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", fmt.Errorf("delete event error")},
+		{"success delete event", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", `"1"`, nil},
+		{"failure delete event error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", fmt.Errorf("delete event error")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -240,12 +427,13 @@ func TestDeleteEvent(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
-			mockEventUsecase.EXPECT().DeleteEvent(tt.ctx, tt.id).Return(tt.deleteEventErr).AnyTimes()
+			mockEventUsecase.EXPECT().DeleteEvent(tt.ctx, tt.id, tt.etag).Return(tt.deleteEventErr).AnyTimes()
 
-			eventHandler := NewEventHandler(mockEventUsecase)
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
 
 			req := &eventv1.DeleteEventRequest{
-				Id: tt.id,
+				Id:   tt.id,
+				Etag: tt.etag,
 			}
 
 			_, err := eventHandler.DeleteEvent(tt.ctx, req)
@@ -258,3 +446,634 @@ func TestDeleteEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestListDeletedEvents(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                 string
+		success              bool
+		ctx                  context.Context
+		listDeletedEventsErr error
+	}{
+		{"success list deleted events", true, context.Background(), nil},
+		{"failure list deleted events error", false, context.Background(), fmt.Errorf("list deleted events error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			mockEvent := mocksevent.NewMockEvent(ctrl)
+			mockEventUsecase.EXPECT().ListDeletedEvents(tt.ctx).Return([]event.Event{mockEvent}, tt.listDeletedEventsErr).AnyTimes()
+			mockEvent.EXPECT().ID().Return(event.NewEventID()).AnyTimes()
+			mockEvent.EXPECT().Title().Return(event.Title("title")).AnyTimes()
+			mockEvent.EXPECT().Description().Return(event.Description("description")).AnyTimes()
+			mockEvent.EXPECT().StartTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().EndTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(false).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(true).AnyTimes()
+			mockEvent.EXPECT().DeletedAt().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().Color().Return(event.Color("#FFFFFF")).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ETag().Return(event.NewETag(1)).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
+
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			req := &eventv1.ListDeletedEventsRequest{}
+
+			res, err := eventHandler.ListDeletedEvents(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && res.GetEvents()[0].GetDeleteTime() == nil {
+				t.Errorf("expected delete_time to be set")
+			}
+		})
+	}
+}
+
+func TestRestoreEvent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		success         bool
+		ctx             context.Context
+		id              string
+		restoreEventErr error
+	}{
+		{"success restore event", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil},
+		{"failure restore event error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", fmt.Errorf("restore event error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			mockEvent := mocksevent.NewMockEvent(ctrl)
+			mockEventUsecase.EXPECT().RestoreEvent(tt.ctx, tt.id).Return(mockEvent, tt.restoreEventErr).AnyTimes()
+			mockEvent.EXPECT().ID().Return(event.NewEventID()).AnyTimes()
+			mockEvent.EXPECT().Title().Return(event.Title("title")).AnyTimes()
+			mockEvent.EXPECT().Description().Return(event.Description("description")).AnyTimes()
+			mockEvent.EXPECT().StartTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().EndTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(false).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(false).AnyTimes()
+			mockEvent.EXPECT().Color().Return(event.Color("#FFFFFF")).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ETag().Return(event.NewETag(1)).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
+
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			req := &eventv1.RestoreEventRequest{
+				Id: tt.id,
+			}
+
+			_, err := eventHandler.RestoreEvent(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestRespondToEvent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		success           bool
+		ctx               context.Context
+		id                string
+		responseStatus    string
+		respondToEventErr error
+	}{
+		{"success respond to event", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "accepted", nil},
+		{"failure respond to event error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "accepted", fmt.Errorf("respond to event error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			mockEvent := mocksevent.NewMockEvent(ctrl)
+			mockEventUsecase.EXPECT().RespondToEvent(tt.ctx, tt.id, tt.responseStatus).Return(mockEvent, tt.respondToEventErr).AnyTimes()
+			mockEvent.EXPECT().ID().Return(event.NewEventID()).AnyTimes()
+			mockEvent.EXPECT().Title().Return(event.Title("title")).AnyTimes()
+			mockEvent.EXPECT().Description().Return(event.Description("description")).AnyTimes()
+			mockEvent.EXPECT().StartTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().EndTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(false).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(false).AnyTimes()
+			mockEvent.EXPECT().Color().Return(event.Color("#FFFFFF")).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ETag().Return(event.NewETag(1)).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
+
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			req := &eventv1.RespondToEventRequest{
+				Id:             tt.id,
+				ResponseStatus: tt.responseStatus,
+			}
+
+			_, err := eventHandler.RespondToEvent(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestExportEvents(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		success         bool
+		ctx             context.Context
+		data            []byte
+		exportEventsErr error
+	}{
+		{"success export events", true, context.Background(), []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil},
+		{"failure export events error", false, context.Background(), nil, fmt.Errorf("export events error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			mockEventUsecase.EXPECT().ExportEvents(tt.ctx).Return(tt.data, tt.exportEventsErr).AnyTimes()
+
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			req := &eventv1.ExportEventsRequest{}
+
+			res, err := eventHandler.ExportEvents(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && res.GetContentType() != "text/calendar; charset=utf-8" {
+				t.Errorf("ContentType = %v, want %v", res.GetContentType(), "text/calendar; charset=utf-8")
+			}
+		})
+	}
+}
+
+func TestImportEvents(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		success         bool
+		ctx             context.Context
+		data            string
+		itemErr         error
+		importEventsErr error
+	}{
+		{"success import events", true, context.Background(), "BEGIN:VCALENDAR", nil, nil},
+		{"success import events with item error", true, context.Background(), "BEGIN:VCALENDAR", event.ErrInvalidTitle, nil},
+		{"failure import events error", false, context.Background(), "", nil, fmt.Errorf("import events error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			mockEvent := mocksevent.NewMockEvent(ctrl)
+			result := appevent.ImportResult{UID: "a@example.com", Err: tt.itemErr}
+			if tt.itemErr == nil {
+				result.Event = mockEvent
+				result.Created = true
+			}
+			mockEventUsecase.EXPECT().ImportEvents(tt.ctx, tt.data).Return([]appevent.ImportResult{result}, tt.importEventsErr).AnyTimes()
+			mockEvent.EXPECT().ID().Return(event.NewEventID()).AnyTimes()
+			mockEvent.EXPECT().Title().Return(event.Title("title")).AnyTimes()
+			mockEvent.EXPECT().Description().Return(event.Description("description")).AnyTimes()
+			mockEvent.EXPECT().StartTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().EndTime().Return(time.Now()).AnyTimes()
+			mockEvent.EXPECT().AllDay().Return(false).AnyTimes()
+			mockEvent.EXPECT().TimeZone().Return(event.TimeZone{}).AnyTimes()
+			mockEvent.EXPECT().IsDeleted().Return(false).AnyTimes()
+			mockEvent.EXPECT().Color().Return(event.Color("#FFFFFF")).AnyTimes()
+			mockEvent.EXPECT().Recurrence().Return(event.Recurrence{}).AnyTimes()
+			mockEvent.EXPECT().ETag().Return(event.NewETag(1)).AnyTimes()
+			mockEvent.EXPECT().CalendarID().Return(calendar.NewCalendarID()).AnyTimes()
+			mockEvent.EXPECT().Attendees().Return(nil).AnyTimes()
+			mockEvent.EXPECT().Reminders().Return(nil).AnyTimes()
+
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			req := &eventv1.ImportEventsRequest{
+				Data: tt.data,
+			}
+
+			res, err := eventHandler.ImportEvents(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if !tt.success {
+				return
+			}
+			if got := res.GetResults()[0]; (got.GetError() != "") != (tt.itemErr != nil) || (got.GetEvent() != nil) == (tt.itemErr != nil) {
+				t.Errorf("ImportEvents() result = %v, want item error %v", got, tt.itemErr)
+			}
+		})
+	}
+}
+
+func TestQueryFreeBusy(t *testing.T) {
+	t.Parallel()
+	startTime := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	endTime := time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		userIDs          []string
+		resultErr        error
+		queryFreeBusyErr error
+	}{
+		{"success query free busy", true, context.Background(), []string{"00000000-0000-0000-0000-000000000001"}, nil, nil},
+		{"success query free busy with user error", true, context.Background(), []string{"00000000-0000-0000-0000-000000000001"}, calendar.ErrPermissionDenied, nil},
+		{"failure query free busy error", false, context.Background(), nil, nil, fmt.Errorf("query free busy error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			result := appevent.FreeBusy{UserID: "00000000-0000-0000-0000-000000000001", Err: tt.resultErr}
+			if tt.resultErr == nil {
+				result.Busy = []event.Interval{{Start: startTime.Add(time.Hour), End: startTime.Add(2 * time.Hour)}}
+			}
+			mockEventUsecase.EXPECT().QueryFreeBusy(tt.ctx, tt.userIDs, timestamppb.New(startTime), timestamppb.New(endTime)).Return([]appevent.FreeBusy{result}, tt.queryFreeBusyErr).AnyTimes()
+
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			req := &eventv1.QueryFreeBusyRequest{
+				UserIds:   tt.userIDs,
+				StartTime: timestamppb.New(startTime),
+				EndTime:   timestamppb.New(endTime),
+			}
+
+			res, err := eventHandler.QueryFreeBusy(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if !tt.success {
+				return
+			}
+			if got := res.GetUsers()[0]; (got.GetError() != "") != (tt.resultErr != nil) || (len(got.GetBusy()) == 0) == (tt.resultErr == nil) {
+				t.Errorf("QueryFreeBusy() user = %v, want user error %v", got, tt.resultErr)
+			}
+		})
+	}
+}
+
+func TestSuggestMeetingTimes(t *testing.T) {
+	t.Parallel()
+	startTime := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	endTime := time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                   string
+		success                bool
+		ctx                    context.Context
+		userIDs                []string
+		constraints            *eventv1.MeetingConstraints
+		expectedConstraints    appevent.SlotConstraints
+		suggestMeetingTimesErr error
+	}{
+		{"success suggest meeting times", true, context.Background(), []string{"00000000-0000-0000-0000-000000000001"}, &eventv1.MeetingConstraints{WorkingHoursStart: "09:00", WorkingHoursEnd: "17:00", TimeZone: "Asia/Tokyo", BufferMinutes: 10}, appevent.SlotConstraints{WorkingHoursStart: "09:00", WorkingHoursEnd: "17:00", TimeZone: "Asia/Tokyo", BufferMinutes: 10}, nil},
+		{"success without constraints", true, context.Background(), []string{"00000000-0000-0000-0000-000000000001"}, nil, appevent.SlotConstraints{}, nil},
+		{"failure suggest meeting times error", false, context.Background(), nil, nil, appevent.SlotConstraints{}, fmt.Errorf("suggest meeting times error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			slots := []event.Interval{{Start: startTime, End: startTime.Add(time.Hour)}}
+			mockEventUsecase.EXPECT().SuggestMeetingTimes(tt.ctx, tt.userIDs, int32(60), timestamppb.New(startTime), timestamppb.New(endTime), tt.expectedConstraints, int32(5)).Return(slots, tt.suggestMeetingTimesErr).AnyTimes()
+
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			req := &eventv1.SuggestMeetingTimesRequest{
+				UserIds:         tt.userIDs,
+				DurationMinutes: 60,
+				StartTime:       timestamppb.New(startTime),
+				EndTime:         timestamppb.New(endTime),
+				Constraints:     tt.constraints,
+				MaxResults:      5,
+			}
+
+			res, err := eventHandler.SuggestMeetingTimes(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(res.GetSlots()) != len(slots) {
+				t.Errorf("len(Slots) = %v, want %v", len(res.GetSlots()), len(slots))
+			}
+		})
+	}
+}
+
+func TestCheckConflicts(t *testing.T) {
+	t.Parallel()
+	startTime := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	endTime := time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name              string
+		success           bool
+		ctx               context.Context
+		excludeEventID    string
+		checkConflictsErr error
+	}{
+		{"success check conflicts", true, context.Background(), "", nil},
+		{"success check conflicts excluding event", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil},
+		{"failure check conflicts error", false, context.Background(), "", fmt.Errorf("check conflicts error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			conflict := event.NewEvent(event.NewEventID(), user.UserID{}, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), startTime, startTime.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+			mockEventUsecase.EXPECT().CheckConflicts(tt.ctx, timestamppb.New(startTime), timestamppb.New(endTime), tt.excludeEventID).Return([]event.Event{conflict}, tt.checkConflictsErr).AnyTimes()
+
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			req := &eventv1.CheckConflictsRequest{
+				StartTime:      timestamppb.New(startTime),
+				EndTime:        timestamppb.New(endTime),
+				ExcludeEventId: tt.excludeEventID,
+			}
+
+			res, err := eventHandler.CheckConflicts(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(res.GetConflicts()) != 1 {
+				t.Errorf("len(Conflicts) = %v, want 1", len(res.GetConflicts()))
+			}
+		})
+	}
+}
+
+func TestSyncEvents(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name            string
+		success         bool
+		ctx             context.Context
+		syncToken       string
+		syncEventsErr   error
+		expectedEvents  int
+		expectedDeleted int
+	}{
+		{"success sync events", true, context.Background(), event.NewSyncToken(now, nil).Token(), nil, 1, 1},
+		{"success full sync", true, context.Background(), "", nil, 1, 1},
+		{"failure sync events error", false, context.Background(), "", fmt.Errorf("sync events error"), 0, 0},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			changed := event.NewEvent(event.NewEventID(), user.UserID{}, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, now, now, time.Time{})
+			deletedID := event.NewEventID()
+			mockEventUsecase := mocksappevent.NewMockEventUsecase(ctrl)
+			mockEventUsecase.EXPECT().SyncEvents(tt.ctx, tt.syncToken, int32(10), "").Return([]event.Event{changed}, []event.EventID{deletedID}, "", event.NewSyncToken(now, nil).Token(), tt.syncEventsErr).AnyTimes()
+
+			eventHandler := NewEventHandler(mockEventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			res, err := eventHandler.SyncEvents(tt.ctx, &eventv1.SyncEventsRequest{SyncToken: tt.syncToken, PageSize: 10})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(res.GetEvents()) != tt.expectedEvents {
+				t.Errorf("len(Events) = %v, want %v", len(res.GetEvents()), tt.expectedEvents)
+			}
+			if len(res.GetDeletedEventIds()) != tt.expectedDeleted {
+				t.Errorf("len(DeletedEventIds) = %v, want %v", len(res.GetDeletedEventIds()), tt.expectedDeleted)
+			}
+			if tt.success && res.GetDeletedEventIds()[0] != deletedID.String() {
+				t.Errorf("DeletedEventIds[0] = %v, want %v", res.GetDeletedEventIds()[0], deletedID.String())
+			}
+			if tt.success && res.GetNextSyncToken() != event.NewSyncToken(now, nil).Token() {
+				t.Errorf("NextSyncToken = %v, want %v", res.GetNextSyncToken(), event.NewSyncToken(now, nil).Token())
+			}
+		})
+	}
+}
+
+type fakeWatchEventsServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	sendErr   error
+	responses []*eventv1.WatchEventsResponse
+}
+
+func (s *fakeWatchEventsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchEventsServer) Send(res *eventv1.WatchEventsResponse) error {
+	s.responses = append(s.responses, res)
+	return s.sendErr
+}
+
+func TestWatchEvents(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		success        bool
+		ctx            context.Context
+		resumeToken    string
+		changeType     event.ChangeType
+		expectedType   string
+		watchEventsErr error
+		sendErr        error
+	}{
+		{"success watch events", true, context.Background(), "", event.ChangeTypeCreated, "created", nil, nil},
+		{"success resume watch events", true, context.Background(), event.NewResumeToken(event.ChangePosition{TxID: 100, ChangeID: 7}, now).Token(), event.ChangeTypeDeleted, "deleted", nil, nil},
+		{"failure watch events error", false, context.Background(), "", event.ChangeTypeUpdated, "updated", fmt.Errorf("watch events error"), nil},
+		{"failure send error", false, context.Background(), "", event.ChangeTypeUpdated, "updated", nil, fmt.Errorf("send error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			changed := event.NewEvent(event.NewEventID(), user.UserID{}, calendar.NewCalendarID(), event.Title("title"), event.Description("description"), now, now.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, now, now, time.Time{})
+			mockEventWatcher := mocksappevent.NewMockEventWatcher(ctrl)
+			mockEventWatcher.EXPECT().WatchEvents(tt.ctx, tt.resumeToken, gomock.Any()).DoAndReturn(func(ctx context.Context, resumeToken string, send func(appevent.Notification) error) error {
+				if err := send(appevent.Notification{Type: tt.changeType, Event: changed, OccurredAt: now, ResumeToken: event.NewResumeToken(event.ChangePosition{TxID: 100, ChangeID: 8}, now).Token()}); err != nil {
+					return err
+				}
+				return tt.watchEventsErr
+			}).Times(1)
+
+			eventHandler := NewEventHandler(mocksappevent.NewMockEventUsecase(ctrl), mockEventWatcher)
+
+			stream := &fakeWatchEventsServer{ctx: tt.ctx, sendErr: tt.sendErr}
+			err := eventHandler.WatchEvents(&eventv1.WatchEventsRequest{ResumeToken: tt.resumeToken}, stream)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if len(stream.responses) != 1 {
+				t.Fatalf("len(responses) = %v, want 1", len(stream.responses))
+			}
+			res := stream.responses[0]
+			if res.GetType() != tt.expectedType {
+				t.Errorf("Type = %v, want %v", res.GetType(), tt.expectedType)
+			}
+			if res.GetEvent().GetId() != changed.ID().String() {
+				t.Errorf("Event.Id = %v, want %v", res.GetEvent().GetId(), changed.ID().String())
+			}
+			if res.GetResumeToken() != event.NewResumeToken(event.ChangePosition{TxID: 100, ChangeID: 8}, now).Token() {
+				t.Errorf("ResumeToken = %v, want %v", res.GetResumeToken(), event.NewResumeToken(event.ChangePosition{TxID: 100, ChangeID: 8}, now).Token())
+			}
+		})
+	}
+}
+
+// TestUpdateEventThroughGateway sends PATCH requests through the HTTP
+// gateway, which derives the update mask from the fields in the body.
+func TestUpdateEventThroughGateway(t *testing.T) {
+	t.Parallel()
+	userID := user.UserID{UUID: uuid.MustParse("6d322c66-bf4d-427a-970c-874f3745f653")}
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		success        bool
+		body           string
+		expectedStatus int
+	}{
+		{"success change to all-day dates", true, `{"allDay":true,"startDate":{"year":2025,"month":1,"day":6},"endDate":{"year":2025,"month":1,"day":7}}`, http.StatusOK},
+		{"success echo back the event read", true, `{"id":"%s","etag":"\"1\"","title":"new title","deleteTime":null}`, http.StatusOK},
+		{"failure unknown field", false, `{"title":"new title","userId":"x"}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storedCalendar := calendar.NewCalendar(calendar.NewCalendarID(), userID, calendar.Name("Default"), calendar.Color("#FFFFFF"), calendar.TimeZone("UTC"), true, time.Now(), time.Now())
+			storedEvent := event.NewEvent(event.NewEventID(), userID, storedCalendar.ID(), event.Title("title"), event.Description("description"), start, start.Add(time.Hour), false, event.TimeZone{}, event.Color("#FFFFFF"), event.Recurrence{}, nil, nil, "", 1, time.Now(), time.Now(), time.Time{})
+			mockEventRepository := mocksevent.NewMockEventRepository(ctrl)
+			mockEventRepository.EXPECT().FindByID(storedEvent.ID()).Return(storedEvent, nil).AnyTimes()
+			mockEventRepository.EXPECT().Update(gomock.Any(), int64(1)).Return(nil).AnyTimes()
+			mockPolicy := mocksappcalendar.NewMockPolicy(ctrl)
+			mockPolicy.EXPECT().Authorize(userID, storedCalendar.ID(), calendar.AccessLevelWrite).Return(storedCalendar, nil).AnyTimes()
+
+			eventUsecase := appevent.NewEventUsecase(mockEventRepository, mockscalendar.NewMockCalendarRepository(ctrl), mockPolicy, event.DefaultMaxDuration, 720*time.Hour)
+			eventHandler := NewEventHandler(eventUsecase, mocksappevent.NewMockEventWatcher(ctrl))
+
+			mux := runtime.NewServeMux()
+			if err := eventv1.RegisterEventServiceHandlerServer(context.Background(), mux, eventHandler); err != nil {
+				t.Fatalf("failed to register handler: %v", err)
+			}
+
+			body := tt.body
+			if strings.Contains(body, "%s") {
+				body = fmt.Sprintf(body, storedEvent.ID())
+			}
+			req := httptest.NewRequest(http.MethodPatch, "/v1/events/"+storedEvent.ID().String(), strings.NewReader(body))
+			req = req.WithContext(auth.NewContext(req.Context(), auth.Principal{UserID: userID.String()}))
+			rec := httptest.NewRecorder()
+
+			mux.ServeHTTP(rec, req)
+
+			if tt.success && rec.Code != http.StatusOK {
+				t.Errorf("expected no error, but got status %d: %s", rec.Code, rec.Body.String())
+			}
+			if !tt.success && rec.Code == http.StatusOK {
+				t.Errorf("expected error, but got status %d", rec.Code)
+			}
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.expectedStatus, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package feed
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	feedv1 "github.com/qkitzero/event-service/gen/go/feed/v1"
+	appfeed "github.com/qkitzero/event-service/internal/application/feed"
+)
+
+const (
+	feedContentType = "text/calendar; charset=utf-8"
+
+	// HTTPCodeHeader tells the gateway which HTTP status to respond with.
+	HTTPCodeHeader = "x-http-code"
+)
+
+type FeedHandler struct {
+	feedv1.UnimplementedFeedServiceServer
+	feedUsecase appfeed.FeedUsecase
+	baseURL     string
+}
+
+func NewFeedHandler(feedUsecase appfeed.FeedUsecase, baseURL string) *FeedHandler {
+	return &FeedHandler{
+		feedUsecase: feedUsecase,
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (h *FeedHandler) RotateFeedToken(ctx context.Context, req *feedv1.RotateFeedTokenRequest) (*feedv1.RotateFeedTokenResponse, error) {
+	token, err := h.feedUsecase.RotateFeedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feedv1.RotateFeedTokenResponse{
+		Token: token.String(),
+		Url:   h.baseURL + "/v1/feeds/" + token.String(),
+	}, nil
+}
+
+func (h *FeedHandler) GetFeed(ctx context.Context, req *feedv1.GetFeedRequest) (*httpbody.HttpBody, error) {
+	content, err := h.feedUsecase.GetFeed(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	header := metadata.Pairs(
+		"etag", content.ETag,
+		"cache-control", "private, no-cache",
+	)
+	if !content.LastModified.IsZero() {
+		header.Set("last-modified", content.LastModified.Format(http.TimeFormat))
+	}
+
+	if notModified(ctx, content) {
+		header.Set(HTTPCodeHeader, "304")
+		if err := grpc.SetHeader(ctx, header); err != nil {
+			return nil, err
+		}
+		return &httpbody.HttpBody{ContentType: feedContentType}, nil
+	}
+
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		return nil, err
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: feedContentType,
+		Data:        content.Data,
+	}, nil
+}
+
+// notModified evaluates If-None-Match, falling back to If-Modified-Since as
+// described in RFC 9110 section 13.2.2.
+func notModified(ctx context.Context, content appfeed.Content) bool {
+	if ifNoneMatch := incomingHeader(ctx, "if-none-match"); ifNoneMatch != "" {
+		for _, etag := range strings.Split(ifNoneMatch, ",") {
+			etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+			if etag == "*" || etag == content.ETag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince := incomingHeader(ctx, "if-modified-since"); ifModifiedSince != "" && !content.LastModified.IsZero() {
+		t, err := http.ParseTime(ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		return !content.LastModified.After(t.Truncate(time.Second))
+	}
+
+	return false
+}
+
+// incomingHeader returns an HTTP request header forwarded by the gateway, or
+// the plain metadata value for gRPC clients.
+func incomingHeader(ctx context.Context, name string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, key := range []string{"grpcgateway-" + name, name} {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
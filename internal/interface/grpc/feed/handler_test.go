@@ -0,0 +1,146 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	feedv1 "github.com/qkitzero/event-service/gen/go/feed/v1"
+	appfeed "github.com/qkitzero/event-service/internal/application/feed"
+	"github.com/qkitzero/event-service/internal/domain/feed"
+	mocksappfeed "github.com/qkitzero/event-service/mocks/application/feed"
+)
+
+type fakeServerTransportStream struct {
+	header metadata.MD
+}
+
+func (s *fakeServerTransportStream) Method() string {
+	return "/feed.v1.FeedService/GetFeed"
+}
+
+func (s *fakeServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *fakeServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *fakeServerTransportStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+func TestRotateFeedToken(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name               string
+		success            bool
+		ctx                context.Context
+		baseURL            string
+		token              feed.Token
+		rotateFeedTokenErr error
+		expectedURL        string
+	}{
+		{"success rotate feed token", true, context.Background(), "https://example.com/", feed.Token("token"), nil, "https://example.com/v1/feeds/token"},
+		{"success rotate feed token without base url", true, context.Background(), "", feed.Token("token"), nil, "/v1/feeds/token"},
+		{"failure rotate feed token error", false, context.Background(), "https://example.com", feed.Token(""), fmt.Errorf("rotate feed token error"), ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFeedUsecase := mocksappfeed.NewMockFeedUsecase(ctrl)
+			mockFeedUsecase.EXPECT().RotateFeedToken(tt.ctx).Return(tt.token, tt.rotateFeedTokenErr).AnyTimes()
+
+			feedHandler := NewFeedHandler(mockFeedUsecase, tt.baseURL)
+
+			res, err := feedHandler.RotateFeedToken(tt.ctx, &feedv1.RotateFeedTokenRequest{})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && res.GetUrl() != tt.expectedURL {
+				t.Errorf("Url = %v, want %v", res.GetUrl(), tt.expectedURL)
+			}
+		})
+	}
+}
+
+func TestGetFeed(t *testing.T) {
+	t.Parallel()
+	lastModified := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	content := appfeed.Content{Data: []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), ETag: `"abc"`, LastModified: lastModified}
+	tests := []struct {
+		name                string
+		success             bool
+		incoming            metadata.MD
+		getFeedErr          error
+		expectedNotModified bool
+	}{
+		{"success get feed", true, metadata.MD{}, nil, false},
+		{"success if none match", true, metadata.Pairs("grpcgateway-if-none-match", `"abc"`), nil, true},
+		{"success weak if none match", true, metadata.Pairs("grpcgateway-if-none-match", `"x", W/"abc"`), nil, true},
+		{"success if none match changed", true, metadata.Pairs("grpcgateway-if-none-match", `"old"`, "grpcgateway-if-modified-since", lastModified.Format(http.TimeFormat)), nil, false},
+		{"success if modified since", true, metadata.Pairs("grpcgateway-if-modified-since", lastModified.Format(http.TimeFormat)), nil, true},
+		{"success if modified since older", true, metadata.Pairs("if-modified-since", lastModified.Add(-time.Second).Format(http.TimeFormat)), nil, false},
+		{"failure get feed error", false, metadata.MD{}, fmt.Errorf("get feed error"), false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stream := &fakeServerTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(context.Background(), tt.incoming), stream)
+
+			mockFeedUsecase := mocksappfeed.NewMockFeedUsecase(ctrl)
+			mockFeedUsecase.EXPECT().GetFeed(ctx, "token").Return(content, tt.getFeedErr).AnyTimes()
+
+			feedHandler := NewFeedHandler(mockFeedUsecase, "")
+
+			res, err := feedHandler.GetFeed(ctx, &feedv1.GetFeedRequest{Token: "token"})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if !tt.success {
+				return
+			}
+			if got := stream.header.Get("etag"); len(got) != 1 || got[0] != content.ETag {
+				t.Errorf("etag header = %v, want %v", got, content.ETag)
+			}
+			if got := stream.header.Get("last-modified"); len(got) != 1 || got[0] != lastModified.Format(http.TimeFormat) {
+				t.Errorf("last-modified header = %v, want %v", got, lastModified.Format(http.TimeFormat))
+			}
+			notModified := len(stream.header.Get(HTTPCodeHeader)) > 0
+			if notModified != tt.expectedNotModified {
+				t.Errorf("not modified = %v, want %v", notModified, tt.expectedNotModified)
+			}
+			if notModified && len(res.GetData()) != 0 {
+				t.Errorf("Data = %q, want empty for 304", res.GetData())
+			}
+			if !notModified && string(res.GetData()) != string(content.Data) {
+				t.Errorf("Data = %q, want %q", res.GetData(), content.Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	mocksauth "github.com/qkitzero/event-service/mocks/application/auth"
+	mocksuser "github.com/qkitzero/event-service/mocks/application/user"
+)
+
+func TestAuthUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		success        bool
+		method         string
+		verifyTokenErr error
+		expectedCode   codes.Code
+	}{
+		{"success authenticated", true, "/event.v1.EventService/GetEvent", nil, codes.OK},
+		{"success public method", true, "/grpc.health.v1.Health/Check", errors.New("metadata is missing"), codes.OK},
+		{"success public feed method", true, "/feed.v1.FeedService/GetFeed", errors.New("metadata is missing"), codes.OK},
+		{"failure feed rotation requires authentication", false, "/feed.v1.FeedService/RotateFeedToken", errors.New("metadata is missing"), codes.Unauthenticated},
+		{"failure missing metadata", false, "/event.v1.EventService/GetEvent", errors.New("metadata is missing"), codes.Unauthenticated},
+		{"failure invalid token", false, "/event.v1.EventService/GetEvent", status.Error(codes.Unauthenticated, "invalid token"), codes.Unauthenticated},
+		{"failure auth service unavailable", false, "/event.v1.EventService/GetEvent", status.Error(codes.Unavailable, "unavailable"), codes.Unavailable},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuthService := mocksauth.NewMockAuthService(ctrl)
+			mockAuthService.EXPECT().VerifyToken(gomock.Any()).Return("google-oauth2|000000000000000000000", tt.verifyTokenErr).AnyTimes()
+			mockUserService := mocksuser.NewMockUserService(ctrl)
+			mockUserService.EXPECT().GetUser(gomock.Any()).Return("6d322c66-bf4d-427a-970c-874f3745f653", nil).AnyTimes()
+
+			authenticator := auth.NewAuthenticator(mockAuthService, mockUserService)
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				if isPublicMethod(tt.method) {
+					return "response", nil
+				}
+				principal, err := auth.FromContext(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return principal.UserID, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+
+			_, err := AuthUnaryServerInterceptor(authenticator)(context.Background(), "request", info, handler)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if status.Code(err) != tt.expectedCode {
+				t.Errorf("Code() = %v, want %v", status.Code(err), tt.expectedCode)
+			}
+		})
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthStreamServerInterceptor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		success        bool
+		verifyTokenErr error
+	}{
+		{"success authenticated", true, nil},
+		{"failure unauthenticated", false, errors.New("metadata is missing")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAuthService := mocksauth.NewMockAuthService(ctrl)
+			mockAuthService.EXPECT().VerifyToken(gomock.Any()).Return("google-oauth2|000000000000000000000", tt.verifyTokenErr).AnyTimes()
+			mockUserService := mocksuser.NewMockUserService(ctrl)
+			mockUserService.EXPECT().GetUser(gomock.Any()).Return("6d322c66-bf4d-427a-970c-874f3745f653", nil).AnyTimes()
+
+			authenticator := auth.NewAuthenticator(mockAuthService, mockUserService)
+
+			handler := func(srv any, ss grpc.ServerStream) error {
+				_, err := auth.FromContext(ss.Context())
+				return err
+			}
+			info := &grpc.StreamServerInfo{FullMethod: "/event.v1.EventService/WatchEvents"}
+
+			err := AuthStreamServerInterceptor(authenticator)(nil, &fakeServerStream{ctx: context.Background()}, info, handler)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
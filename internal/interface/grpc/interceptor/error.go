@@ -0,0 +1,134 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qkitzero/event-service/internal/application/auth"
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/feed"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+var fieldErrors = []struct {
+	err   error
+	field string
+}{
+	{event.ErrInvalidEventID, "id"},
+	{event.ErrInvalidTitle, "title"},
+	{event.ErrInvalidDescription, "description"},
+	{event.ErrStartTimeRequired, "start_time"},
+	{event.ErrEndTimeRequired, "end_time"},
+	{event.ErrInvalidTimeRange, "end_time"},
+	{event.ErrDurationTooLong, "end_time"},
+	{event.ErrInvalidTimeWindow, "end_time"},
+	{event.ErrStartDateRequired, "start_date"},
+	{event.ErrEndDateRequired, "end_date"},
+	{event.ErrInvalidDate, "start_date"},
+	{event.ErrInvalidAllDayRange, "all_day"},
+	{event.ErrInvalidTimeZone, "time_zone"},
+	{event.ErrInvalidColor, "color"},
+	{event.ErrInvalidRecurrence, "recurrence"},
+	{event.ErrInvalidETag, "etag"},
+	{event.ErrInvalidPageSize, "page_size"},
+	{event.ErrInvalidPageToken, "page_token"},
+	{event.ErrInvalidResumeToken, "resume_token"},
+	{event.ErrInvalidSyncToken, "sync_token"},
+	{event.ErrInvalidUpdateMask, "update_mask"},
+	{event.ErrInvalidICalendar, "data"},
+	{event.ErrInvalidAttendee, "attendees"},
+	{event.ErrDuplicateAttendee, "attendees"},
+	{event.ErrInvalidAttendeeRole, "attendees"},
+	{event.ErrInvalidResponseStatus, "response_status"},
+	{event.ErrInvalidUserIDs, "user_ids"},
+	{event.ErrInvalidDuration, "duration_minutes"},
+	{event.ErrInvalidWorkingHours, "constraints.working_hours_start"},
+	{event.ErrInvalidBuffer, "constraints.buffer_minutes"},
+	{event.ErrInvalidMaxResults, "max_results"},
+	{event.ErrInvalidReminder, "reminders"},
+	{event.ErrInvalidChannel, "reminders"},
+	{event.ErrDuplicateReminder, "reminders"},
+	{event.ErrTooManyReminders, "reminders"},
+	{feed.ErrInvalidToken, "token"},
+	{calendar.ErrInvalidCalendarID, "calendar_id"},
+	{calendar.ErrInvalidName, "name"},
+	{calendar.ErrInvalidColor, "color"},
+	{calendar.ErrInvalidTimeZone, "time_zone"},
+	{calendar.ErrInvalidUpdateMask, "update_mask"},
+	{calendar.ErrInvalidAccessLevel, "access_level"},
+	{calendar.ErrInvalidShareUser, "user_id"},
+	{webhook.ErrInvalidWebhookID, "id"},
+	{webhook.ErrInvalidURL, "url"},
+	{webhook.ErrInvalidEventType, "event_types"},
+	{webhook.ErrInvalidUpdateMask, "update_mask"},
+	{webhook.ErrInvalidPageSize, "page_size"},
+}
+
+func ErrorUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatusError(info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+func ErrorStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return toStatusError(info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+func toStatusError(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, event.ErrEventNotFound), errors.Is(err, feed.ErrFeedNotFound), errors.Is(err, calendar.ErrCalendarNotFound), errors.Is(err, calendar.ErrShareNotFound), errors.Is(err, webhook.ErrWebhookNotFound), errors.Is(err, webhook.ErrDeliveryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, event.ErrPermissionDenied), errors.Is(err, event.ErrNotOrganizer), errors.Is(err, event.ErrNotAttendee), errors.Is(err, calendar.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, event.ErrETagMismatch), errors.Is(err, event.ErrConflictingEvents), errors.Is(err, event.ErrSyncTokenExpired), errors.Is(err, event.ErrResumeTokenExpired), errors.Is(err, event.ErrICalUIDConflict), errors.Is(err, calendar.ErrDefaultCalendarDelete):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, event.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	for _, fe := range fieldErrors {
+		if !errors.Is(err, fe.err) {
+			continue
+		}
+
+		st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: fe.field, Description: err.Error()},
+			},
+		})
+		if detailErr != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return st.Err()
+	}
+
+	log.Printf("%s: %v", method, err)
+
+	return status.Error(codes.Internal, "internal error")
+}
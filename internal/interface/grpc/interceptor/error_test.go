@@ -0,0 +1,163 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/qkitzero/event-service/internal/domain/calendar"
+	"github.com/qkitzero/event-service/internal/domain/event"
+	"github.com/qkitzero/event-service/internal/domain/feed"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+func TestErrorUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		success       bool
+		handlerErr    error
+		expectedCode  codes.Code
+		expectedField string
+	}{
+		{"success no error", true, nil, codes.OK, ""},
+		{"failure event not found", false, event.ErrEventNotFound, codes.NotFound, ""},
+		{"failure feed not found", false, feed.ErrFeedNotFound, codes.NotFound, ""},
+		{"failure invalid feed token", false, feed.ErrInvalidToken, codes.InvalidArgument, "token"},
+		{"failure calendar not found", false, calendar.ErrCalendarNotFound, codes.NotFound, ""},
+		{"failure default calendar delete", false, calendar.ErrDefaultCalendarDelete, codes.FailedPrecondition, ""},
+		{"failure invalid calendar id", false, calendar.ErrInvalidCalendarID, codes.InvalidArgument, "calendar_id"},
+		{"failure share not found", false, calendar.ErrShareNotFound, codes.NotFound, ""},
+		{"failure invalid access level", false, calendar.ErrInvalidAccessLevel, codes.InvalidArgument, "access_level"},
+		{"failure invalid share user", false, calendar.ErrInvalidShareUser, codes.InvalidArgument, "user_id"},
+		{"failure webhook not found", false, webhook.ErrWebhookNotFound, codes.NotFound, ""},
+		{"failure webhook delivery not found", false, webhook.ErrDeliveryNotFound, codes.NotFound, ""},
+		{"failure invalid webhook url", false, webhook.ErrInvalidURL, codes.InvalidArgument, "url"},
+		{"failure invalid webhook event type", false, webhook.ErrInvalidEventType, codes.InvalidArgument, "event_types"},
+		{"failure permission denied", false, event.ErrPermissionDenied, codes.PermissionDenied, ""},
+		{"failure etag mismatch", false, event.ErrETagMismatch, codes.FailedPrecondition, ""},
+		{"failure conflicting events", false, event.ErrConflictingEvents, codes.FailedPrecondition, ""},
+		{"failure version conflict", false, event.ErrVersionConflict, codes.Aborted, ""},
+		{"failure invalid etag", false, event.ErrInvalidETag, codes.InvalidArgument, "etag"},
+		{"failure invalid title", false, event.ErrInvalidTitle, codes.InvalidArgument, "title"},
+		{"failure invalid event id", false, fmt.Errorf("%w: invalid UUID length: 0", event.ErrInvalidEventID), codes.InvalidArgument, "id"},
+		{"failure invalid recurrence", false, fmt.Errorf("%w: RRULE requires FREQ", event.ErrInvalidRecurrence), codes.InvalidArgument, "recurrence"},
+		{"failure start time required", false, event.ErrStartTimeRequired, codes.InvalidArgument, "start_time"},
+		{"failure invalid page token", false, event.ErrInvalidPageToken, codes.InvalidArgument, "page_token"},
+		{"failure invalid resume token", false, event.ErrInvalidResumeToken, codes.InvalidArgument, "resume_token"},
+		{"failure invalid sync token", false, event.ErrInvalidSyncToken, codes.InvalidArgument, "sync_token"},
+		{"failure sync token expired", false, event.ErrSyncTokenExpired, codes.FailedPrecondition, ""},
+		{"failure resume token expired", false, event.ErrResumeTokenExpired, codes.FailedPrecondition, ""},
+		{"failure ical uid conflict", false, event.ErrICalUIDConflict, codes.FailedPrecondition, ""},
+		{"failure invalid icalendar", false, fmt.Errorf("%w: missing VCALENDAR", event.ErrInvalidICalendar), codes.InvalidArgument, "data"},
+		{"failure not organizer", false, event.ErrNotOrganizer, codes.PermissionDenied, ""},
+		{"failure not attendee", false, event.ErrNotAttendee, codes.PermissionDenied, ""},
+		{"failure duplicate attendee", false, event.ErrDuplicateAttendee, codes.InvalidArgument, "attendees"},
+		{"failure invalid response status", false, event.ErrInvalidResponseStatus, codes.InvalidArgument, "response_status"},
+		{"failure invalid user ids", false, fmt.Errorf("%w: invalid UUID length: 7", event.ErrInvalidUserIDs), codes.InvalidArgument, "user_ids"},
+		{"failure invalid duration", false, event.ErrInvalidDuration, codes.InvalidArgument, "duration_minutes"},
+		{"failure invalid working hours", false, event.ErrInvalidWorkingHours, codes.InvalidArgument, "constraints.working_hours_start"},
+		{"failure invalid buffer", false, event.ErrInvalidBuffer, codes.InvalidArgument, "constraints.buffer_minutes"},
+		{"failure invalid max results", false, event.ErrInvalidMaxResults, codes.InvalidArgument, "max_results"},
+		{"failure invalid reminder", false, event.ErrInvalidReminder, codes.InvalidArgument, "reminders"},
+		{"failure invalid reminder channel", false, event.ErrInvalidChannel, codes.InvalidArgument, "reminders"},
+		{"failure duplicate reminder", false, event.ErrDuplicateReminder, codes.InvalidArgument, "reminders"},
+		{"failure too many reminders", false, event.ErrTooManyReminders, codes.InvalidArgument, "reminders"},
+		{"failure status error", false, status.Error(codes.Unauthenticated, "unauthenticated"), codes.Unauthenticated, ""},
+		{"failure deadline exceeded", false, context.DeadlineExceeded, codes.DeadlineExceeded, ""},
+		{"failure internal error", false, errors.New("connection refused"), codes.Internal, ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				return "response", tt.handlerErr
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/event.v1.EventService/GetEvent"}
+
+			_, err := ErrorUnaryServerInterceptor()(context.Background(), "request", info, handler)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			st := status.Convert(err)
+			if st.Code() != tt.expectedCode {
+				t.Errorf("Code() = %v, want %v", st.Code(), tt.expectedCode)
+			}
+			if tt.expectedCode == codes.Internal && st.Message() != "internal error" {
+				t.Errorf("Message() = %v, want %v", st.Message(), "internal error")
+			}
+
+			var field string
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					field = badRequest.GetFieldViolations()[0].GetField()
+				}
+			}
+			if field != tt.expectedField {
+				t.Errorf("field = %v, want %v", field, tt.expectedField)
+			}
+		})
+	}
+}
+
+func TestErrorStreamServerInterceptor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		success       bool
+		handlerErr    error
+		expectedCode  codes.Code
+		expectedField string
+	}{
+		{"success no error", true, nil, codes.OK, ""},
+		{"failure invalid resume token", false, event.ErrInvalidResumeToken, codes.InvalidArgument, "resume_token"},
+		{"failure canceled", false, context.Canceled, codes.Canceled, ""},
+		{"failure internal error", false, errors.New("connection refused"), codes.Internal, ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(srv any, ss grpc.ServerStream) error {
+				return tt.handlerErr
+			}
+			info := &grpc.StreamServerInfo{FullMethod: "/event.v1.EventService/WatchEvents"}
+
+			err := ErrorStreamServerInterceptor()(nil, &fakeServerStream{ctx: context.Background()}, info, handler)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+
+			st := status.Convert(err)
+			if st.Code() != tt.expectedCode {
+				t.Errorf("Code() = %v, want %v", st.Code(), tt.expectedCode)
+			}
+
+			var field string
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					field = badRequest.GetFieldViolations()[0].GetField()
+				}
+			}
+			if field != tt.expectedField {
+				t.Errorf("field = %v, want %v", field, tt.expectedField)
+			}
+		})
+	}
+}
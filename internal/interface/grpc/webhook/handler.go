@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	webhookv1 "github.com/qkitzero/event-service/gen/go/webhook/v1"
+	appwebhook "github.com/qkitzero/event-service/internal/application/webhook"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+)
+
+type WebhookHandler struct {
+	webhookv1.UnimplementedWebhookServiceServer
+	webhookUsecase appwebhook.WebhookUsecase
+}
+
+func NewWebhookHandler(webhookUsecase appwebhook.WebhookUsecase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUsecase: webhookUsecase,
+	}
+}
+
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, req *webhookv1.CreateWebhookRequest) (*webhookv1.CreateWebhookResponse, error) {
+	webhook, err := h.webhookUsecase.CreateWebhook(ctx, req.GetUrl(), req.GetEventTypes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookv1.CreateWebhookResponse{
+		Webhook: toWebhookProto(webhook),
+		Secret:  webhook.Secret().String(),
+	}, nil
+}
+
+func (h *WebhookHandler) GetWebhook(ctx context.Context, req *webhookv1.GetWebhookRequest) (*webhookv1.GetWebhookResponse, error) {
+	webhook, err := h.webhookUsecase.GetWebhook(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookv1.GetWebhookResponse{
+		Webhook: toWebhookProto(webhook),
+	}, nil
+}
+
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, req *webhookv1.ListWebhooksRequest) (*webhookv1.ListWebhooksResponse, error) {
+	webhooks, err := h.webhookUsecase.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbWebhooks []*webhookv1.Webhook
+	for _, webhook := range webhooks {
+		pbWebhooks = append(pbWebhooks, toWebhookProto(webhook))
+	}
+
+	return &webhookv1.ListWebhooksResponse{
+		Webhooks: pbWebhooks,
+	}, nil
+}
+
+func (h *WebhookHandler) UpdateWebhook(ctx context.Context, req *webhookv1.UpdateWebhookRequest) (*webhookv1.UpdateWebhookResponse, error) {
+	webhook, err := h.webhookUsecase.UpdateWebhook(ctx, req.GetWebhook().GetId(), req.GetWebhook().GetUrl(), req.GetWebhook().GetEventTypes(), req.GetWebhook().GetEnabled(), req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookv1.UpdateWebhookResponse{
+		Webhook: toWebhookProto(webhook),
+	}, nil
+}
+
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, req *webhookv1.DeleteWebhookRequest) (*webhookv1.DeleteWebhookResponse, error) {
+	if err := h.webhookUsecase.DeleteWebhook(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &webhookv1.DeleteWebhookResponse{}, nil
+}
+
+func (h *WebhookHandler) ListWebhookDeliveries(ctx context.Context, req *webhookv1.ListWebhookDeliveriesRequest) (*webhookv1.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := h.webhookUsecase.ListDeliveries(ctx, req.GetWebhookId(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	var pbDeliveries []*webhookv1.Delivery
+	for _, delivery := range deliveries {
+		pbDeliveries = append(pbDeliveries, toDeliveryProto(delivery))
+	}
+
+	return &webhookv1.ListWebhookDeliveriesResponse{
+		Deliveries: pbDeliveries,
+	}, nil
+}
+
+func toWebhookProto(w webhook.Webhook) *webhookv1.Webhook {
+	eventTypes := make([]string, 0, len(w.EventTypes()))
+	for _, t := range w.EventTypes() {
+		eventTypes = append(eventTypes, t.String())
+	}
+
+	return &webhookv1.Webhook{
+		Id:                  w.ID().String(),
+		Url:                 w.URL().String(),
+		EventTypes:          eventTypes,
+		Enabled:             w.Enabled(),
+		ConsecutiveFailures: int32(w.ConsecutiveFailures()),
+		CreateTime:          timestamppb.New(w.CreatedAt()),
+		UpdateTime:          timestamppb.New(w.UpdatedAt()),
+	}
+}
+
+func toDeliveryProto(d webhook.Delivery) *webhookv1.Delivery {
+	attempts := make([]*webhookv1.DeliveryAttempt, 0, len(d.Attempts()))
+	for _, a := range d.Attempts() {
+		attempts = append(attempts, &webhookv1.DeliveryAttempt{
+			StatusCode:  int32(a.StatusCode()),
+			Error:       a.Error(),
+			AttemptTime: timestamppb.New(a.AttemptedAt()),
+		})
+	}
+
+	pbDelivery := &webhookv1.Delivery{
+		Id:         d.ID().String(),
+		WebhookId:  d.WebhookID().String(),
+		EventType:  d.EventType().String(),
+		Status:     d.Status().String(),
+		Attempts:   attempts,
+		CreateTime: timestamppb.New(d.CreatedAt()),
+	}
+	if !d.NextAttemptAt().IsZero() {
+		pbDelivery.NextAttemptTime = timestamppb.New(d.NextAttemptAt())
+	}
+
+	return pbDelivery
+}
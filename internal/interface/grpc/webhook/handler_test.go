@@ -0,0 +1,309 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	webhookv1 "github.com/qkitzero/event-service/gen/go/webhook/v1"
+	domainuser "github.com/qkitzero/event-service/internal/domain/user"
+	"github.com/qkitzero/event-service/internal/domain/webhook"
+	mocksappwebhook "github.com/qkitzero/event-service/mocks/application/webhook"
+	mockswebhook "github.com/qkitzero/event-service/mocks/domain/webhook"
+)
+
+func newMockWebhook(ctrl *gomock.Controller, url string) *mockswebhook.MockWebhook {
+	mockWebhook := mockswebhook.NewMockWebhook(ctrl)
+	mockWebhook.EXPECT().ID().Return(webhook.NewWebhookID()).AnyTimes()
+	mockWebhook.EXPECT().UserID().Return(domainuser.UserID{UUID: uuid.New()}).AnyTimes()
+	mockWebhook.EXPECT().URL().Return(webhook.URL(url)).AnyTimes()
+	mockWebhook.EXPECT().EventTypes().Return([]webhook.EventType{webhook.EventTypeCreated}).AnyTimes()
+	mockWebhook.EXPECT().Secret().Return(webhook.Secret("secret")).AnyTimes()
+	mockWebhook.EXPECT().Enabled().Return(true).AnyTimes()
+	mockWebhook.EXPECT().ConsecutiveFailures().Return(0).AnyTimes()
+	mockWebhook.EXPECT().CreatedAt().Return(time.Now()).AnyTimes()
+	mockWebhook.EXPECT().UpdatedAt().Return(time.Now()).AnyTimes()
+	return mockWebhook
+}
+
+func newMockDelivery(ctrl *gomock.Controller, status webhook.DeliveryStatus, nextAttemptAt time.Time) *mockswebhook.MockDelivery {
+	mockDelivery := mockswebhook.NewMockDelivery(ctrl)
+	mockDelivery.EXPECT().ID().Return(webhook.NewDeliveryID()).AnyTimes()
+	mockDelivery.EXPECT().WebhookID().Return(webhook.NewWebhookID()).AnyTimes()
+	mockDelivery.EXPECT().EventType().Return(webhook.EventTypeCreated).AnyTimes()
+	mockDelivery.EXPECT().Status().Return(status).AnyTimes()
+	mockDelivery.EXPECT().Attempts().Return([]webhook.Attempt{webhook.NewAttempt(500, "", time.Now())}).AnyTimes()
+	mockDelivery.EXPECT().NextAttemptAt().Return(nextAttemptAt).AnyTimes()
+	mockDelivery.EXPECT().CreatedAt().Return(time.Now()).AnyTimes()
+	return mockDelivery
+}
+
+func TestCreateWebhook(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		url              string
+		eventTypes       []string
+		createWebhookErr error
+	}{
+		{"success create webhook", true, context.Background(), "https://example.com/hooks", []string{"event.created"}, nil},
+		{"failure create webhook error", false, context.Background(), "https://example.com/hooks", nil, fmt.Errorf("create webhook error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookUsecase := mocksappwebhook.NewMockWebhookUsecase(ctrl)
+			mockWebhook := newMockWebhook(ctrl, tt.url)
+			mockWebhookUsecase.EXPECT().CreateWebhook(tt.ctx, tt.url, tt.eventTypes).Return(mockWebhook, tt.createWebhookErr).AnyTimes()
+
+			webhookHandler := NewWebhookHandler(mockWebhookUsecase)
+
+			req := &webhookv1.CreateWebhookRequest{
+				Url:        tt.url,
+				EventTypes: tt.eventTypes,
+			}
+
+			res, err := webhookHandler.CreateWebhook(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && res.GetWebhook().GetUrl() != tt.url {
+				t.Errorf("Url = %v, want %v", res.GetWebhook().GetUrl(), tt.url)
+			}
+			if tt.success && res.GetSecret() != "secret" {
+				t.Errorf("Secret = %v, want %v", res.GetSecret(), "secret")
+			}
+		})
+	}
+}
+
+func TestGetWebhook(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		success       bool
+		ctx           context.Context
+		id            string
+		getWebhookErr error
+	}{
+		{"success get webhook", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil},
+		{"failure get webhook error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", fmt.Errorf("get webhook error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookUsecase := mocksappwebhook.NewMockWebhookUsecase(ctrl)
+			mockWebhook := newMockWebhook(ctrl, "https://example.com/hooks")
+			mockWebhookUsecase.EXPECT().GetWebhook(tt.ctx, tt.id).Return(mockWebhook, tt.getWebhookErr).AnyTimes()
+
+			webhookHandler := NewWebhookHandler(mockWebhookUsecase)
+
+			_, err := webhookHandler.GetWebhook(tt.ctx, &webhookv1.GetWebhookRequest{Id: tt.id})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		success         bool
+		ctx             context.Context
+		listWebhooksErr error
+	}{
+		{"success list webhooks", true, context.Background(), nil},
+		{"failure list webhooks error", false, context.Background(), fmt.Errorf("list webhooks error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookUsecase := mocksappwebhook.NewMockWebhookUsecase(ctrl)
+			webhooks := []webhook.Webhook{newMockWebhook(ctrl, "https://example.com/hooks"), newMockWebhook(ctrl, "https://example.com/other")}
+			mockWebhookUsecase.EXPECT().ListWebhooks(tt.ctx).Return(webhooks, tt.listWebhooksErr).AnyTimes()
+
+			webhookHandler := NewWebhookHandler(mockWebhookUsecase)
+
+			res, err := webhookHandler.ListWebhooks(tt.ctx, &webhookv1.ListWebhooksRequest{})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(res.GetWebhooks()) != len(webhooks) {
+				t.Errorf("len(Webhooks) = %v, want %v", len(res.GetWebhooks()), len(webhooks))
+			}
+		})
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		id               string
+		url              string
+		eventTypes       []string
+		enabled          bool
+		updateMask       []string
+		updateWebhookErr error
+	}{
+		{"success update webhook", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "https://example.com/hooks", []string{"event.deleted"}, true, nil, nil},
+		{"success update webhook with update mask", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "", nil, true, []string{"enabled"}, nil},
+		{"failure update webhook error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", "https://example.com/hooks", nil, false, nil, fmt.Errorf("update webhook error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookUsecase := mocksappwebhook.NewMockWebhookUsecase(ctrl)
+			mockWebhook := newMockWebhook(ctrl, tt.url)
+			mockWebhookUsecase.EXPECT().UpdateWebhook(tt.ctx, tt.id, tt.url, tt.eventTypes, tt.enabled, tt.updateMask).Return(mockWebhook, tt.updateWebhookErr).AnyTimes()
+
+			webhookHandler := NewWebhookHandler(mockWebhookUsecase)
+
+			req := &webhookv1.UpdateWebhookRequest{
+				Webhook: &webhookv1.Webhook{
+					Id:         tt.id,
+					Url:        tt.url,
+					EventTypes: tt.eventTypes,
+					Enabled:    tt.enabled,
+				},
+			}
+			if tt.updateMask != nil {
+				req.UpdateMask = &fieldmaskpb.FieldMask{Paths: tt.updateMask}
+			}
+
+			_, err := webhookHandler.UpdateWebhook(tt.ctx, req)
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		success          bool
+		ctx              context.Context
+		id               string
+		deleteWebhookErr error
+	}{
+		{"success delete webhook", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", nil},
+		{"failure delete webhook error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", fmt.Errorf("delete webhook error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookUsecase := mocksappwebhook.NewMockWebhookUsecase(ctrl)
+			mockWebhookUsecase.EXPECT().DeleteWebhook(tt.ctx, tt.id).Return(tt.deleteWebhookErr).AnyTimes()
+
+			webhookHandler := NewWebhookHandler(mockWebhookUsecase)
+
+			_, err := webhookHandler.DeleteWebhook(tt.ctx, &webhookv1.DeleteWebhookRequest{Id: tt.id})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+		})
+	}
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		success           bool
+		ctx               context.Context
+		webhookID         string
+		pageSize          int32
+		listDeliveriesErr error
+	}{
+		{"success list webhook deliveries", true, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", 10, nil},
+		{"failure list webhook deliveries error", false, context.Background(), "fe8c2263-bbac-4bb9-a41d-b04f5afc4425", 0, fmt.Errorf("list webhook deliveries error")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWebhookUsecase := mocksappwebhook.NewMockWebhookUsecase(ctrl)
+			deliveries := []webhook.Delivery{
+				newMockDelivery(ctrl, webhook.DeliveryStatusPending, time.Now()),
+				newMockDelivery(ctrl, webhook.DeliveryStatusFailed, time.Time{}),
+			}
+			mockWebhookUsecase.EXPECT().ListDeliveries(tt.ctx, tt.webhookID, tt.pageSize).Return(deliveries, tt.listDeliveriesErr).AnyTimes()
+
+			webhookHandler := NewWebhookHandler(mockWebhookUsecase)
+
+			res, err := webhookHandler.ListWebhookDeliveries(tt.ctx, &webhookv1.ListWebhookDeliveriesRequest{WebhookId: tt.webhookID, PageSize: tt.pageSize})
+			if tt.success && err != nil {
+				t.Errorf("expected no error, but got %v", err)
+			}
+			if !tt.success && err == nil {
+				t.Errorf("expected error, but got nil")
+			}
+			if tt.success && len(res.GetDeliveries()) != len(deliveries) {
+				t.Errorf("len(Deliveries) = %v, want %v", len(res.GetDeliveries()), len(deliveries))
+			}
+			if tt.success && res.GetDeliveries()[0].GetNextAttemptTime() == nil {
+				t.Errorf("expected next attempt time of pending delivery, but got nil")
+			}
+			if tt.success && res.GetDeliveries()[1].GetNextAttemptTime() != nil {
+				t.Errorf("NextAttemptTime = %v, want nil", res.GetDeliveries()[1].GetNextAttemptTime())
+			}
+		})
+	}
+}
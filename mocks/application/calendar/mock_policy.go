@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/calendar/policy.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/application/calendar/policy.go -destination=mocks/application/calendar/mock_policy.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	calendar "github.com/qkitzero/event-service/internal/domain/calendar"
+	user "github.com/qkitzero/event-service/internal/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPolicy is a mock of Policy interface.
+type MockPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyMockRecorder is the mock recorder for MockPolicy.
+type MockPolicyMockRecorder struct {
+	mock *MockPolicy
+}
+
+// NewMockPolicy creates a new mock instance.
+func NewMockPolicy(ctrl *gomock.Controller) *MockPolicy {
+	mock := &MockPolicy{ctrl: ctrl}
+	mock.recorder = &MockPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicy) EXPECT() *MockPolicyMockRecorder {
+	return m.recorder
+}
+
+// AccessibleCalendarIDs mocks base method.
+func (m *MockPolicy) AccessibleCalendarIDs(userID user.UserID, required calendar.AccessLevel) ([]calendar.CalendarID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccessibleCalendarIDs", userID, required)
+	ret0, _ := ret[0].([]calendar.CalendarID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccessibleCalendarIDs indicates an expected call of AccessibleCalendarIDs.
+func (mr *MockPolicyMockRecorder) AccessibleCalendarIDs(userID, required any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessibleCalendarIDs", reflect.TypeOf((*MockPolicy)(nil).AccessibleCalendarIDs), userID, required)
+}
+
+// Authorize mocks base method.
+func (m *MockPolicy) Authorize(userID user.UserID, calendarID calendar.CalendarID, required calendar.AccessLevel) (calendar.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", userID, calendarID, required)
+	ret0, _ := ret[0].(calendar.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPolicyMockRecorder) Authorize(userID, calendarID, required any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPolicy)(nil).Authorize), userID, calendarID, required)
+}
+
+// VisibleCalendarIDs mocks base method.
+func (m *MockPolicy) VisibleCalendarIDs(viewerID, ownerID user.UserID, required calendar.AccessLevel) ([]calendar.CalendarID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VisibleCalendarIDs", viewerID, ownerID, required)
+	ret0, _ := ret[0].([]calendar.CalendarID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VisibleCalendarIDs indicates an expected call of VisibleCalendarIDs.
+func (mr *MockPolicyMockRecorder) VisibleCalendarIDs(viewerID, ownerID, required any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VisibleCalendarIDs", reflect.TypeOf((*MockPolicy)(nil).VisibleCalendarIDs), viewerID, ownerID, required)
+}
@@ -13,7 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 
-	event "github.com/qkitzero/event-service/internal/domain/event"
+	event "github.com/qkitzero/event-service/internal/application/event"
+	event0 "github.com/qkitzero/event-service/internal/domain/event"
 	gomock "go.uber.org/mock/gomock"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -42,40 +43,71 @@ func (m *MockEventUsecase) EXPECT() *MockEventUsecaseMockRecorder {
 	return m.recorder
 }
 
-// CreateEvent mocks base method.
-func (m *MockEventUsecase) CreateEvent(ctx context.Context, title, description string, startTime, endTime *timestamppb.Timestamp, color string) (event.Event, error) {
+// CheckConflicts mocks base method.
+func (m *MockEventUsecase) CheckConflicts(ctx context.Context, startTime, endTime *timestamppb.Timestamp, excludeEventID string) ([]event0.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEvent", ctx, title, description, startTime, endTime, color)
-	ret0, _ := ret[0].(event.Event)
+	ret := m.ctrl.Call(m, "CheckConflicts", ctx, startTime, endTime, excludeEventID)
+	ret0, _ := ret[0].([]event0.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// CheckConflicts indicates an expected call of CheckConflicts.
+func (mr *MockEventUsecaseMockRecorder) CheckConflicts(ctx, startTime, endTime, excludeEventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckConflicts", reflect.TypeOf((*MockEventUsecase)(nil).CheckConflicts), ctx, startTime, endTime, excludeEventID)
+}
+
+// CreateEvent mocks base method.
+func (m *MockEventUsecase) CreateEvent(ctx context.Context, input event.CreateEventInput) (event0.Event, []event0.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, input)
+	ret0, _ := ret[0].(event0.Event)
+	ret1, _ := ret[1].([]event0.Event)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // CreateEvent indicates an expected call of CreateEvent.
-func (mr *MockEventUsecaseMockRecorder) CreateEvent(ctx, title, description, startTime, endTime, color any) *gomock.Call {
+func (mr *MockEventUsecaseMockRecorder) CreateEvent(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockEventUsecase)(nil).CreateEvent), ctx, title, description, startTime, endTime, color)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockEventUsecase)(nil).CreateEvent), ctx, input)
 }
 
 // DeleteEvent mocks base method.
-func (m *MockEventUsecase) DeleteEvent(ctx context.Context, eventID string) error {
+func (m *MockEventUsecase) DeleteEvent(ctx context.Context, eventID, etag string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEvent", ctx, eventID)
+	ret := m.ctrl.Call(m, "DeleteEvent", ctx, eventID, etag)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteEvent indicates an expected call of DeleteEvent.
-func (mr *MockEventUsecaseMockRecorder) DeleteEvent(ctx, eventID any) *gomock.Call {
+func (mr *MockEventUsecaseMockRecorder) DeleteEvent(ctx, eventID, etag any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockEventUsecase)(nil).DeleteEvent), ctx, eventID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockEventUsecase)(nil).DeleteEvent), ctx, eventID, etag)
+}
+
+// ExportEvents mocks base method.
+func (m *MockEventUsecase) ExportEvents(ctx context.Context) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEvents", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEvents indicates an expected call of ExportEvents.
+func (mr *MockEventUsecaseMockRecorder) ExportEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEvents", reflect.TypeOf((*MockEventUsecase)(nil).ExportEvents), ctx)
 }
 
 // GetEvent mocks base method.
-func (m *MockEventUsecase) GetEvent(ctx context.Context, eventID string) (event.Event, error) {
+func (m *MockEventUsecase) GetEvent(ctx context.Context, eventID string) (event0.Event, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEvent", ctx, eventID)
-	ret0, _ := ret[0].(event.Event)
+	ret0, _ := ret[0].(event0.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -86,32 +118,188 @@ func (mr *MockEventUsecaseMockRecorder) GetEvent(ctx, eventID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockEventUsecase)(nil).GetEvent), ctx, eventID)
 }
 
-// ListEvents mocks base method.
-func (m *MockEventUsecase) ListEvents(ctx context.Context) ([]event.Event, error) {
+// GetEventByICalUID mocks base method.
+func (m *MockEventUsecase) GetEventByICalUID(ctx context.Context, icalUID string) (event0.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventByICalUID", ctx, icalUID)
+	ret0, _ := ret[0].(event0.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventByICalUID indicates an expected call of GetEventByICalUID.
+func (mr *MockEventUsecaseMockRecorder) GetEventByICalUID(ctx, icalUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByICalUID", reflect.TypeOf((*MockEventUsecase)(nil).GetEventByICalUID), ctx, icalUID)
+}
+
+// ImportEvents mocks base method.
+func (m *MockEventUsecase) ImportEvents(ctx context.Context, data string) ([]event.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEvents", ctx, data)
+	ret0, _ := ret[0].([]event.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEvents indicates an expected call of ImportEvents.
+func (mr *MockEventUsecaseMockRecorder) ImportEvents(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEvents", reflect.TypeOf((*MockEventUsecase)(nil).ImportEvents), ctx, data)
+}
+
+// ListDeletedEvents mocks base method.
+func (m *MockEventUsecase) ListDeletedEvents(ctx context.Context) ([]event0.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEvents", ctx)
-	ret0, _ := ret[0].([]event.Event)
+	ret := m.ctrl.Call(m, "ListDeletedEvents", ctx)
+	ret0, _ := ret[0].([]event0.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// ListDeletedEvents indicates an expected call of ListDeletedEvents.
+func (mr *MockEventUsecaseMockRecorder) ListDeletedEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedEvents", reflect.TypeOf((*MockEventUsecase)(nil).ListDeletedEvents), ctx)
+}
+
+// ListEvents mocks base method.
+func (m *MockEventUsecase) ListEvents(ctx context.Context, calendarIDs []string, startTime, endTime *timestamppb.Timestamp, pageSize int32, pageToken string) ([]event0.Event, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, calendarIDs, startTime, endTime, pageSize, pageToken)
+	ret0, _ := ret[0].([]event0.Event)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // ListEvents indicates an expected call of ListEvents.
-func (mr *MockEventUsecaseMockRecorder) ListEvents(ctx any) *gomock.Call {
+func (mr *MockEventUsecaseMockRecorder) ListEvents(ctx, calendarIDs, startTime, endTime, pageSize, pageToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventUsecase)(nil).ListEvents), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventUsecase)(nil).ListEvents), ctx, calendarIDs, startTime, endTime, pageSize, pageToken)
 }
 
-// UpdateEvent mocks base method.
-func (m *MockEventUsecase) UpdateEvent(ctx context.Context, eventID, title, description string, startTime, endTime *timestamppb.Timestamp, color string) (event.Event, error) {
+// ListICalendarEvents mocks base method.
+func (m *MockEventUsecase) ListICalendarEvents(ctx context.Context, startTime, endTime *timestamppb.Timestamp) ([]event0.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListICalendarEvents", ctx, startTime, endTime)
+	ret0, _ := ret[0].([]event0.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListICalendarEvents indicates an expected call of ListICalendarEvents.
+func (mr *MockEventUsecaseMockRecorder) ListICalendarEvents(ctx, startTime, endTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListICalendarEvents", reflect.TypeOf((*MockEventUsecase)(nil).ListICalendarEvents), ctx, startTime, endTime)
+}
+
+// QueryFreeBusy mocks base method.
+func (m *MockEventUsecase) QueryFreeBusy(ctx context.Context, userIDs []string, startTime, endTime *timestamppb.Timestamp) ([]event.FreeBusy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryFreeBusy", ctx, userIDs, startTime, endTime)
+	ret0, _ := ret[0].([]event.FreeBusy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryFreeBusy indicates an expected call of QueryFreeBusy.
+func (mr *MockEventUsecaseMockRecorder) QueryFreeBusy(ctx, userIDs, startTime, endTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFreeBusy", reflect.TypeOf((*MockEventUsecase)(nil).QueryFreeBusy), ctx, userIDs, startTime, endTime)
+}
+
+// RespondToEvent mocks base method.
+func (m *MockEventUsecase) RespondToEvent(ctx context.Context, eventID, responseStatus string) (event0.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondToEvent", ctx, eventID, responseStatus)
+	ret0, _ := ret[0].(event0.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RespondToEvent indicates an expected call of RespondToEvent.
+func (mr *MockEventUsecaseMockRecorder) RespondToEvent(ctx, eventID, responseStatus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondToEvent", reflect.TypeOf((*MockEventUsecase)(nil).RespondToEvent), ctx, eventID, responseStatus)
+}
+
+// RestoreEvent mocks base method.
+func (m *MockEventUsecase) RestoreEvent(ctx context.Context, eventID string) (event0.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEvent", ctx, eventID)
+	ret0, _ := ret[0].(event0.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreEvent indicates an expected call of RestoreEvent.
+func (mr *MockEventUsecaseMockRecorder) RestoreEvent(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEvent", reflect.TypeOf((*MockEventUsecase)(nil).RestoreEvent), ctx, eventID)
+}
+
+// SaveICalendarEvent mocks base method.
+func (m *MockEventUsecase) SaveICalendarEvent(ctx context.Context, icalUID, data, etag string) (event0.Event, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveICalendarEvent", ctx, icalUID, data, etag)
+	ret0, _ := ret[0].(event0.Event)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SaveICalendarEvent indicates an expected call of SaveICalendarEvent.
+func (mr *MockEventUsecaseMockRecorder) SaveICalendarEvent(ctx, icalUID, data, etag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveICalendarEvent", reflect.TypeOf((*MockEventUsecase)(nil).SaveICalendarEvent), ctx, icalUID, data, etag)
+}
+
+// SuggestMeetingTimes mocks base method.
+func (m *MockEventUsecase) SuggestMeetingTimes(ctx context.Context, userIDs []string, durationMinutes int32, startTime, endTime *timestamppb.Timestamp, constraints event.SlotConstraints, maxResults int32) ([]event0.Interval, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEvent", ctx, eventID, title, description, startTime, endTime, color)
-	ret0, _ := ret[0].(event.Event)
+	ret := m.ctrl.Call(m, "SuggestMeetingTimes", ctx, userIDs, durationMinutes, startTime, endTime, constraints, maxResults)
+	ret0, _ := ret[0].([]event0.Interval)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// SuggestMeetingTimes indicates an expected call of SuggestMeetingTimes.
+func (mr *MockEventUsecaseMockRecorder) SuggestMeetingTimes(ctx, userIDs, durationMinutes, startTime, endTime, constraints, maxResults any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestMeetingTimes", reflect.TypeOf((*MockEventUsecase)(nil).SuggestMeetingTimes), ctx, userIDs, durationMinutes, startTime, endTime, constraints, maxResults)
+}
+
+// SyncEvents mocks base method.
+func (m *MockEventUsecase) SyncEvents(ctx context.Context, syncToken string, pageSize int32, pageToken string) ([]event0.Event, []event0.EventID, string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncEvents", ctx, syncToken, pageSize, pageToken)
+	ret0, _ := ret[0].([]event0.Event)
+	ret1, _ := ret[1].([]event0.EventID)
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(string)
+	ret4, _ := ret[4].(error)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// SyncEvents indicates an expected call of SyncEvents.
+func (mr *MockEventUsecaseMockRecorder) SyncEvents(ctx, syncToken, pageSize, pageToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncEvents", reflect.TypeOf((*MockEventUsecase)(nil).SyncEvents), ctx, syncToken, pageSize, pageToken)
+}
+
+// UpdateEvent mocks base method.
+func (m *MockEventUsecase) UpdateEvent(ctx context.Context, input event.UpdateEventInput) (event0.Event, []event0.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvent", ctx, input)
+	ret0, _ := ret[0].(event0.Event)
+	ret1, _ := ret[1].([]event0.Event)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // UpdateEvent indicates an expected call of UpdateEvent.
-func (mr *MockEventUsecaseMockRecorder) UpdateEvent(ctx, eventID, title, description, startTime, endTime, color any) *gomock.Call {
+func (mr *MockEventUsecaseMockRecorder) UpdateEvent(ctx, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventUsecase)(nil).UpdateEvent), ctx, eventID, title, description, startTime, endTime, color)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventUsecase)(nil).UpdateEvent), ctx, input)
 }
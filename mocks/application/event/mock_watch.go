@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/event/watch.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/application/event/watch.go -destination=mocks/application/event/mock_watch.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	event "github.com/qkitzero/event-service/internal/application/event"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventWatcher is a mock of EventWatcher interface.
+type MockEventWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventWatcherMockRecorder
+	isgomock struct{}
+}
+
+// MockEventWatcherMockRecorder is the mock recorder for MockEventWatcher.
+type MockEventWatcherMockRecorder struct {
+	mock *MockEventWatcher
+}
+
+// NewMockEventWatcher creates a new mock instance.
+func NewMockEventWatcher(ctrl *gomock.Controller) *MockEventWatcher {
+	mock := &MockEventWatcher{ctrl: ctrl}
+	mock.recorder = &MockEventWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventWatcher) EXPECT() *MockEventWatcherMockRecorder {
+	return m.recorder
+}
+
+// WatchEvents mocks base method.
+func (m *MockEventWatcher) WatchEvents(ctx context.Context, resumeToken string, send func(event.Notification) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEvents", ctx, resumeToken, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchEvents indicates an expected call of WatchEvents.
+func (mr *MockEventWatcherMockRecorder) WatchEvents(ctx, resumeToken, send any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEvents", reflect.TypeOf((*MockEventWatcher)(nil).WatchEvents), ctx, resumeToken, send)
+}
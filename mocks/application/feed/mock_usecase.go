@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/application/feed/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/application/feed/usecase.go -destination=mocks/application/feed/mock_usecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	feed "github.com/qkitzero/event-service/internal/application/feed"
+	feed0 "github.com/qkitzero/event-service/internal/domain/feed"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedUsecase is a mock of FeedUsecase interface.
+type MockFeedUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedUsecaseMockRecorder is the mock recorder for MockFeedUsecase.
+type MockFeedUsecaseMockRecorder struct {
+	mock *MockFeedUsecase
+}
+
+// NewMockFeedUsecase creates a new mock instance.
+func NewMockFeedUsecase(ctrl *gomock.Controller) *MockFeedUsecase {
+	mock := &MockFeedUsecase{ctrl: ctrl}
+	mock.recorder = &MockFeedUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedUsecase) EXPECT() *MockFeedUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetFeed mocks base method.
+func (m *MockFeedUsecase) GetFeed(ctx context.Context, token string) (feed.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, token)
+	ret0, _ := ret[0].(feed.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockFeedUsecaseMockRecorder) GetFeed(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockFeedUsecase)(nil).GetFeed), ctx, token)
+}
+
+// RotateFeedToken mocks base method.
+func (m *MockFeedUsecase) RotateFeedToken(ctx context.Context) (feed0.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateFeedToken", ctx)
+	ret0, _ := ret[0].(feed0.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateFeedToken indicates an expected call of RotateFeedToken.
+func (mr *MockFeedUsecaseMockRecorder) RotateFeedToken(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateFeedToken", reflect.TypeOf((*MockFeedUsecase)(nil).RotateFeedToken), ctx)
+}
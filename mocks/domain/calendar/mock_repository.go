@@ -0,0 +1,226 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/calendar/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/calendar/repository.go -destination=mocks/domain/calendar/mock_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	calendar "github.com/qkitzero/event-service/internal/domain/calendar"
+	user "github.com/qkitzero/event-service/internal/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCalendarRepository is a mock of CalendarRepository interface.
+type MockCalendarRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCalendarRepositoryMockRecorder is the mock recorder for MockCalendarRepository.
+type MockCalendarRepositoryMockRecorder struct {
+	mock *MockCalendarRepository
+}
+
+// NewMockCalendarRepository creates a new mock instance.
+func NewMockCalendarRepository(ctrl *gomock.Controller) *MockCalendarRepository {
+	mock := &MockCalendarRepository{ctrl: ctrl}
+	mock.recorder = &MockCalendarRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarRepository) EXPECT() *MockCalendarRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCalendarRepository) Create(arg0 calendar.Calendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCalendarRepositoryMockRecorder) Create(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCalendarRepository)(nil).Create), arg0)
+}
+
+// Delete mocks base method.
+func (m *MockCalendarRepository) Delete(id calendar.CalendarID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCalendarRepositoryMockRecorder) Delete(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCalendarRepository)(nil).Delete), id)
+}
+
+// FindAllByUserID mocks base method.
+func (m *MockCalendarRepository) FindAllByUserID(userID user.UserID) ([]calendar.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByUserID", userID)
+	ret0, _ := ret[0].([]calendar.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByUserID indicates an expected call of FindAllByUserID.
+func (mr *MockCalendarRepositoryMockRecorder) FindAllByUserID(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockCalendarRepository)(nil).FindAllByUserID), userID)
+}
+
+// FindByID mocks base method.
+func (m *MockCalendarRepository) FindByID(id calendar.CalendarID) (calendar.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", id)
+	ret0, _ := ret[0].(calendar.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCalendarRepositoryMockRecorder) FindByID(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCalendarRepository)(nil).FindByID), id)
+}
+
+// FindDefaultByUserID mocks base method.
+func (m *MockCalendarRepository) FindDefaultByUserID(userID user.UserID) (calendar.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDefaultByUserID", userID)
+	ret0, _ := ret[0].(calendar.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDefaultByUserID indicates an expected call of FindDefaultByUserID.
+func (mr *MockCalendarRepositoryMockRecorder) FindDefaultByUserID(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefaultByUserID", reflect.TypeOf((*MockCalendarRepository)(nil).FindDefaultByUserID), userID)
+}
+
+// Update mocks base method.
+func (m *MockCalendarRepository) Update(arg0 calendar.Calendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCalendarRepositoryMockRecorder) Update(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCalendarRepository)(nil).Update), arg0)
+}
+
+// MockShareRepository is a mock of ShareRepository interface.
+type MockShareRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockShareRepositoryMockRecorder is the mock recorder for MockShareRepository.
+type MockShareRepositoryMockRecorder struct {
+	mock *MockShareRepository
+}
+
+// NewMockShareRepository creates a new mock instance.
+func NewMockShareRepository(ctrl *gomock.Controller) *MockShareRepository {
+	mock := &MockShareRepository{ctrl: ctrl}
+	mock.recorder = &MockShareRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareRepository) EXPECT() *MockShareRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockShareRepository) Delete(calendarID calendar.CalendarID, userID user.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", calendarID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockShareRepositoryMockRecorder) Delete(calendarID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockShareRepository)(nil).Delete), calendarID, userID)
+}
+
+// FindAllByCalendarID mocks base method.
+func (m *MockShareRepository) FindAllByCalendarID(calendarID calendar.CalendarID) ([]calendar.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByCalendarID", calendarID)
+	ret0, _ := ret[0].([]calendar.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByCalendarID indicates an expected call of FindAllByCalendarID.
+func (mr *MockShareRepositoryMockRecorder) FindAllByCalendarID(calendarID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByCalendarID", reflect.TypeOf((*MockShareRepository)(nil).FindAllByCalendarID), calendarID)
+}
+
+// FindAllByUserID mocks base method.
+func (m *MockShareRepository) FindAllByUserID(userID user.UserID) ([]calendar.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByUserID", userID)
+	ret0, _ := ret[0].([]calendar.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByUserID indicates an expected call of FindAllByUserID.
+func (mr *MockShareRepositoryMockRecorder) FindAllByUserID(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockShareRepository)(nil).FindAllByUserID), userID)
+}
+
+// FindByCalendarIDAndUserID mocks base method.
+func (m *MockShareRepository) FindByCalendarIDAndUserID(calendarID calendar.CalendarID, userID user.UserID) (calendar.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCalendarIDAndUserID", calendarID, userID)
+	ret0, _ := ret[0].(calendar.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCalendarIDAndUserID indicates an expected call of FindByCalendarIDAndUserID.
+func (mr *MockShareRepositoryMockRecorder) FindByCalendarIDAndUserID(calendarID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCalendarIDAndUserID", reflect.TypeOf((*MockShareRepository)(nil).FindByCalendarIDAndUserID), calendarID, userID)
+}
+
+// Save mocks base method.
+func (m *MockShareRepository) Save(share calendar.Share) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", share)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockShareRepositoryMockRecorder) Save(share any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockShareRepository)(nil).Save), share)
+}
@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	calendar "github.com/qkitzero/event-service/internal/domain/calendar"
 	event "github.com/qkitzero/event-service/internal/domain/event"
 	user "github.com/qkitzero/event-service/internal/domain/user"
 	gomock "go.uber.org/mock/gomock"
@@ -42,6 +43,62 @@ func (m *MockEvent) EXPECT() *MockEventMockRecorder {
 	return m.recorder
 }
 
+// AllDay mocks base method.
+func (m *MockEvent) AllDay() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllDay")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AllDay indicates an expected call of AllDay.
+func (mr *MockEventMockRecorder) AllDay() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllDay", reflect.TypeOf((*MockEvent)(nil).AllDay))
+}
+
+// Attendees mocks base method.
+func (m *MockEvent) Attendees() []event.Attendee {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attendees")
+	ret0, _ := ret[0].([]event.Attendee)
+	return ret0
+}
+
+// Attendees indicates an expected call of Attendees.
+func (mr *MockEventMockRecorder) Attendees() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attendees", reflect.TypeOf((*MockEvent)(nil).Attendees))
+}
+
+// AuthorizeEdit mocks base method.
+func (m *MockEvent) AuthorizeEdit(editor user.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeEdit", editor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthorizeEdit indicates an expected call of AuthorizeEdit.
+func (mr *MockEventMockRecorder) AuthorizeEdit(editor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeEdit", reflect.TypeOf((*MockEvent)(nil).AuthorizeEdit), editor)
+}
+
+// CalendarID mocks base method.
+func (m *MockEvent) CalendarID() calendar.CalendarID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalendarID")
+	ret0, _ := ret[0].(calendar.CalendarID)
+	return ret0
+}
+
+// CalendarID indicates an expected call of CalendarID.
+func (mr *MockEventMockRecorder) CalendarID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalendarID", reflect.TypeOf((*MockEvent)(nil).CalendarID))
+}
+
 // Color mocks base method.
 func (m *MockEvent) Color() event.Color {
 	m.ctrl.T.Helper()
@@ -70,6 +127,20 @@ func (mr *MockEventMockRecorder) CreatedAt() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatedAt", reflect.TypeOf((*MockEvent)(nil).CreatedAt))
 }
 
+// DeletedAt mocks base method.
+func (m *MockEvent) DeletedAt() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletedAt")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// DeletedAt indicates an expected call of DeletedAt.
+func (mr *MockEventMockRecorder) DeletedAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletedAt", reflect.TypeOf((*MockEvent)(nil).DeletedAt))
+}
+
 // Description mocks base method.
 func (m *MockEvent) Description() event.Description {
 	m.ctrl.T.Helper()
@@ -84,6 +155,20 @@ func (mr *MockEventMockRecorder) Description() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Description", reflect.TypeOf((*MockEvent)(nil).Description))
 }
 
+// ETag mocks base method.
+func (m *MockEvent) ETag() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ETag")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ETag indicates an expected call of ETag.
+func (mr *MockEventMockRecorder) ETag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ETag", reflect.TypeOf((*MockEvent)(nil).ETag))
+}
+
 // EndTime mocks base method.
 func (m *MockEvent) EndTime() time.Time {
 	m.ctrl.T.Helper()
@@ -98,6 +183,20 @@ func (mr *MockEventMockRecorder) EndTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndTime", reflect.TypeOf((*MockEvent)(nil).EndTime))
 }
 
+// ICalUID mocks base method.
+func (m *MockEvent) ICalUID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ICalUID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ICalUID indicates an expected call of ICalUID.
+func (mr *MockEventMockRecorder) ICalUID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ICalUID", reflect.TypeOf((*MockEvent)(nil).ICalUID))
+}
+
 // ID mocks base method.
 func (m *MockEvent) ID() event.EventID {
 	m.ctrl.T.Helper()
@@ -112,6 +211,119 @@ func (mr *MockEventMockRecorder) ID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockEvent)(nil).ID))
 }
 
+// IsAttendee mocks base method.
+func (m *MockEvent) IsAttendee(userID user.UserID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAttendee", userID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAttendee indicates an expected call of IsAttendee.
+func (mr *MockEventMockRecorder) IsAttendee(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAttendee", reflect.TypeOf((*MockEvent)(nil).IsAttendee), userID)
+}
+
+// IsBusyFor mocks base method.
+func (m *MockEvent) IsBusyFor(userID user.UserID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBusyFor", userID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsBusyFor indicates an expected call of IsBusyFor.
+func (mr *MockEventMockRecorder) IsBusyFor(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBusyFor", reflect.TypeOf((*MockEvent)(nil).IsBusyFor), userID)
+}
+
+// IsDeleted mocks base method.
+func (m *MockEvent) IsDeleted() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDeleted")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDeleted indicates an expected call of IsDeleted.
+func (mr *MockEventMockRecorder) IsDeleted() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDeleted", reflect.TypeOf((*MockEvent)(nil).IsDeleted))
+}
+
+// NextReminderTime mocks base method.
+func (m *MockEvent) NextReminderTime(r event.Reminder, after time.Time) (time.Time, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextReminderTime", r, after)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// NextReminderTime indicates an expected call of NextReminderTime.
+func (mr *MockEventMockRecorder) NextReminderTime(r, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextReminderTime", reflect.TypeOf((*MockEvent)(nil).NextReminderTime), r, after)
+}
+
+// Occurrences mocks base method.
+func (m *MockEvent) Occurrences(from, to time.Time) []event.Event {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occurrences", from, to)
+	ret0, _ := ret[0].([]event.Event)
+	return ret0
+}
+
+// Occurrences indicates an expected call of Occurrences.
+func (mr *MockEventMockRecorder) Occurrences(from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occurrences", reflect.TypeOf((*MockEvent)(nil).Occurrences), from, to)
+}
+
+// Recurrence mocks base method.
+func (m *MockEvent) Recurrence() event.Recurrence {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recurrence")
+	ret0, _ := ret[0].(event.Recurrence)
+	return ret0
+}
+
+// Recurrence indicates an expected call of Recurrence.
+func (mr *MockEventMockRecorder) Recurrence() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recurrence", reflect.TypeOf((*MockEvent)(nil).Recurrence))
+}
+
+// Reminders mocks base method.
+func (m *MockEvent) Reminders() []event.Reminder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reminders")
+	ret0, _ := ret[0].([]event.Reminder)
+	return ret0
+}
+
+// Reminders indicates an expected call of Reminders.
+func (mr *MockEventMockRecorder) Reminders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reminders", reflect.TypeOf((*MockEvent)(nil).Reminders))
+}
+
+// Respond mocks base method.
+func (m *MockEvent) Respond(userID user.UserID, responseStatus event.ResponseStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Respond", userID, responseStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Respond indicates an expected call of Respond.
+func (mr *MockEventMockRecorder) Respond(userID, responseStatus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Respond", reflect.TypeOf((*MockEvent)(nil).Respond), userID, responseStatus)
+}
+
 // StartTime mocks base method.
 func (m *MockEvent) StartTime() time.Time {
 	m.ctrl.T.Helper()
@@ -126,6 +338,20 @@ func (mr *MockEventMockRecorder) StartTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTime", reflect.TypeOf((*MockEvent)(nil).StartTime))
 }
 
+// TimeZone mocks base method.
+func (m *MockEvent) TimeZone() event.TimeZone {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TimeZone")
+	ret0, _ := ret[0].(event.TimeZone)
+	return ret0
+}
+
+// TimeZone indicates an expected call of TimeZone.
+func (mr *MockEventMockRecorder) TimeZone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeZone", reflect.TypeOf((*MockEvent)(nil).TimeZone))
+}
+
 // Title mocks base method.
 func (m *MockEvent) Title() event.Title {
 	m.ctrl.T.Helper()
@@ -141,15 +367,17 @@ func (mr *MockEventMockRecorder) Title() *gomock.Call {
 }
 
 // Update mocks base method.
-func (m *MockEvent) Update(title event.Title, description event.Description, startTime, endTime time.Time, color event.Color) {
+func (m *MockEvent) Update(editor user.UserID, calendarID calendar.CalendarID, title event.Title, description event.Description, timeRange event.TimeRange, timeZone event.TimeZone, color event.Color, recurrence event.Recurrence, attendees []event.Attendee, reminders []event.Reminder) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Update", title, description, startTime, endTime, color)
+	ret := m.ctrl.Call(m, "Update", editor, calendarID, title, description, timeRange, timeZone, color, recurrence, attendees, reminders)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockEventMockRecorder) Update(title, description, startTime, endTime, color any) *gomock.Call {
+func (mr *MockEventMockRecorder) Update(editor, calendarID, title, description, timeRange, timeZone, color, recurrence, attendees, reminders any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEvent)(nil).Update), title, description, startTime, endTime, color)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEvent)(nil).Update), editor, calendarID, title, description, timeRange, timeZone, color, recurrence, attendees, reminders)
 }
 
 // UpdatedAt mocks base method.
@@ -179,3 +407,17 @@ func (mr *MockEventMockRecorder) UserID() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserID", reflect.TypeOf((*MockEvent)(nil).UserID))
 }
+
+// Version mocks base method.
+func (m *MockEvent) Version() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Version")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Version indicates an expected call of Version.
+func (mr *MockEventMockRecorder) Version() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockEvent)(nil).Version))
+}
@@ -11,7 +11,9 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
+	calendar "github.com/qkitzero/event-service/internal/domain/calendar"
 	event "github.com/qkitzero/event-service/internal/domain/event"
 	user "github.com/qkitzero/event-service/internal/domain/user"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +43,21 @@ func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ClaimDueReminders mocks base method.
+func (m *MockEventRepository) ClaimDueReminders(now time.Time, limit int) ([]event.DueReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueReminders", now, limit)
+	ret0, _ := ret[0].([]event.DueReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueReminders indicates an expected call of ClaimDueReminders.
+func (mr *MockEventRepositoryMockRecorder) ClaimDueReminders(now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueReminders", reflect.TypeOf((*MockEventRepository)(nil).ClaimDueReminders), now, limit)
+}
+
 // Create mocks base method.
 func (m *MockEventRepository) Create(arg0 event.Event) error {
 	m.ctrl.T.Helper()
@@ -56,17 +73,32 @@ func (mr *MockEventRepositoryMockRecorder) Create(arg0 any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockEventRepository) Delete(id event.EventID) error {
+func (m *MockEventRepository) Delete(id event.EventID, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockEventRepositoryMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockEventRepositoryMockRecorder) Delete(id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEventRepository)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEventRepository)(nil).Delete), id, version)
+}
+
+// FindAllByCalendarIDs mocks base method.
+func (m *MockEventRepository) FindAllByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID) ([]event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByCalendarIDs", calendarIDs, attendeeID)
+	ret0, _ := ret[0].([]event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByCalendarIDs indicates an expected call of FindAllByCalendarIDs.
+func (mr *MockEventRepositoryMockRecorder) FindAllByCalendarIDs(calendarIDs, attendeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByCalendarIDs", reflect.TypeOf((*MockEventRepository)(nil).FindAllByCalendarIDs), calendarIDs, attendeeID)
 }
 
 // FindAllByUserID mocks base method.
@@ -84,6 +116,51 @@ func (mr *MockEventRepositoryMockRecorder) FindAllByUserID(userID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockEventRepository)(nil).FindAllByUserID), userID)
 }
 
+// FindByCalendarIDsAfterID mocks base method.
+func (m *MockEventRepository) FindByCalendarIDsAfterID(calendarIDs []calendar.CalendarID, attendeeID user.UserID, afterID *event.EventID, limit int) ([]event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCalendarIDsAfterID", calendarIDs, attendeeID, afterID, limit)
+	ret0, _ := ret[0].([]event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCalendarIDsAfterID indicates an expected call of FindByCalendarIDsAfterID.
+func (mr *MockEventRepositoryMockRecorder) FindByCalendarIDsAfterID(calendarIDs, attendeeID, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCalendarIDsAfterID", reflect.TypeOf((*MockEventRepository)(nil).FindByCalendarIDsAfterID), calendarIDs, attendeeID, afterID, limit)
+}
+
+// FindByCalendarIDsInRange mocks base method.
+func (m *MockEventRepository) FindByCalendarIDsInRange(calendarIDs []calendar.CalendarID, attendeeID user.UserID, from, to time.Time, after *event.Cursor, limit int) ([]event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCalendarIDsInRange", calendarIDs, attendeeID, from, to, after, limit)
+	ret0, _ := ret[0].([]event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCalendarIDsInRange indicates an expected call of FindByCalendarIDsInRange.
+func (mr *MockEventRepositoryMockRecorder) FindByCalendarIDsInRange(calendarIDs, attendeeID, from, to, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCalendarIDsInRange", reflect.TypeOf((*MockEventRepository)(nil).FindByCalendarIDsInRange), calendarIDs, attendeeID, from, to, after, limit)
+}
+
+// FindByICalUID mocks base method.
+func (m *MockEventRepository) FindByICalUID(userID user.UserID, icalUID string) (event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByICalUID", userID, icalUID)
+	ret0, _ := ret[0].(event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByICalUID indicates an expected call of FindByICalUID.
+func (mr *MockEventRepositoryMockRecorder) FindByICalUID(userID, icalUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByICalUID", reflect.TypeOf((*MockEventRepository)(nil).FindByICalUID), userID, icalUID)
+}
+
 // FindByID mocks base method.
 func (m *MockEventRepository) FindByID(id event.EventID) (event.Event, error) {
 	m.ctrl.T.Helper()
@@ -99,16 +176,224 @@ func (mr *MockEventRepositoryMockRecorder) FindByID(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockEventRepository)(nil).FindByID), id)
 }
 
+// FindByUserIDInRange mocks base method.
+func (m *MockEventRepository) FindByUserIDInRange(userID user.UserID, from, to time.Time) ([]event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIDInRange", userID, from, to)
+	ret0, _ := ret[0].([]event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserIDInRange indicates an expected call of FindByUserIDInRange.
+func (mr *MockEventRepositoryMockRecorder) FindByUserIDInRange(userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDInRange", reflect.TypeOf((*MockEventRepository)(nil).FindByUserIDInRange), userID, from, to)
+}
+
+// FindChangedByCalendarIDs mocks base method.
+func (m *MockEventRepository) FindChangedByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID, since time.Time) ([]event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChangedByCalendarIDs", calendarIDs, attendeeID, since)
+	ret0, _ := ret[0].([]event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChangedByCalendarIDs indicates an expected call of FindChangedByCalendarIDs.
+func (mr *MockEventRepositoryMockRecorder) FindChangedByCalendarIDs(calendarIDs, attendeeID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChangedByCalendarIDs", reflect.TypeOf((*MockEventRepository)(nil).FindChangedByCalendarIDs), calendarIDs, attendeeID, since)
+}
+
+// FindChangesAfter mocks base method.
+func (m *MockEventRepository) FindChangesAfter(after event.ChangePosition, limit int) ([]event.Change, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChangesAfter", after, limit)
+	ret0, _ := ret[0].([]event.Change)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChangesAfter indicates an expected call of FindChangesAfter.
+func (mr *MockEventRepositoryMockRecorder) FindChangesAfter(after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChangesAfter", reflect.TypeOf((*MockEventRepository)(nil).FindChangesAfter), after, limit)
+}
+
+// FindDeletedByCalendarIDs mocks base method.
+func (m *MockEventRepository) FindDeletedByCalendarIDs(calendarIDs []calendar.CalendarID) ([]event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByCalendarIDs", calendarIDs)
+	ret0, _ := ret[0].([]event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByCalendarIDs indicates an expected call of FindDeletedByCalendarIDs.
+func (mr *MockEventRepositoryMockRecorder) FindDeletedByCalendarIDs(calendarIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByCalendarIDs", reflect.TypeOf((*MockEventRepository)(nil).FindDeletedByCalendarIDs), calendarIDs)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockEventRepository) FindDeletedByID(id event.EventID) (event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", id)
+	ret0, _ := ret[0].(event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockEventRepositoryMockRecorder) FindDeletedByID(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockEventRepository)(nil).FindDeletedByID), id)
+}
+
+// FindDepartedByCalendarIDs mocks base method.
+func (m *MockEventRepository) FindDepartedByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID, since time.Time) ([]event.EventID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDepartedByCalendarIDs", calendarIDs, attendeeID, since)
+	ret0, _ := ret[0].([]event.EventID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDepartedByCalendarIDs indicates an expected call of FindDepartedByCalendarIDs.
+func (mr *MockEventRepositoryMockRecorder) FindDepartedByCalendarIDs(calendarIDs, attendeeID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDepartedByCalendarIDs", reflect.TypeOf((*MockEventRepository)(nil).FindDepartedByCalendarIDs), calendarIDs, attendeeID, since)
+}
+
+// FindRecurringByCalendarIDs mocks base method.
+func (m *MockEventRepository) FindRecurringByCalendarIDs(calendarIDs []calendar.CalendarID, attendeeID user.UserID, before time.Time) ([]event.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecurringByCalendarIDs", calendarIDs, attendeeID, before)
+	ret0, _ := ret[0].([]event.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecurringByCalendarIDs indicates an expected call of FindRecurringByCalendarIDs.
+func (mr *MockEventRepositoryMockRecorder) FindRecurringByCalendarIDs(calendarIDs, attendeeID, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecurringByCalendarIDs", reflect.TypeOf((*MockEventRepository)(nil).FindRecurringByCalendarIDs), calendarIDs, attendeeID, before)
+}
+
+// LatestChangePosition mocks base method.
+func (m *MockEventRepository) LatestChangePosition() (event.ChangePosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestChangePosition")
+	ret0, _ := ret[0].(event.ChangePosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestChangePosition indicates an expected call of LatestChangePosition.
+func (mr *MockEventRepositoryMockRecorder) LatestChangePosition() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestChangePosition", reflect.TypeOf((*MockEventRepository)(nil).LatestChangePosition))
+}
+
+// LatestDeletionByUserID mocks base method.
+func (m *MockEventRepository) LatestDeletionByUserID(userID user.UserID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestDeletionByUserID", userID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestDeletionByUserID indicates an expected call of LatestDeletionByUserID.
+func (mr *MockEventRepositoryMockRecorder) LatestDeletionByUserID(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestDeletionByUserID", reflect.TypeOf((*MockEventRepository)(nil).LatestDeletionByUserID), userID)
+}
+
+// PurgeDeletedBefore mocks base method.
+func (m *MockEventRepository) PurgeDeletedBefore(before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockEventRepositoryMockRecorder) PurgeDeletedBefore(before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockEventRepository)(nil).PurgeDeletedBefore), before)
+}
+
+// PurgePublishedChangesBefore mocks base method.
+func (m *MockEventRepository) PurgePublishedChangesBefore(before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgePublishedChangesBefore", before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgePublishedChangesBefore indicates an expected call of PurgePublishedChangesBefore.
+func (mr *MockEventRepositoryMockRecorder) PurgePublishedChangesBefore(before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePublishedChangesBefore", reflect.TypeOf((*MockEventRepository)(nil).PurgePublishedChangesBefore), before)
+}
+
+// RelayChanges mocks base method.
+func (m *MockEventRepository) RelayChanges(limit int, publish func(event.Change) error) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayChanges", limit, publish)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayChanges indicates an expected call of RelayChanges.
+func (mr *MockEventRepositoryMockRecorder) RelayChanges(limit, publish any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayChanges", reflect.TypeOf((*MockEventRepository)(nil).RelayChanges), limit, publish)
+}
+
+// ReleaseReminder mocks base method.
+func (m *MockEventRepository) ReleaseReminder(dueReminder event.DueReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseReminder", dueReminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseReminder indicates an expected call of ReleaseReminder.
+func (mr *MockEventRepositoryMockRecorder) ReleaseReminder(dueReminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseReminder", reflect.TypeOf((*MockEventRepository)(nil).ReleaseReminder), dueReminder)
+}
+
+// Restore mocks base method.
+func (m *MockEventRepository) Restore(id event.EventID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockEventRepositoryMockRecorder) Restore(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockEventRepository)(nil).Restore), id)
+}
+
 // Update mocks base method.
-func (m *MockEventRepository) Update(arg0 event.Event) error {
+func (m *MockEventRepository) Update(arg0 event.Event, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0)
+	ret := m.ctrl.Call(m, "Update", arg0, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockEventRepositoryMockRecorder) Update(arg0 any) *gomock.Call {
+func (mr *MockEventRepositoryMockRecorder) Update(arg0, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEventRepository)(nil).Update), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEventRepository)(nil).Update), arg0, version)
 }
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/feed/feed.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/feed/feed.go -destination=mocks/domain/feed/mock_feed.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	user "github.com/qkitzero/event-service/internal/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeed is a mock of Feed interface.
+type MockFeed struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedMockRecorder is the mock recorder for MockFeed.
+type MockFeedMockRecorder struct {
+	mock *MockFeed
+}
+
+// NewMockFeed creates a new mock instance.
+func NewMockFeed(ctrl *gomock.Controller) *MockFeed {
+	mock := &MockFeed{ctrl: ctrl}
+	mock.recorder = &MockFeedMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeed) EXPECT() *MockFeedMockRecorder {
+	return m.recorder
+}
+
+// CreatedAt mocks base method.
+func (m *MockFeed) CreatedAt() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatedAt")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// CreatedAt indicates an expected call of CreatedAt.
+func (mr *MockFeedMockRecorder) CreatedAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatedAt", reflect.TypeOf((*MockFeed)(nil).CreatedAt))
+}
+
+// TokenHash mocks base method.
+func (m *MockFeed) TokenHash() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokenHash")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TokenHash indicates an expected call of TokenHash.
+func (mr *MockFeedMockRecorder) TokenHash() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenHash", reflect.TypeOf((*MockFeed)(nil).TokenHash))
+}
+
+// UserID mocks base method.
+func (m *MockFeed) UserID() user.UserID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserID")
+	ret0, _ := ret[0].(user.UserID)
+	return ret0
+}
+
+// UserID indicates an expected call of UserID.
+func (mr *MockFeedMockRecorder) UserID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserID", reflect.TypeOf((*MockFeed)(nil).UserID))
+}
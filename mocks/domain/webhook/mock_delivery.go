@@ -0,0 +1,180 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/webhook/delivery.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/webhook/delivery.go -destination=mocks/domain/webhook/mock_delivery.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	webhook "github.com/qkitzero/event-service/internal/domain/webhook"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDelivery is a mock of Delivery interface.
+type MockDelivery struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliveryMockRecorder
+	isgomock struct{}
+}
+
+// MockDeliveryMockRecorder is the mock recorder for MockDelivery.
+type MockDeliveryMockRecorder struct {
+	mock *MockDelivery
+}
+
+// NewMockDelivery creates a new mock instance.
+func NewMockDelivery(ctrl *gomock.Controller) *MockDelivery {
+	mock := &MockDelivery{ctrl: ctrl}
+	mock.recorder = &MockDeliveryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDelivery) EXPECT() *MockDeliveryMockRecorder {
+	return m.recorder
+}
+
+// Attempts mocks base method.
+func (m *MockDelivery) Attempts() []webhook.Attempt {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attempts")
+	ret0, _ := ret[0].([]webhook.Attempt)
+	return ret0
+}
+
+// Attempts indicates an expected call of Attempts.
+func (mr *MockDeliveryMockRecorder) Attempts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attempts", reflect.TypeOf((*MockDelivery)(nil).Attempts))
+}
+
+// ChangeID mocks base method.
+func (m *MockDelivery) ChangeID() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeID")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// ChangeID indicates an expected call of ChangeID.
+func (mr *MockDeliveryMockRecorder) ChangeID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeID", reflect.TypeOf((*MockDelivery)(nil).ChangeID))
+}
+
+// CreatedAt mocks base method.
+func (m *MockDelivery) CreatedAt() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatedAt")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// CreatedAt indicates an expected call of CreatedAt.
+func (mr *MockDeliveryMockRecorder) CreatedAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatedAt", reflect.TypeOf((*MockDelivery)(nil).CreatedAt))
+}
+
+// EventType mocks base method.
+func (m *MockDelivery) EventType() webhook.EventType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventType")
+	ret0, _ := ret[0].(webhook.EventType)
+	return ret0
+}
+
+// EventType indicates an expected call of EventType.
+func (mr *MockDeliveryMockRecorder) EventType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventType", reflect.TypeOf((*MockDelivery)(nil).EventType))
+}
+
+// ID mocks base method.
+func (m *MockDelivery) ID() webhook.DeliveryID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ID")
+	ret0, _ := ret[0].(webhook.DeliveryID)
+	return ret0
+}
+
+// ID indicates an expected call of ID.
+func (mr *MockDeliveryMockRecorder) ID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockDelivery)(nil).ID))
+}
+
+// NextAttemptAt mocks base method.
+func (m *MockDelivery) NextAttemptAt() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextAttemptAt")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// NextAttemptAt indicates an expected call of NextAttemptAt.
+func (mr *MockDeliveryMockRecorder) NextAttemptAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextAttemptAt", reflect.TypeOf((*MockDelivery)(nil).NextAttemptAt))
+}
+
+// Payload mocks base method.
+func (m *MockDelivery) Payload() []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Payload")
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// Payload indicates an expected call of Payload.
+func (mr *MockDeliveryMockRecorder) Payload() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Payload", reflect.TypeOf((*MockDelivery)(nil).Payload))
+}
+
+// RecordAttempt mocks base method.
+func (m *MockDelivery) RecordAttempt(attempt webhook.Attempt) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordAttempt", attempt)
+}
+
+// RecordAttempt indicates an expected call of RecordAttempt.
+func (mr *MockDeliveryMockRecorder) RecordAttempt(attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAttempt", reflect.TypeOf((*MockDelivery)(nil).RecordAttempt), attempt)
+}
+
+// Status mocks base method.
+func (m *MockDelivery) Status() webhook.DeliveryStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status")
+	ret0, _ := ret[0].(webhook.DeliveryStatus)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockDeliveryMockRecorder) Status() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockDelivery)(nil).Status))
+}
+
+// WebhookID mocks base method.
+func (m *MockDelivery) WebhookID() webhook.WebhookID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookID")
+	ret0, _ := ret[0].(webhook.WebhookID)
+	return ret0
+}
+
+// WebhookID indicates an expected call of WebhookID.
+func (mr *MockDeliveryMockRecorder) WebhookID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookID", reflect.TypeOf((*MockDelivery)(nil).WebhookID))
+}
@@ -0,0 +1,219 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/webhook/webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/webhook/webhook.go -destination=mocks/domain/webhook/mock_webhook.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	user "github.com/qkitzero/event-service/internal/domain/user"
+	webhook "github.com/qkitzero/event-service/internal/domain/webhook"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhook is a mock of Webhook interface.
+type MockWebhook struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookMockRecorder is the mock recorder for MockWebhook.
+type MockWebhookMockRecorder struct {
+	mock *MockWebhook
+}
+
+// NewMockWebhook creates a new mock instance.
+func NewMockWebhook(ctrl *gomock.Controller) *MockWebhook {
+	mock := &MockWebhook{ctrl: ctrl}
+	mock.recorder = &MockWebhookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhook) EXPECT() *MockWebhookMockRecorder {
+	return m.recorder
+}
+
+// ConsecutiveFailures mocks base method.
+func (m *MockWebhook) ConsecutiveFailures() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsecutiveFailures")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ConsecutiveFailures indicates an expected call of ConsecutiveFailures.
+func (mr *MockWebhookMockRecorder) ConsecutiveFailures() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsecutiveFailures", reflect.TypeOf((*MockWebhook)(nil).ConsecutiveFailures))
+}
+
+// CreatedAt mocks base method.
+func (m *MockWebhook) CreatedAt() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatedAt")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// CreatedAt indicates an expected call of CreatedAt.
+func (mr *MockWebhookMockRecorder) CreatedAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatedAt", reflect.TypeOf((*MockWebhook)(nil).CreatedAt))
+}
+
+// Enabled mocks base method.
+func (m *MockWebhook) Enabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Enabled indicates an expected call of Enabled.
+func (mr *MockWebhookMockRecorder) Enabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enabled", reflect.TypeOf((*MockWebhook)(nil).Enabled))
+}
+
+// EventTypes mocks base method.
+func (m *MockWebhook) EventTypes() []webhook.EventType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventTypes")
+	ret0, _ := ret[0].([]webhook.EventType)
+	return ret0
+}
+
+// EventTypes indicates an expected call of EventTypes.
+func (mr *MockWebhookMockRecorder) EventTypes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventTypes", reflect.TypeOf((*MockWebhook)(nil).EventTypes))
+}
+
+// ID mocks base method.
+func (m *MockWebhook) ID() webhook.WebhookID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ID")
+	ret0, _ := ret[0].(webhook.WebhookID)
+	return ret0
+}
+
+// ID indicates an expected call of ID.
+func (mr *MockWebhookMockRecorder) ID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockWebhook)(nil).ID))
+}
+
+// RecordFailure mocks base method.
+func (m *MockWebhook) RecordFailure() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordFailure")
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockWebhookMockRecorder) RecordFailure() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockWebhook)(nil).RecordFailure))
+}
+
+// RecordSuccess mocks base method.
+func (m *MockWebhook) RecordSuccess() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordSuccess")
+}
+
+// RecordSuccess indicates an expected call of RecordSuccess.
+func (mr *MockWebhookMockRecorder) RecordSuccess() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSuccess", reflect.TypeOf((*MockWebhook)(nil).RecordSuccess))
+}
+
+// Secret mocks base method.
+func (m *MockWebhook) Secret() webhook.Secret {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Secret")
+	ret0, _ := ret[0].(webhook.Secret)
+	return ret0
+}
+
+// Secret indicates an expected call of Secret.
+func (mr *MockWebhookMockRecorder) Secret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secret", reflect.TypeOf((*MockWebhook)(nil).Secret))
+}
+
+// Subscribes mocks base method.
+func (m *MockWebhook) Subscribes(eventType webhook.EventType) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribes", eventType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Subscribes indicates an expected call of Subscribes.
+func (mr *MockWebhookMockRecorder) Subscribes(eventType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribes", reflect.TypeOf((*MockWebhook)(nil).Subscribes), eventType)
+}
+
+// URL mocks base method.
+func (m *MockWebhook) URL() webhook.URL {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URL")
+	ret0, _ := ret[0].(webhook.URL)
+	return ret0
+}
+
+// URL indicates an expected call of URL.
+func (mr *MockWebhookMockRecorder) URL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URL", reflect.TypeOf((*MockWebhook)(nil).URL))
+}
+
+// Update mocks base method.
+func (m *MockWebhook) Update(url webhook.URL, eventTypes []webhook.EventType, enabled bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Update", url, eventTypes, enabled)
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookMockRecorder) Update(url, eventTypes, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhook)(nil).Update), url, eventTypes, enabled)
+}
+
+// UpdatedAt mocks base method.
+func (m *MockWebhook) UpdatedAt() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatedAt")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// UpdatedAt indicates an expected call of UpdatedAt.
+func (mr *MockWebhookMockRecorder) UpdatedAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatedAt", reflect.TypeOf((*MockWebhook)(nil).UpdatedAt))
+}
+
+// UserID mocks base method.
+func (m *MockWebhook) UserID() user.UserID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserID")
+	ret0, _ := ret[0].(user.UserID)
+	return ret0
+}
+
+// UserID indicates an expected call of UserID.
+func (mr *MockWebhookMockRecorder) UserID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserID", reflect.TypeOf((*MockWebhook)(nil).UserID))
+}